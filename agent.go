@@ -18,6 +18,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"strings"
 	"sync"
@@ -99,6 +100,9 @@ type process struct {
 	exitCodeCh  chan int
 	sync.Once
 	stdinClosed bool
+	// set for an init process recovered from the state of a previous
+	// agent, which is not backed by a libcontainer process
+	recoveredPid int
 }
 
 type container struct {
@@ -110,9 +114,22 @@ type container struct {
 	config          configs.Config
 	processes       map[string]*process
 	mounts          []string
+	storages        []string
 	useSandboxPidNs bool
 	agentPidNs      bool
 	ctx             context.Context
+	intelRdtGroup   string
+	// resctrl group of the processes of the container, owned or not
+	intelRdtPath string
+	timeNs       bool
+	// directory holding the changes made to an overlay rootfs
+	rootfsOverlay string
+
+	// containers to wait for before starting, and for how long
+	startAfter        []string
+	startAfterTimeout time.Duration
+	// set once started and ready, protected by the container lock
+	ready bool
 }
 
 type sandboxStorage struct {
@@ -126,6 +143,7 @@ type sandbox struct {
 	id                string
 	hostname          string
 	containers        map[string]*container
+	creating          map[string]bool
 	channel           channel
 	network           network
 	wg                sync.WaitGroup
@@ -135,6 +153,7 @@ type sandbox struct {
 	server            *grpc.Server
 	sysToDevMap       map[string]string
 	deviceWatchers    map[string](chan string)
+	pciDevices        deviceCache
 	sharedUTSNs       namespace
 	sharedIPCNs       namespace
 	guestHooks        *specs.Hooks
@@ -145,7 +164,14 @@ type sandbox struct {
 	sandboxPidNs      bool
 	storages          map[string]*sandboxStorage
 	stopServer        chan struct{}
+	stopServerOnce    sync.Once
 	oomEvents         chan string
+	fsFreezer         fsFreezer
+	events            eventBus
+	proxy             guestProxy
+
+	// held during a whole teardown of the sandbox, see GracefulShutdown()
+	teardownLock sync.Mutex
 }
 
 var agentFields = logrus.Fields{
@@ -183,15 +209,102 @@ var debugConsoleVSockPort = uint32(0)
 // Timeout waiting for a device to be hotplugged
 var hotplugTimeout = 3 * time.Second
 
+// Upper bound of the interval between two checks for a hotplugged device
+var hotplugMaxInterval = 500 * time.Millisecond
+
 // Specify the log level
 var logLevel = defaultLogLevel
 
+// Number of log lines kept in memory for GetLogs, 0 disables the buffer.
+var logBufferSize = uint32(defaultLogBufferSize)
+
 // Specify whether the agent has to use cgroups v2 or not.
 var unifiedCgroupHierarchy = false
 
+// Mount options forbidden for any storage, whatever its driver.
+var deniedMountOptions []string
+
+// If not empty, the only mount options allowed for any storage.
+var allowedMountOptions []string
+
+// Specify whether forbidden mount options fail the mount instead of being
+// stripped.
+var rejectForbiddenMountOptions = false
+
+// Bytes buffered for each streaming RPC, and for all of them.
+var streamBufferSize = uint32(defaultStreamBufferSize)
+var streamBufferTotal = uint32(defaultStreamBufferTotal)
+
+// Specify whether the messages of a streaming RPC whose buffer is full are
+// dropped instead of blocking their producer.
+var streamBufferPolicy = streamBufferPolicyBlock
+
 // Size in bytes of the stdout/stderr pipes created for each container.
 var containerPipeSize = uint32(0)
 
+// Directory where the output of the container processes is logged, empty if
+// not logged.
+var containerLogDir = ""
+
+// Size in bytes at which the container logs are rotated, and number of
+// rotated logs kept.
+var containerLogMaxSize = uint32(defaultContainerLogMaxSize)
+var containerLogMaxBackups = uint32(defaultContainerLogMaxBackups)
+
+// Specify whether the rotated container logs are compressed.
+var containerLogCompress = false
+
+// Umask of the container init process when the spec does not specify one.
+var containerDefaultUmask = uint32(defaultContainerUmask)
+
+// Specify whether the OCI spec files written for the hooks are indented, to
+// ease debugging.
+var indentSpecFile = false
+
+// How the sandbox DNS configuration is provided to the containers, left to
+// their spec if empty.
+var resolvConfMode = ""
+
+// Variables of the agent environment passed to the OCI hooks, along with the
+// container metadata.
+var hookEnvAllowlist = defaultHookEnvAllowlist
+
+// How the guest hooks which are not executable are handled.
+var hookNonExecutablePolicy = hookNonExecutableReject
+
+// Maximum number of hooks run, and time they can run, for each phase of a
+// container, unlimited if 0.
+var hooksMax = uint32(0)
+var hooksTimeout = time.Duration(0)
+
+// How the hooks of each phase of a container are run.
+var hooksPolicy = hooksPolicyOrdered
+
+// Time the creation of a container can take, unlimited if 0.
+var createTimeout = time.Duration(0)
+
+// Time after which a sandbox without containers and calls is torn down,
+// never if 0.
+var idleTimeout = time.Duration(0)
+
+// Specify whether the supplementary groups of the processes run with a UID
+// are resolved from the /etc/group file of their container.
+var resolveUserGroups = false
+
+// Time above which the mount of a storage is logged, never if 0.
+var mountLogThreshold = time.Duration(0)
+
+// Pattern matching the names of the environment variables which values are
+// masked when a spec is logged, none being masked if nil.
+var redactEnvPattern = regexp.MustCompile(defaultRedactEnvPattern)
+
+// Annotations which values are masked when a spec is logged.
+var redactAnnotations []string
+
+// Directory holding the OCI spec of each container when the default one is
+// not writable, no fallback if empty.
+var ociConfigFallbackPath = ""
+
 // commType is used to denote the communication channel type used.
 type commType int
 
@@ -232,6 +345,17 @@ func (p *process) closePostStartFDs() {
 	if p.consoleSock != nil {
 		p.consoleSock.Close()
 	}
+
+	closeFiles(p.process.ExtraFiles)
+}
+
+// pid returns the PID of the process.
+func (p *process) pid() (int, error) {
+	if p.recoveredPid != 0 {
+		return p.recoveredPid, nil
+	}
+
+	return p.process.Pid()
 }
 
 // This is the list of file descriptors we can properly close after the process
@@ -288,13 +412,25 @@ func (c *container) removeContainer() error {
 	span, _ := c.trace("removeContainer")
 	defer span.finish()
 	// This will terminates all processes related to this container, and
-	// destroy the container right after. But this will error in case the
-	// container in not in the right state.
-	if err := c.container.Destroy(); err != nil {
-		return err
+	// destroy the container right after, removing its cgroups. But this
+	// will error in case the container in not in the right state.
+	err := c.container.Destroy()
+
+	// Whatever failed, clean up as much as possible, the first error
+	// being reported.
+	for _, e := range []error{removeIntelRdtGroup(c.intelRdtGroup), removeMounts(c.mounts)} {
+		if e == nil {
+			continue
+		}
+
+		if err == nil {
+			err = e
+		} else {
+			agentLog.WithError(e).WithField("container", c.id).Error("Could not clean up removed container")
+		}
 	}
 
-	return removeMounts(c.mounts)
+	return err
 }
 
 func (c *container) getProcess(execID string) (*process, error) {
@@ -420,11 +556,11 @@ func (s *sandbox) removeSandboxStorage(path string) error {
 
 	err := removeMounts([]string{path})
 	if err != nil {
-		return grpcStatus.Errorf(codes.Unknown, "Unable to unmount sandbox storage path %s: %v", path, err)
+		return grpcStatus.Errorf(codes.Internal, "Unable to unmount sandbox storage path %s: %v", path, err)
 	}
 	err = os.RemoveAll(path)
 	if err != nil {
-		return grpcStatus.Errorf(codes.Unknown, "Unable to delete sandbox storage path %s: %v", path, err)
+		return grpcStatus.Errorf(codes.Internal, "Unable to delete sandbox storage path %s: %v", path, err)
 	}
 	return nil
 }
@@ -482,6 +618,54 @@ func (s *sandbox) setContainer(ctx context.Context, id string, ctr *container) {
 	s.Unlock()
 }
 
+// reserveContainerID prevents another container from being created with id
+// until the returned function is called. The ID must not be used by an
+// existing container, unless it is to be replaced.
+func (s *sandbox) reserveContainerID(id string, replace bool) (func(), error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.creating[id] {
+		return nil, grpcStatus.Errorf(codes.AlreadyExists, "Container %s is already being created", id)
+	}
+
+	if _, exist := s.containers[id]; exist && !replace {
+		return nil, grpcStatus.Errorf(codes.AlreadyExists, "Container %s already exists, impossible to create", id)
+	}
+
+	if s.creating == nil {
+		s.creating = make(map[string]bool)
+	}
+	s.creating[id] = true
+
+	return func() {
+		s.Lock()
+		delete(s.creating, id)
+		s.Unlock()
+	}, nil
+}
+
+// replaceContainer stops the container id, if any, giving its processes until
+// deadline to exit, then removes it for a new container to take its ID.
+func (s *sandbox) replaceContainer(id string, deadline time.Time) error {
+	ctr, err := s.getContainer(id)
+	if err != nil {
+		return nil
+	}
+
+	agentLog.WithField("container", id).Info("Replacing container")
+
+	if err := ctr.stop(deadline); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not stop container %s to replace it: %v", id, err)
+	}
+
+	if err := s.removeContainer(ctr); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not remove container %s to replace it: %v", id, err)
+	}
+
+	return nil
+}
+
 func (s *sandbox) deleteContainer(id string) {
 	span, _ := s.trace("deleteContainer")
 	span.setTag("container", id)
@@ -503,12 +687,80 @@ func (s *sandbox) deleteContainer(id string) {
 				}
 			}
 		}
+
+		for _, k := range ctr.storages {
+			if err := s.unsetAndRemoveSandboxStorage(k); err != nil {
+				agentLog.WithError(err).Error()
+			}
+		}
 	}
 
 	delete(s.containers, id)
 	s.Unlock()
 }
 
+// removeContainer removes ctr and all its resources: its processes, cgroups,
+// mounts, sandbox storages and OCI spec file. It keeps on cleaning up when a
+// step fails, reporting all the errors, and the container is not tracked by
+// the sandbox anymore in any case. Removing a container which is not tracked
+// anymore does nothing.
+func (s *sandbox) removeContainer(ctr *container) error {
+	span, _ := s.trace("removeContainer")
+	span.setTag("container", ctr.id)
+	defer span.finish()
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.containers[ctr.id] != ctr {
+		return nil
+	}
+
+	var errs []error
+
+	if err := ctr.removeContainer(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// The overlay rootfs changes may be kept on a storage released below.
+	if ctr.rootfsOverlay != "" {
+		if err := os.RemoveAll(ctr.rootfsOverlay); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Find the sandbox storage used by this container
+	for _, path := range ctr.mounts {
+		if _, ok := s.storages[path]; ok {
+			if err := s.unsetAndRemoveSandboxStorage(path); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	for _, path := range ctr.storages {
+		if err := s.unsetAndRemoveSandboxStorage(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := os.RemoveAll(filepath.Join(ociConfigBasePath, ctr.id)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := removeContainerResolvConf(ctr.id); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := removeContainerLogs(ctr.id); err != nil {
+		errs = append(errs, err)
+	}
+
+	delete(s.containers, ctr.id)
+
+	return combineErrors(errs)
+}
+
 func (s *sandbox) getProcess(cid, execID string) (*process, *container, error) {
 	if !s.running {
 		return nil, nil, grpcStatus.Error(codes.FailedPrecondition, "Sandbox not started")
@@ -664,6 +916,22 @@ func (s *sandbox) teardownSharedPidNs() error {
 	return nil
 }
 
+// isRunning returns whether the sandbox is started.
+func (s *sandbox) isRunning() bool {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.running
+}
+
+// closeStopServer signals the main agent code to stop the server when all
+// gRPC calls will be completed, once whatever the teardowns of the sandbox.
+func (s *sandbox) closeStopServer() {
+	s.stopServerOnce.Do(func() {
+		close(s.stopServer)
+	})
+}
+
 func (s *sandbox) waitForStopServer() {
 	span, _ := s.trace("waitForStopServer")
 	defer span.finish()
@@ -750,6 +1018,7 @@ func (s *sandbox) listenToUdevEvents() {
 			fieldLogger.Infof("Remove dev from sysToDevMap")
 			s.Lock()
 			delete(s.sysToDevMap, uEv.DevPath)
+			s.pciDevices.invalidate(uEv.DevPath)
 			s.Unlock()
 			goto FINISH_SPAN
 		}
@@ -809,6 +1078,7 @@ func (s *sandbox) runOOMEventMonitor(ch <-chan struct{}, containerID string) {
 				return
 			}
 			agentLog.WithField("container-id", containerID).Info("Received OOM event")
+			s.events.publish(containerID, eventOOM, 0)
 			s.oomEvents <- containerID
 		}
 	}()
@@ -1006,6 +1276,11 @@ func (s *sandbox) initLogger(ctx context.Context) error {
 
 	agentLog.Logger.SetLevel(logLevel)
 
+	if logBufferSize > 0 {
+		logBuffer.resize(int(logBufferSize))
+		agentLog.Logger.AddHook(logBuffer)
+	}
+
 	agentLog = agentLog.WithField("debug_console", debugConsole)
 
 	if logsVSockPort != 0 {
@@ -1071,8 +1346,8 @@ func makeUnaryInterceptor() grpc.UnaryServerInterceptor {
 				span.setTag("api-category", "interactive")
 			}
 		} else {
-			// Just log call details
-			message = req.(proto.Message)
+			// Just log call details, the secrets of the spec left out
+			message = redactRequest(req.(proto.Message))
 
 			agentLog.WithFields(logrus.Fields{
 				"request": grpcCall,
@@ -1110,7 +1385,7 @@ func makeUnaryInterceptor() grpc.UnaryServerInterceptor {
 			stopTracing(ctx)
 		}
 
-		return resp, err
+		return resp, toGRPCError(err)
 	}
 }
 
@@ -1130,14 +1405,18 @@ func (s *sandbox) startGRPC() {
 
 	var grpcServer *grpc.Server
 
-	var serverOpts []grpc.ServerOption
+	var unaryInterceptor grpc.UnaryServerInterceptor
 
 	if collatedTrace {
 		// "collated" tracing (allow agent traces to be
 		// associated with runtime-initiated traces.
 		tracer := span.tracer()
+		tracingInterceptor := otgrpc.OpenTracingServerInterceptor(tracer.tracer)
 
-		serverOpts = append(serverOpts, grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(tracer.tracer)))
+		unaryInterceptor = func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			resp, err := tracingInterceptor(ctx, req, info, handler)
+			return resp, toGRPCError(err)
+		}
 	} else {
 		// Enable interceptor whether tracing is enabled or not. This
 		// is necessary to support StartTracing() and StopTracing()
@@ -1147,10 +1426,16 @@ func (s *sandbox) startGRPC() {
 		// When tracing is enabled, the interceptor handles "isolated"
 		// tracing (agent traces are not associated with runtime-initiated
 		// traces).
-		serverOpts = append(serverOpts, grpc.UnaryInterceptor(makeUnaryInterceptor()))
+		unaryInterceptor = makeUnaryInterceptor()
 	}
 
-	grpcServer = grpc.NewServer(serverOpts...)
+	if idleTimeout > 0 {
+		w := newIdleWatcher(idleTimeout, s.isIdle)
+		unaryInterceptor = w.interceptor(unaryInterceptor)
+		go s.watchIdle(grpcImpl, w)
+	}
+
+	grpcServer = grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor))
 
 	pb.RegisterAgentServiceServer(grpcServer, grpcImpl)
 	pb.RegisterHealthServer(grpcServer, grpcImpl)
@@ -1284,7 +1569,10 @@ func mountToRootfs(m initMount) error {
 		return err
 	}
 
-	flags, options := parseMountFlagsAndOptions(m.options)
+	flags, options, err := parseMountFlagsAndOptions(m.options)
+	if err != nil {
+		return err
+	}
 
 	if err := syscall.Mount(m.src, m.dest, m.fstype, uintptr(flags), options); err != nil {
 		return grpcStatus.Errorf(codes.Internal, "Could not mount %v to %v: %v", m.src, m.dest, err)
@@ -1484,6 +1772,10 @@ func initAgentAsInit() error {
 }
 
 func init() {
+	if len(os.Args) > 1 && os.Args[1] == execProcessArg {
+		execProcess(os.Args[2:])
+	}
+
 	if len(os.Args) > 1 && os.Args[1] == "init" {
 		runtime.GOMAXPROCS(1)
 		runtime.LockOSThread()
@@ -1553,6 +1845,10 @@ func realMain() error {
 		agentLog.WithError(err).Error("failed to setup debug console")
 	}
 
+	// The OCI spec of the containers is written for their hooks.
+	setupOCIConfigBasePath()
+	setupLibcontainerPath()
+
 	// Set the sandbox context now that the context contains the tracing
 	// information.
 	s.ctx = rootContext
@@ -1561,6 +1857,12 @@ func realMain() error {
 		return fmt.Errorf("failed to setup signal handler: %v", err)
 	}
 
+	// Track the containers left running by a previous agent, once the
+	// reaper is set up.
+	if err = s.recoverContainers(); err != nil {
+		agentLog.WithError(err).Error("failed to recover containers")
+	}
+
 	if err = s.handleLocalhost(); err != nil {
 		return fmt.Errorf("failed to handle localhost: %v", err)
 	}
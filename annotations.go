@@ -0,0 +1,157 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	units "github.com/docker/go-units"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Annotations of the spec providing resource hints, applied when the spec
+// does not set the corresponding field. The zero value of a field of the gRPC
+// spec stands for an unset field.
+const (
+	containerAnnotationPrefix = "io.katacontainers.container."
+
+	// Swappiness of the container memory, from 0 to 100.
+	swappinessAnnotation = containerAnnotationPrefix + "memory.swappiness"
+	// Memory and swap limit of the container, e.g. "1g", or -1 for no limit.
+	swapAnnotation = containerAnnotationPrefix + "memory.swap"
+	// OOM score adjustment of the container process, from -1000 to 1000.
+	oomScoreAdjAnnotation = containerAnnotationPrefix + "oom_score_adj"
+	// Prefix of the hugepages limit for a page size, e.g.
+	// "io.katacontainers.container.hugepages.2MB" set to "1g".
+	hugepagesAnnotationPrefix = containerAnnotationPrefix + "hugepages."
+)
+
+func invalidAnnotation(key, value string, err error) error {
+	return grpcStatus.Errorf(codes.InvalidArgument, "Invalid annotation %s=%q: %v", key, value, err)
+}
+
+// specMemory returns the memory resources of spec, creating them if needed.
+func specMemory(spec *pb.Spec) *pb.LinuxMemory {
+	if spec.Linux.Resources == nil {
+		spec.Linux.Resources = &pb.LinuxResources{}
+	}
+
+	if spec.Linux.Resources.Memory == nil {
+		spec.Linux.Resources.Memory = &pb.LinuxMemory{}
+	}
+
+	return spec.Linux.Resources.Memory
+}
+
+// hasHugepageLimit returns whether spec limits the hugepages of pagesize.
+func hasHugepageLimit(spec *pb.Spec, pagesize string) bool {
+	if spec.Linux.Resources == nil {
+		return false
+	}
+
+	for _, l := range spec.Linux.Resources.HugepageLimits {
+		if l.Pagesize == pagesize {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyAnnotationHints sets the fields of spec left unset from the resource
+// hints of its annotations. The other annotations are ignored.
+func applyAnnotationHints(spec *pb.Spec) error {
+	if spec == nil || len(spec.Annotations) == 0 {
+		return nil
+	}
+
+	// Sort the keys so that the hugepages limits are added in order.
+	var keys []string
+	for key := range spec.Annotations {
+		if strings.HasPrefix(key, containerAnnotationPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := spec.Annotations[key]
+
+		switch {
+		case key == oomScoreAdjAnnotation:
+			if spec.Process == nil || spec.Process.OOMScoreAdj != 0 {
+				continue
+			}
+
+			adj, err := strconv.ParseInt(value, 10, 64)
+			if err == nil && (adj < -1000 || adj > 1000) {
+				err = grpcStatus.Error(codes.InvalidArgument, "must be between -1000 and 1000")
+			}
+			if err != nil {
+				return invalidAnnotation(key, value, err)
+			}
+
+			spec.Process.OOMScoreAdj = adj
+		case key == swappinessAnnotation:
+			if spec.Linux == nil || (spec.Linux.Resources != nil && spec.Linux.Resources.Memory != nil && spec.Linux.Resources.Memory.Swappiness != 0) {
+				continue
+			}
+
+			swappiness, err := strconv.ParseUint(value, 10, 64)
+			if err == nil && swappiness > 100 {
+				err = grpcStatus.Error(codes.InvalidArgument, "must be between 0 and 100")
+			}
+			if err != nil {
+				return invalidAnnotation(key, value, err)
+			}
+
+			specMemory(spec).Swappiness = swappiness
+		case key == swapAnnotation:
+			if spec.Linux == nil || (spec.Linux.Resources != nil && spec.Linux.Resources.Memory != nil && spec.Linux.Resources.Memory.Swap != 0) {
+				continue
+			}
+
+			swap := int64(-1)
+			if value != "-1" {
+				var err error
+				if swap, err = units.RAMInBytes(value); err == nil && swap <= 0 {
+					err = grpcStatus.Error(codes.InvalidArgument, "must be positive or -1")
+				}
+				if err != nil {
+					return invalidAnnotation(key, value, err)
+				}
+			}
+
+			specMemory(spec).Swap = swap
+		case strings.HasPrefix(key, hugepagesAnnotationPrefix):
+			pagesize := strings.TrimPrefix(key, hugepagesAnnotationPrefix)
+			if spec.Linux == nil || pagesize == "" || hasHugepageLimit(spec, pagesize) {
+				continue
+			}
+
+			limit, err := units.RAMInBytes(value)
+			if err == nil && limit < 0 {
+				err = grpcStatus.Error(codes.InvalidArgument, "must not be negative")
+			}
+			if err != nil {
+				return invalidAnnotation(key, value, err)
+			}
+
+			if spec.Linux.Resources == nil {
+				spec.Linux.Resources = &pb.LinuxResources{}
+			}
+			spec.Linux.Resources.HugepageLimits = append(spec.Linux.Resources.HugepageLimits,
+				pb.LinuxHugepageLimit{Pagesize: pagesize, Limit: uint64(limit)})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestApplyAnnotationHints(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		annotations       map[string]string
+		resources         *pb.LinuxResources
+		oomScoreAdj       int64
+		expectedResources *pb.LinuxResources
+		expectedAdj       int64
+		expectedCode      codes.Code
+	}
+
+	data := []testData{
+		{nil, nil, 0, nil, 0, codes.OK},
+		// Unknown annotations are ignored.
+		{map[string]string{"foo": "bar", containerAnnotationPrefix + "foo": "bar"}, nil, 0, nil, 0, codes.OK},
+		{map[string]string{oomScoreAdjAnnotation: "500"}, nil, 0, nil, 500, codes.OK},
+		{map[string]string{oomScoreAdjAnnotation: "-1000"}, nil, 0, nil, -1000, codes.OK},
+		{map[string]string{swappinessAnnotation: "10"}, nil, 0,
+			&pb.LinuxResources{Memory: &pb.LinuxMemory{Swappiness: 10}}, 0, codes.OK},
+		{map[string]string{swapAnnotation: "1g"}, &pb.LinuxResources{Memory: &pb.LinuxMemory{Limit: 1024}}, 0,
+			&pb.LinuxResources{Memory: &pb.LinuxMemory{Limit: 1024, Swap: 1024 * 1024 * 1024}}, 0, codes.OK},
+		{map[string]string{swapAnnotation: "-1"}, nil, 0,
+			&pb.LinuxResources{Memory: &pb.LinuxMemory{Swap: -1}}, 0, codes.OK},
+		{map[string]string{hugepagesAnnotationPrefix + "2MB": "64m", hugepagesAnnotationPrefix + "1GB": "1g"}, nil, 0,
+			&pb.LinuxResources{HugepageLimits: []pb.LinuxHugepageLimit{
+				{Pagesize: "1GB", Limit: 1024 * 1024 * 1024},
+				{Pagesize: "2MB", Limit: 64 * 1024 * 1024},
+			}}, 0, codes.OK},
+
+		// The fields of the spec take precedence.
+		{map[string]string{oomScoreAdjAnnotation: "500"}, nil, -10, nil, -10, codes.OK},
+		{map[string]string{swappinessAnnotation: "10", swapAnnotation: "1g"}, &pb.LinuxResources{Memory: &pb.LinuxMemory{Swappiness: 60, Swap: 2048}}, 0,
+			&pb.LinuxResources{Memory: &pb.LinuxMemory{Swappiness: 60, Swap: 2048}}, 0, codes.OK},
+		{map[string]string{hugepagesAnnotationPrefix + "2MB": "64m", hugepagesAnnotationPrefix + "1GB": "1g"},
+			&pb.LinuxResources{HugepageLimits: []pb.LinuxHugepageLimit{{Pagesize: "2MB", Limit: 4096}}}, 0,
+			&pb.LinuxResources{HugepageLimits: []pb.LinuxHugepageLimit{
+				{Pagesize: "2MB", Limit: 4096},
+				{Pagesize: "1GB", Limit: 1024 * 1024 * 1024},
+			}}, 0, codes.OK},
+
+		{map[string]string{oomScoreAdjAnnotation: "1001"}, nil, 0, nil, 0, codes.InvalidArgument},
+		{map[string]string{oomScoreAdjAnnotation: "foo"}, nil, 0, nil, 0, codes.InvalidArgument},
+		{map[string]string{swappinessAnnotation: "101"}, nil, 0, nil, 0, codes.InvalidArgument},
+		{map[string]string{swappinessAnnotation: "-1"}, nil, 0, nil, 0, codes.InvalidArgument},
+		{map[string]string{swapAnnotation: "0"}, nil, 0, nil, 0, codes.InvalidArgument},
+		{map[string]string{swapAnnotation: "lots"}, nil, 0, nil, 0, codes.InvalidArgument},
+		{map[string]string{hugepagesAnnotationPrefix + "2MB": "lots"}, nil, 0, nil, 0, codes.InvalidArgument},
+	}
+
+	for i, d := range data {
+		spec := &pb.Spec{
+			Annotations: d.annotations,
+			Process:     &pb.Process{OOMScoreAdj: d.oomScoreAdj},
+			Linux:       &pb.Linux{Resources: d.resources},
+		}
+
+		err := applyAnnotationHints(spec)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		if err != nil {
+			continue
+		}
+
+		assert.Equal(d.expectedAdj, spec.Process.OOMScoreAdj, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedResources, spec.Linux.Resources, "test %d (%+v)", i, d)
+	}
+
+	// The hints are passed by the OCI spec.
+	spec := &pb.Spec{
+		Annotations: map[string]string{oomScoreAdjAnnotation: "500", swappinessAnnotation: "10"},
+		Process:     &pb.Process{},
+		Linux:       &pb.Linux{},
+	}
+	assert.NoError(applyAnnotationHints(spec))
+
+	ociSpec, err := pb.GRPCtoOCI(spec)
+	assert.NoError(err)
+	assert.Equal(500, *ociSpec.Process.OOMScoreAdj)
+	assert.Equal(uint64(10), *ociSpec.Linux.Resources.Memory.Swappiness)
+
+	// Nothing to apply without annotations or spec.
+	assert.NoError(applyAnnotationHints(nil))
+	assert.NoError(applyAnnotationHints(&pb.Spec{Annotations: map[string]string{swappinessAnnotation: "10"}}))
+}
@@ -0,0 +1,49 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"math/rand"
+	"time"
+)
+
+// backoff computes jittered exponential retry intervals. Every call to
+// next() doubles the base interval until it reaches max, and returns a random
+// duration between half the base interval and the base interval itself, so
+// that concurrent waiters do not poll in lockstep.
+type backoff struct {
+	interval time.Duration
+	max      time.Duration
+}
+
+func newBackoff(initial, max time.Duration) *backoff {
+	if initial > max {
+		initial = max
+	}
+
+	return &backoff{
+		interval: initial,
+		max:      max,
+	}
+}
+
+// next returns the duration to wait before the next attempt.
+func (b *backoff) next() time.Duration {
+	base := b.interval
+
+	b.interval *= 2
+	if b.interval > b.max {
+		b.interval = b.max
+	}
+
+	half := base / 2
+	if half <= 0 {
+		return base
+	}
+
+	return half + time.Duration(rand.Int63n(int64(base-half)+1))
+}
@@ -0,0 +1,51 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffGrowsWithinMax(t *testing.T) {
+	assert := assert.New(t)
+
+	initial := 10 * time.Millisecond
+	max := 200 * time.Millisecond
+
+	b := newBackoff(initial, max)
+
+	base := initial
+	for i := 0; i < 20; i++ {
+		d := b.next()
+
+		assert.True(d >= base/2, "step %d: %s shorter than half of %s", i, d, base)
+		assert.True(d <= base, "step %d: %s longer than %s", i, d, base)
+		assert.True(d <= max, "step %d: %s longer than max %s", i, d, max)
+
+		base *= 2
+		if base > max {
+			base = max
+		}
+	}
+
+	// The base interval must have been capped.
+	assert.Equal(max, b.interval)
+}
+
+func TestBackoffInitialAboveMax(t *testing.T) {
+	assert := assert.New(t)
+
+	max := 5 * time.Millisecond
+	b := newBackoff(time.Second, max)
+
+	for i := 0; i < 5; i++ {
+		assert.True(b.next() <= max)
+	}
+}
@@ -0,0 +1,48 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func hasCapability(caps []string, c string) bool {
+	for _, v := range caps {
+		if v == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setupAmbientCapabilities makes the ambient capabilities of caps survive
+// the switch to a non-root user and the execution of the process. They are
+// raised by libcontainer once the user is set up, which only succeeds for
+// capabilities both permitted and inheritable, the others being silently left
+// out, so they are added to the inheritable set. Without being permitted and
+// bounding, an ambient capability would be granted by nothing and the
+// configuration is rejected.
+func setupAmbientCapabilities(caps *configs.Capabilities) error {
+	if caps == nil {
+		return nil
+	}
+
+	for _, c := range caps.Ambient {
+		if !hasCapability(caps.Permitted, c) || !hasCapability(caps.Bounding, c) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Ambient capability %s must be permitted and bounding", c)
+		}
+
+		if !hasCapability(caps.Inheritable, c) {
+			caps.Inheritable = append(caps.Inheritable, c)
+		}
+	}
+
+	return nil
+}
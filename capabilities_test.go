@@ -0,0 +1,112 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestSetupAmbientCapabilities(t *testing.T) {
+	assert := assert.New(t)
+
+	const netBind = "CAP_NET_BIND_SERVICE"
+	const netRaw = "CAP_NET_RAW"
+
+	type testData struct {
+		caps                *configs.Capabilities
+		valid               bool
+		expectedInheritable []string
+	}
+
+	data := []testData{
+		{nil, true, nil},
+		{&configs.Capabilities{}, true, nil},
+		{&configs.Capabilities{Bounding: []string{netBind}, Permitted: []string{netBind}, Inheritable: []string{netRaw}}, true, []string{netRaw}},
+		{&configs.Capabilities{Bounding: []string{netBind}, Permitted: []string{netBind}, Ambient: []string{netBind}}, true, []string{netBind}},
+		{&configs.Capabilities{Bounding: []string{netBind}, Permitted: []string{netBind}, Inheritable: []string{netBind}, Ambient: []string{netBind}}, true, []string{netBind}},
+		{&configs.Capabilities{Bounding: []string{netBind, netRaw}, Permitted: []string{netBind, netRaw}, Inheritable: []string{netRaw}, Ambient: []string{netBind}}, true, []string{netRaw, netBind}},
+		{&configs.Capabilities{Bounding: []string{netBind}, Ambient: []string{netBind}}, false, nil},
+		{&configs.Capabilities{Permitted: []string{netBind}, Ambient: []string{netBind}}, false, nil},
+	}
+
+	for i, d := range data {
+		err := setupAmbientCapabilities(d.caps)
+		if !d.valid {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		if d.caps != nil {
+			assert.Equal(d.expectedInheritable, d.caps.Inheritable, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestAmbientCapabilitiesNonRootUser(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	c, cleanup := createTestContainer(t, "test-ambient-caps")
+	defer cleanup()
+
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"sleep", "100"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	newProcess := func(caps *configs.Capabilities) *process {
+		proc, err := buildProcess(&pb.Process{
+			Args: []string{"grep", "CapAmb", "/proc/self/status"},
+			Env:  []string{"PATH=/bin"},
+			Cwd:  "/",
+			User: pb.User{UID: 1000, GID: 1000},
+		}, "exec", false)
+		assert.NoError(err)
+
+		proc.process.Capabilities = caps
+		return proc
+	}
+
+	caps := &configs.Capabilities{
+		Bounding:  []string{"CAP_NET_BIND_SERVICE"},
+		Effective: []string{"CAP_NET_BIND_SERVICE"},
+		Permitted: []string{"CAP_NET_BIND_SERVICE"},
+		Ambient:   []string{"CAP_NET_BIND_SERVICE"},
+	}
+
+	// The ambient capability is not raised if it is not inheritable.
+	output := runTestProcess(t, c, newProcess(caps))
+	assert.Equal("CapAmb:\t0000000000000000", strings.TrimSpace(output))
+
+	err = setupAmbientCapabilities(caps)
+	assert.NoError(err)
+
+	// Once the user has changed, the capability is in the ambient set of
+	// the process, which keeps it across the execution.
+	output = runTestProcess(t, c, newProcess(caps))
+	assert.Equal("CapAmb:\t0000000000000400", strings.TrimSpace(output))
+
+	// Nothing is raised by default.
+	output = runTestProcess(t, c, newProcess(&configs.Capabilities{}))
+	assert.Equal("CapAmb:\t0000000000000000", strings.TrimSpace(output))
+}
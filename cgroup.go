@@ -7,11 +7,22 @@
 package main
 
 import (
+	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/docker/docker/pkg/parsers"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/cgroups"
+	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 // set function in variable to overwrite for testing.
@@ -63,3 +74,302 @@ func getAvailableCpusetList(cpusetReq string) (string, error) {
 	}).Debugf("the requested cpuset is valid, using it")
 	return cpusetReq, nil
 }
+
+// containerCgroupPath returns the path of the cgroup of ctr in the cgroup
+// hierarchy, as seen by its init process, along with the absolute path of
+// this cgroup for each controller.
+func containerCgroupPath(ctr *container) (string, map[string]string, error) {
+	state, err := ctr.container.State()
+	if err != nil {
+		return "", nil, err
+	}
+	if state == nil || state.InitProcessPid <= 0 {
+		return "", nil, grpcStatus.Errorf(codes.FailedPrecondition, "Container %s has no init process", ctr.id)
+	}
+
+	paths, err := cgroups.ParseCgroupFile(fmt.Sprintf("/proc/%d/cgroup", state.InitProcessPid))
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The devices controller is always used by the containers with
+	// cgroups v1.
+	controller := "devices"
+	if unifiedCgroupHierarchy {
+		controller = ""
+	}
+
+	path, ok := paths[controller]
+	if !ok {
+		return "", nil, grpcStatus.Errorf(codes.Internal, "No cgroup found for the init process of container %s", ctr.id)
+	}
+
+	return path, state.CgroupPaths, nil
+}
+
+// checkUnifiedResources rejects the raw cgroup v2 key/values of unified if
+// the agent does not use cgroups v2.
+func checkUnifiedResources(unified map[string]string) error {
+	if len(unified) > 0 && !unifiedCgroupHierarchy {
+		return grpcStatus.Error(codes.InvalidArgument, "Unified cgroup resources are only supported with cgroups v2")
+	}
+
+	return nil
+}
+
+// writeUnifiedResources writes the raw cgroup v2 key/values of unified to
+// the files of the cgroup directory dir. Each key must name an existing file
+// of this directory, such as "memory.high".
+func writeUnifiedResources(dir string, unified map[string]string) error {
+	keys := make([]string, 0, len(unified))
+	for key := range unified {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// Check all the keys first, not to partially apply the resources.
+	for _, key := range keys {
+		if key == "" || strings.Contains(key, "/") || strings.HasPrefix(key, ".") || !strings.Contains(key, ".") {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid unified cgroup resource %q", key)
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, key)); err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Unsupported unified cgroup resource %q: %v", key, err)
+		}
+	}
+
+	for _, key := range keys {
+		path := filepath.Join(dir, key)
+
+		agentLog.WithFields(logrus.Fields{
+			"path":  path,
+			"value": unified[key],
+		}).Debug("updating unified cgroup resource")
+
+		if err := ioutil.WriteFile(path, []byte(unified[key]), 0644); err != nil {
+			return fmt.Errorf("Could not write unified cgroup resource %q: %v", key, err)
+		}
+	}
+
+	return nil
+}
+
+// setUnifiedResources writes the raw cgroup v2 key/values of unified to the
+// cgroup of ctr, whose init process must be started.
+func setUnifiedResources(ctr *container, unified map[string]string) error {
+	if len(unified) == 0 {
+		return nil
+	}
+
+	if err := checkUnifiedResources(unified); err != nil {
+		return err
+	}
+
+	path, _, err := containerCgroupPath(ctr)
+	if err != nil {
+		return err
+	}
+
+	return writeUnifiedResources(filepath.Join(cgroupPath, path), unified)
+}
+
+// Files of the net_cls and net_prio cgroup v1 controllers.
+const (
+	netClsClassidFile    = "net_cls.classid"
+	netPrioIfpriomapFile = "net_prio.ifpriomap"
+)
+
+// checkNetworkResources rejects the class identifier and the interface
+// priorities of network if the agent uses cgroups v2, which has no net_cls
+// and net_prio controllers, or if an interface name is not valid.
+func checkNetworkResources(network *pb.LinuxNetwork) error {
+	if network == nil || (network.ClassID == 0 && len(network.Priorities) == 0) {
+		return nil
+	}
+
+	if unifiedCgroupHierarchy {
+		return grpcStatus.Error(codes.InvalidArgument,
+			"Network cgroup resources are only supported with cgroups v1, the net_cls and net_prio controllers are not available with cgroups v2")
+	}
+
+	for _, p := range network.Priorities {
+		if p.Name == "" || strings.ContainsAny(p.Name, " \t\n/") {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid network interface name %q", p.Name)
+		}
+	}
+
+	return nil
+}
+
+// writeNetworkResources writes the class identifier of network to the
+// net_cls.classid file of the cgroup directory netClsDir, and its interface
+// priorities to the net_prio.ifpriomap file of netPrioDir, an empty
+// directory meaning the controller is not available.
+func writeNetworkResources(netClsDir, netPrioDir string, network *pb.LinuxNetwork) error {
+	if network.ClassID != 0 {
+		if netClsDir == "" {
+			return grpcStatus.Error(codes.FailedPrecondition, "The net_cls cgroup controller is not available")
+		}
+
+		path := filepath.Join(netClsDir, netClsClassidFile)
+		value := strconv.FormatUint(uint64(network.ClassID), 10)
+
+		agentLog.WithFields(logrus.Fields{
+			"path":  path,
+			"value": value,
+		}).Debug("updating network class identifier")
+
+		if err := ioutil.WriteFile(path, []byte(value), 0644); err != nil {
+			return fmt.Errorf("Could not write network class identifier %s: %v", value, err)
+		}
+	}
+
+	if len(network.Priorities) == 0 {
+		return nil
+	}
+
+	if netPrioDir == "" {
+		return grpcStatus.Error(codes.FailedPrecondition, "The net_prio cgroup controller is not available")
+	}
+
+	path := filepath.Join(netPrioDir, netPrioIfpriomapFile)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC, 0)
+	if err != nil {
+		return fmt.Errorf("Could not open %s: %v", path, err)
+	}
+	defer f.Close()
+
+	// The kernel reads a single interface priority for each write.
+	for _, p := range network.Priorities {
+		value := fmt.Sprintf("%s %d\n", p.Name, p.Priority)
+
+		agentLog.WithFields(logrus.Fields{
+			"path":  path,
+			"value": strings.TrimSpace(value),
+		}).Debug("updating network interface priority")
+
+		if _, err := f.WriteString(value); err != nil {
+			return fmt.Errorf("Could not write network interface %s priority %d: %v", p.Name, p.Priority, err)
+		}
+	}
+
+	return nil
+}
+
+// setNetworkResources writes the class identifier and the interface
+// priorities of network to the net_cls and net_prio cgroups of ctr, whose
+// init process must be started.
+func setNetworkResources(ctr *container, network *pb.LinuxNetwork) error {
+	if network == nil || (network.ClassID == 0 && len(network.Priorities) == 0) {
+		return nil
+	}
+
+	if err := checkNetworkResources(network); err != nil {
+		return err
+	}
+
+	_, paths, err := containerCgroupPath(ctr)
+	if err != nil {
+		return err
+	}
+
+	return writeNetworkResources(paths["net_cls"], paths["net_prio"], network)
+}
+
+// Flags of the mounts exposing the cgroup of a container.
+const cgroupMountFlags = unix.MS_NOSUID | unix.MS_NODEV | unix.MS_NOEXEC
+
+// configCgroupPath returns the path of the cgroup of config in the cgroup
+// hierarchy.
+func configCgroupPath(config *configs.Cgroup) string {
+	if config.Path != "" {
+		return config.Path
+	}
+
+	return filepath.Join("/", config.Parent, config.Name)
+}
+
+// cgroupMounts returns the mounts exposing read-only, at destination in
+// rootfs, the cgroup cgroupsPath of a container. With cgroups v2, the cgroup
+// directory is bind mounted. With cgroups v1, the cgroup directory of each
+// controller is bind mounted in a tmpfs, which is then remounted read-only.
+func cgroupMounts(rootfs, destination, cgroupsPath string, propagation []int) ([]*configs.Mount, error) {
+	bind := func(source, dest string) *configs.Mount {
+		return &configs.Mount{
+			Source:           source,
+			Destination:      dest,
+			Device:           "bind",
+			Flags:            unix.MS_BIND | unix.MS_REC | unix.MS_RDONLY | cgroupMountFlags,
+			PropagationFlags: propagation,
+		}
+	}
+
+	if unifiedCgroupHierarchy {
+		return []*configs.Mount{bind(filepath.Join(cgroupPath, cgroupsPath), destination)}, nil
+	}
+
+	entries, err := ioutil.ReadDir(cgroupPath)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts := []*configs.Mount{{
+		Source:           "tmpfs",
+		Destination:      destination,
+		Device:           "tmpfs",
+		Flags:            cgroupMountFlags,
+		Data:             "mode=755",
+		PropagationFlags: propagation,
+	}}
+
+	for _, e := range entries {
+		// The agent mounts each controller on its own hierarchy.
+		if !e.IsDir() {
+			continue
+		}
+
+		source := filepath.Join(cgroupPath, e.Name(), cgroupsPath)
+		mounts = append(mounts, bind(source, filepath.Join(destination, e.Name())))
+	}
+
+	// The tmpfs cannot be mounted read-only before the mount points of
+	// the controllers are created in it, it is remounted afterwards.
+	remount := bind(filepath.Join(rootfs, destination), destination)
+	remount.Flags = unix.MS_BIND | unix.MS_REMOUNT | unix.MS_RDONLY | cgroupMountFlags
+	remount.PropagationFlags = nil
+	mounts = append(mounts, remount)
+
+	return mounts, nil
+}
+
+// setupCgroupMounts replaces the cgroup mounts of config, which would expose
+// the cgroups of the guest, with mounts exposing read-only the cgroup of the
+// container.
+func setupCgroupMounts(config *configs.Config) error {
+	var mounts []*configs.Mount
+
+	for _, m := range config.Mounts {
+		if m.Device != "cgroup" {
+			mounts = append(mounts, m)
+			continue
+		}
+
+		cgroupsPath := configCgroupPath(config.Cgroups)
+
+		replacement, err := cgroupMounts(config.Rootfs, m.Destination, cgroupsPath, m.PropagationFlags)
+		if err != nil {
+			return err
+		}
+
+		agentLog.WithFields(logrus.Fields{
+			"mount-destination": m.Destination,
+			"cgroup":            cgroupsPath,
+		}).Debug("Exposing the container cgroup")
+
+		mounts = append(mounts, replacement...)
+	}
+
+	config.Mounts = mounts
+
+	return nil
+}
@@ -6,9 +6,21 @@
 package main
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
 	"testing"
 
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/cgroups"
+	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 func TestGetAvailableCpusetList(t *testing.T) {
@@ -43,3 +55,489 @@ func TestGetAvailableCpusetList(t *testing.T) {
 		assert.Equal(t, out, c.expectedOutput)
 	}
 }
+
+func TestUpdateContainerPidsLimit(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	cid := "test-update-pids-limit"
+
+	c, cleanup := createTestContainer(t, cid)
+	defer cleanup()
+
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"sleep", "100"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	state, err := c.State()
+	assert.NoError(err)
+	pidsPath, ok := state.CgroupPaths["pids"]
+	assert.True(ok)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				cid: {id: cid, container: c},
+			},
+			running: true,
+		},
+	}
+
+	type testData struct {
+		limit         int64
+		expectedValue string
+	}
+
+	data := []testData{
+		{100, "100"},
+		{-1, "max"},
+		{42, "42"},
+	}
+
+	for i, d := range data {
+		_, err = a.UpdateContainer(context.Background(), &pb.UpdateContainerRequest{
+			ContainerId: cid,
+			Resources: &pb.LinuxResources{
+				Pids: &pb.LinuxPids{Limit: d.limit},
+			},
+		})
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		content, err := ioutil.ReadFile(filepath.Join(pidsPath, "pids.max"))
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedValue, strings.TrimSpace(string(content)), "test %d (%+v)", i, d)
+		assert.Equal(d.limit, c.Config().Cgroups.Resources.PidsLimit, "test %d (%+v)", i, d)
+	}
+
+	// The limit is kept when other resources are updated.
+	_, err = a.UpdateContainer(context.Background(), &pb.UpdateContainerRequest{
+		ContainerId: cid,
+		Resources: &pb.LinuxResources{
+			BlockIO: &pb.LinuxBlockIO{},
+		},
+	})
+	assert.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(pidsPath, "pids.max"))
+	assert.NoError(err)
+	assert.Equal("42", strings.TrimSpace(string(content)))
+}
+
+func TestGetCgroupPath(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	if cgroups.IsCgroup2UnifiedMode() {
+		t.Skip("Test requires cgroups v1")
+	}
+
+	agentCgroups, err := cgroups.ParseCgroupFile("/proc/self/cgroup")
+	assert.NoError(err)
+
+	devicesMount, err := cgroups.FindCgroupMountpoint("", "devices")
+	assert.NoError(err)
+
+	type testData struct {
+		id           string
+		cgroupsPath  string
+		expectedPath string
+	}
+
+	data := []testData{
+		// By default, the container cgroup is created below the agent one.
+		{"test-cgroup-default", "", filepath.Join(agentCgroups["devices"], "test-cgroup-default")},
+		{"test-cgroup-custom", "/kata/custom", "/kata/custom"},
+	}
+
+	for i, d := range data {
+		c, cleanup := createTestContainerWithConfig(t, d.id, func(config *configs.Config) {
+			if d.cgroupsPath != "" {
+				config.Cgroups.Name = ""
+				config.Cgroups.Path = d.cgroupsPath
+			}
+		})
+
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				running:    true,
+				containers: make(map[string]*container),
+			},
+		}
+
+		_, err := a.GetCgroupPath(context.Background(), &pb.GetCgroupPathRequest{ContainerId: d.id})
+		assert.Equal(codes.NotFound, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		ctr := &container{id: d.id, container: c}
+		a.sandbox.containers[d.id] = ctr
+
+		// No cgroup until the init process is started.
+		_, err = a.GetCgroupPath(context.Background(), &pb.GetCgroupPathRequest{ContainerId: d.id})
+		assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		initProc, err := buildProcess(&pb.Process{
+			Args: []string{"sleep", "100"},
+			Env:  []string{"PATH=/bin"},
+			Cwd:  "/",
+		}, "init", true)
+		assert.NoError(err)
+
+		err = c.Run(&initProc.process)
+		initProc.closePostStartFDs()
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		resp, err := a.GetCgroupPath(context.Background(), &pb.GetCgroupPathRequest{ContainerId: d.id})
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedPath, resp.Path, "test %d (%+v)", i, d)
+		assert.Equal(filepath.Join(devicesMount, d.expectedPath), resp.ControllerPaths["devices"], "test %d (%+v)", i, d)
+		assert.Contains(resp.ControllerPaths, "memory", "test %d (%+v)", i, d)
+
+		for controller, path := range resp.ControllerPaths {
+			_, err = os.Stat(path)
+			assert.NoError(err, "test %d (%+v): controller %s", i, d, controller)
+		}
+
+		err = c.Signal(syscall.SIGKILL, true)
+		assert.NoError(err)
+		initProc.process.Wait()
+		initProc.closePostExitFDs()
+
+		cleanup()
+	}
+}
+
+func TestCheckUnifiedResources(t *testing.T) {
+	assert := assert.New(t)
+
+	savedUnifiedCgroupHierarchy := unifiedCgroupHierarchy
+	defer func() {
+		unifiedCgroupHierarchy = savedUnifiedCgroupHierarchy
+	}()
+
+	type testData struct {
+		unified      bool
+		resources    map[string]string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{false, nil, codes.OK},
+		{false, map[string]string{}, codes.OK},
+		{false, map[string]string{"memory.high": "1G"}, codes.InvalidArgument},
+		{true, nil, codes.OK},
+		{true, map[string]string{"memory.high": "1G"}, codes.OK},
+	}
+
+	for i, d := range data {
+		unifiedCgroupHierarchy = d.unified
+		err := checkUnifiedResources(d.resources)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		// Nothing is written to the cgroup of the container on rejection.
+		if d.expectedCode != codes.OK || len(d.resources) == 0 {
+			err = setUnifiedResources(&container{}, d.resources)
+			assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestWriteUnifiedResources(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	dir := filepath.Join(tmpDir, "kata", "container")
+	err = os.MkdirAll(dir, 0755)
+	assert.NoError(err)
+
+	for _, file := range []string{"memory.high", "pids.max", "cgroup.max.depth"} {
+		err = ioutil.WriteFile(filepath.Join(dir, file), []byte("max"), 0644)
+		assert.NoError(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "kata", "memory.high"), []byte("max"), 0644)
+	assert.NoError(err)
+
+	type testData struct {
+		resources       map[string]string
+		expectedCode    codes.Code
+		expectedWritten map[string]string
+	}
+
+	data := []testData{
+		{nil, codes.OK, nil},
+		{map[string]string{"memory.high": "1073741824", "pids.max": "100", "cgroup.max.depth": "2"}, codes.OK,
+			map[string]string{"memory.high": "1073741824", "pids.max": "100", "cgroup.max.depth": "2"}},
+		// The keys cannot escape the container cgroup.
+		{map[string]string{"../memory.high": "1"}, codes.InvalidArgument, nil},
+		{map[string]string{"/memory.high": "1"}, codes.InvalidArgument, nil},
+		{map[string]string{"..": "1"}, codes.InvalidArgument, nil},
+		{map[string]string{"memory": "1"}, codes.InvalidArgument, nil},
+		{map[string]string{"": "1"}, codes.InvalidArgument, nil},
+		// Only the existing files are written.
+		{map[string]string{"memory.bogus": "1"}, codes.InvalidArgument, nil},
+		// Nothing is written if any key is invalid.
+		{map[string]string{"memory.high": "1", "pids.max": "1", "zzz/pids.max": "1"}, codes.InvalidArgument, nil},
+	}
+
+	for i, d := range data {
+		for _, file := range []string{"memory.high", "pids.max", "cgroup.max.depth"} {
+			err = ioutil.WriteFile(filepath.Join(dir, file), []byte("max"), 0644)
+			assert.NoError(err)
+		}
+
+		err := writeUnifiedResources(dir, d.resources)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		for _, file := range []string{"memory.high", "pids.max", "cgroup.max.depth"} {
+			expected, ok := d.expectedWritten[file]
+			if !ok {
+				expected = "max"
+			}
+
+			content, err := ioutil.ReadFile(filepath.Join(dir, file))
+			assert.NoError(err)
+			assert.Equal(expected, string(content), "test %d (%+v): %s", i, d, file)
+		}
+
+		content, err := ioutil.ReadFile(filepath.Join(tmpDir, "kata", "memory.high"))
+		assert.NoError(err)
+		assert.Equal("max", string(content), "test %d (%+v)", i, d)
+	}
+}
+
+func TestCheckNetworkResources(t *testing.T) {
+	assert := assert.New(t)
+
+	savedUnifiedCgroupHierarchy := unifiedCgroupHierarchy
+	defer func() {
+		unifiedCgroupHierarchy = savedUnifiedCgroupHierarchy
+	}()
+
+	type testData struct {
+		unified      bool
+		network      *pb.LinuxNetwork
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{false, nil, codes.OK},
+		{false, &pb.LinuxNetwork{}, codes.OK},
+		{false, &pb.LinuxNetwork{ClassID: 0x100001}, codes.OK},
+		{false, &pb.LinuxNetwork{Priorities: []pb.LinuxInterfacePriority{{Name: "eth0", Priority: 5}}}, codes.OK},
+		{false, &pb.LinuxNetwork{Priorities: []pb.LinuxInterfacePriority{{Name: "", Priority: 5}}}, codes.InvalidArgument},
+		{false, &pb.LinuxNetwork{Priorities: []pb.LinuxInterfacePriority{{Name: "eth0 1", Priority: 5}}}, codes.InvalidArgument},
+		{true, nil, codes.OK},
+		{true, &pb.LinuxNetwork{}, codes.OK},
+		{true, &pb.LinuxNetwork{ClassID: 0x100001}, codes.InvalidArgument},
+		{true, &pb.LinuxNetwork{Priorities: []pb.LinuxInterfacePriority{{Name: "eth0", Priority: 5}}}, codes.InvalidArgument},
+	}
+
+	for i, d := range data {
+		unifiedCgroupHierarchy = d.unified
+		err := checkNetworkResources(d.network)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		// Nothing is written to the cgroup of the container on rejection.
+		if d.expectedCode != codes.OK || d.network == nil || (d.network.ClassID == 0 && len(d.network.Priorities) == 0) {
+			err = setNetworkResources(&container{}, d.network)
+			assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestWriteNetworkResources(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	netClsDir := filepath.Join(tmpDir, "net_cls", "kata", "container")
+	netPrioDir := filepath.Join(tmpDir, "net_prio", "kata", "container")
+
+	type testData struct {
+		netClsDir       string
+		netPrioDir      string
+		network         *pb.LinuxNetwork
+		expectedCode    codes.Code
+		expectedClassid string
+		expectedPriomap string
+	}
+
+	priorities := []pb.LinuxInterfacePriority{{Name: "eth0", Priority: 5}, {Name: "lo", Priority: 10}}
+
+	data := []testData{
+		{netClsDir, netPrioDir, &pb.LinuxNetwork{}, codes.OK, "0", ""},
+		{netClsDir, netPrioDir, &pb.LinuxNetwork{ClassID: 0x100001}, codes.OK, "1048577", ""},
+		{netClsDir, netPrioDir, &pb.LinuxNetwork{Priorities: priorities}, codes.OK, "0", "eth0 5\nlo 10\n"},
+		{netClsDir, netPrioDir, &pb.LinuxNetwork{ClassID: 0x10002, Priorities: priorities[:1]}, codes.OK, "65538", "eth0 5\n"},
+		// The controllers must be available.
+		{"", netPrioDir, &pb.LinuxNetwork{ClassID: 0x100001}, codes.FailedPrecondition, "0", ""},
+		{netClsDir, "", &pb.LinuxNetwork{Priorities: priorities}, codes.FailedPrecondition, "0", ""},
+		// Only the files of the controllers used are written.
+		{"", netPrioDir, &pb.LinuxNetwork{Priorities: priorities}, codes.OK, "0", "eth0 5\nlo 10\n"},
+		{netClsDir, "", &pb.LinuxNetwork{ClassID: 0x100001}, codes.OK, "1048577", ""},
+	}
+
+	for i, d := range data {
+		for _, file := range []string{filepath.Join(netClsDir, netClsClassidFile), filepath.Join(netPrioDir, netPrioIfpriomapFile)} {
+			err = os.MkdirAll(filepath.Dir(file), 0755)
+			assert.NoError(err)
+			err = ioutil.WriteFile(file, nil, 0644)
+			assert.NoError(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(netClsDir, netClsClassidFile), []byte("0"), 0644)
+		assert.NoError(err)
+
+		err := writeNetworkResources(d.netClsDir, d.netPrioDir, d.network)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		content, err := ioutil.ReadFile(filepath.Join(netClsDir, netClsClassidFile))
+		assert.NoError(err)
+		assert.Equal(d.expectedClassid, string(content), "test %d (%+v)", i, d)
+
+		content, err = ioutil.ReadFile(filepath.Join(netPrioDir, netPrioIfpriomapFile))
+		assert.NoError(err)
+		assert.Equal(d.expectedPriomap, string(content), "test %d (%+v)", i, d)
+	}
+}
+
+func TestSetupCgroupMounts(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedCgroupPath := cgroupPath
+	savedUnifiedCgroupHierarchy := unifiedCgroupHierarchy
+	defer func() {
+		cgroupPath = savedCgroupPath
+		unifiedCgroupHierarchy = savedUnifiedCgroupHierarchy
+	}()
+
+	// Hierarchies of the controllers, the files being ignored.
+	cgroupPath = tmpDir
+	for _, controller := range []string{"cpu", "memory"} {
+		err := os.Mkdir(filepath.Join(tmpDir, controller), 0755)
+		assert.NoError(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "cgroup.procs"), nil, 0644)
+	assert.NoError(err)
+
+	procMount := &configs.Mount{Source: "proc", Destination: "/proc", Device: "proc"}
+	cgroupMount := &configs.Mount{Source: "cgroup", Destination: "/sys/fs/cgroup", Device: "cgroup",
+		PropagationFlags: []int{unix.MS_PRIVATE}}
+
+	bindFlags := unix.MS_BIND | unix.MS_REC | unix.MS_RDONLY | cgroupMountFlags
+
+	type testData struct {
+		unified  bool
+		cgroup   configs.Cgroup
+		expected []*configs.Mount
+	}
+
+	data := []testData{
+		{true, configs.Cgroup{Path: "/kata/ctr"}, []*configs.Mount{
+			procMount,
+			{Source: filepath.Join(tmpDir, "kata/ctr"), Destination: "/sys/fs/cgroup", Device: "bind",
+				Flags: bindFlags, PropagationFlags: []int{unix.MS_PRIVATE}},
+		}},
+		// The cgroup of a container without cgroups path is named after
+		// the container.
+		{true, configs.Cgroup{Name: "ctr"}, []*configs.Mount{
+			procMount,
+			{Source: filepath.Join(tmpDir, "ctr"), Destination: "/sys/fs/cgroup", Device: "bind",
+				Flags: bindFlags, PropagationFlags: []int{unix.MS_PRIVATE}},
+		}},
+		{false, configs.Cgroup{Path: "/kata/ctr"}, []*configs.Mount{
+			procMount,
+			{Source: "tmpfs", Destination: "/sys/fs/cgroup", Device: "tmpfs",
+				Flags: cgroupMountFlags, Data: "mode=755", PropagationFlags: []int{unix.MS_PRIVATE}},
+			{Source: filepath.Join(tmpDir, "cpu/kata/ctr"), Destination: "/sys/fs/cgroup/cpu", Device: "bind",
+				Flags: bindFlags, PropagationFlags: []int{unix.MS_PRIVATE}},
+			{Source: filepath.Join(tmpDir, "memory/kata/ctr"), Destination: "/sys/fs/cgroup/memory", Device: "bind",
+				Flags: bindFlags, PropagationFlags: []int{unix.MS_PRIVATE}},
+			{Source: "/rootfs/sys/fs/cgroup", Destination: "/sys/fs/cgroup", Device: "bind",
+				Flags: unix.MS_BIND | unix.MS_REMOUNT | unix.MS_RDONLY | cgroupMountFlags},
+		}},
+	}
+
+	for i, d := range data {
+		unifiedCgroupHierarchy = d.unified
+
+		cgroup := d.cgroup
+		config := &configs.Config{
+			Rootfs:  "/rootfs",
+			Cgroups: &cgroup,
+			Mounts:  []*configs.Mount{procMount, cgroupMount},
+		}
+
+		err := setupCgroupMounts(config)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expected, config.Mounts, "test %d (%+v)", i, d)
+	}
+
+	// Nothing to do without cgroup mount.
+	config := &configs.Config{Cgroups: &configs.Cgroup{Path: "/kata/ctr"}, Mounts: []*configs.Mount{procMount}}
+	err = setupCgroupMounts(config)
+	assert.NoError(err)
+	assert.Equal([]*configs.Mount{procMount}, config.Mounts)
+}
+
+func TestCgroupMountsReadonly(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedCgroupPath := cgroupPath
+	savedUnifiedCgroupHierarchy := unifiedCgroupHierarchy
+	defer func() {
+		cgroupPath = savedCgroupPath
+		unifiedCgroupHierarchy = savedUnifiedCgroupHierarchy
+	}()
+
+	// Directories standing for the cgroup of the container in each
+	// controller hierarchy.
+	cgroupPath = tmpDir
+	unifiedCgroupHierarchy = false
+	for _, controller := range []string{"cpu", "memory"} {
+		err := os.MkdirAll(filepath.Join(tmpDir, controller, "kata", "ctr"), 0755)
+		assert.NoError(err)
+		err = ioutil.WriteFile(filepath.Join(tmpDir, controller, "kata", "ctr", controller+".stat"), nil, 0644)
+		assert.NoError(err)
+	}
+
+	c, cleanup := createTestContainerWithConfig(t, "test-cgroup-mounts", func(config *configs.Config) {
+		config.Mounts = append(config.Mounts, &configs.Mount{Source: "cgroup", Destination: "/cgroup", Device: "cgroup"})
+		mountsConfig := &configs.Config{Rootfs: config.Rootfs, Cgroups: &configs.Cgroup{Path: "/kata/ctr"}, Mounts: config.Mounts}
+		err := setupCgroupMounts(mountsConfig)
+		assert.NoError(err)
+		config.Mounts = mountsConfig.Mounts
+	})
+	defer cleanup()
+
+	proc, err := buildProcess(&pb.Process{
+		Args: []string{"sh", "-c", "ls /cgroup/*; for f in /cgroup/file /cgroup/cpu/file; do touch $f 2>/dev/null || echo $f read-only; done"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	assert.Equal("/cgroup/cpu:\ncpu.stat\n\n/cgroup/memory:\nmemory.stat\n/cgroup/file read-only\n/cgroup/cpu/file read-only\n", runTestProcess(t, c, proc))
+}
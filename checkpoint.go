@@ -0,0 +1,183 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// checkCriuDirectory makes sure path is an absolute path to an existing
+// directory inside the VM.
+func checkCriuDirectory(path string) error {
+	if !filepath.IsAbs(path) {
+		return grpcStatus.Errorf(codes.InvalidArgument, "CRIU directory %q must be an absolute path", path)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid CRIU directory %q: %v", path, err)
+	}
+
+	if !info.IsDir() {
+		return grpcStatus.Errorf(codes.InvalidArgument, "CRIU directory %q is not a directory", path)
+	}
+
+	return nil
+}
+
+// buildCriuRestoreOpts converts the restore options of a CreateContainer
+// request into the options expected by libcontainer. The bind mounts of the
+// container config are passed to CRIU as external mounts by libcontainer.
+func buildCriuRestoreOpts(opts *pb.RestoreOptions) (*libcontainer.CriuOpts, error) {
+	if opts == nil {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "Restore options cannot be nil")
+	}
+
+	if opts.ImagePath == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "Restore image path cannot be empty")
+	}
+
+	if err := checkCriuDirectory(opts.ImagePath); err != nil {
+		return nil, err
+	}
+
+	workPath := opts.WorkPath
+	if workPath == "" {
+		workPath = opts.ImagePath
+	} else if err := checkCriuDirectory(workPath); err != nil {
+		return nil, err
+	}
+
+	return &libcontainer.CriuOpts{
+		ImagesDirectory:         opts.ImagePath,
+		WorkDirectory:           workPath,
+		TcpEstablished:          opts.TcpEstablished,
+		ExternalUnixConnections: opts.ExternalUnixConnections,
+		ShellJob:                opts.ShellJob,
+		FileLocks:               opts.FileLocks,
+	}, nil
+}
+
+// Permissions of the checkpoint image directory created by the agent.
+const checkpointDirMode = 0700
+
+// buildCriuCheckpointOpts converts a CheckpointContainer request into the
+// options expected by libcontainer, creating the image directory if needed.
+func buildCriuCheckpointOpts(req *pb.CheckpointContainerRequest) (*libcontainer.CriuOpts, error) {
+	if req.ImagePath == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "Checkpoint image path cannot be empty")
+	}
+
+	if !filepath.IsAbs(req.ImagePath) {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Checkpoint image path %q must be an absolute path", req.ImagePath)
+	}
+
+	if filepath.IsAbs(req.ParentPath) {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Checkpoint parent path %q must be relative to the image path", req.ParentPath)
+	}
+
+	if err := os.MkdirAll(req.ImagePath, checkpointDirMode); err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not create checkpoint image directory %q: %v", req.ImagePath, err)
+	}
+
+	if err := checkCriuDirectory(req.ImagePath); err != nil {
+		return nil, err
+	}
+
+	workPath := req.WorkPath
+	if workPath == "" {
+		workPath = req.ImagePath
+	} else if err := checkCriuDirectory(workPath); err != nil {
+		return nil, err
+	}
+
+	return &libcontainer.CriuOpts{
+		ImagesDirectory:         req.ImagePath,
+		WorkDirectory:           workPath,
+		ParentImage:             req.ParentPath,
+		LeaveRunning:            req.LeaveRunning,
+		PreDump:                 req.PreDump,
+		TcpEstablished:          req.TcpEstablished,
+		ExternalUnixConnections: req.ExternalUnixConnections,
+		ShellJob:                req.ShellJob,
+		FileLocks:               req.FileLocks,
+	}, nil
+}
+
+// checkpointContainer dumps the process tree of a running or paused
+// container into the image directory of the request.
+func checkpointContainer(ctr *container, req *pb.CheckpointContainerRequest) error {
+	status, err := ctr.container.Status()
+	if err != nil {
+		return err
+	}
+
+	if status != libcontainer.Running && status != libcontainer.Paused {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "Container %s status %s, should be %s or %s",
+			ctr.id, status.String(), libcontainer.Running.String(), libcontainer.Paused.String())
+	}
+
+	criuOpts, err := buildCriuCheckpointOpts(req)
+	if err != nil {
+		return err
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"container":     ctr.id,
+		"image-path":    criuOpts.ImagesDirectory,
+		"work-path":     criuOpts.WorkDirectory,
+		"leave-running": criuOpts.LeaveRunning,
+		"pre-dump":      criuOpts.PreDump,
+	}).Info("checkpointing container")
+
+	if err := ctr.container.Checkpoint(criuOpts); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not checkpoint container: %v", err)
+	}
+
+	return nil
+}
+
+// restoreProcess restores the init process of a container from a CRIU
+// checkpoint. This is the counterpart of execProcess for restored containers.
+func (a *agentGRPC) restoreProcess(ctr *container, proc *process, opts *pb.RestoreOptions) error {
+	if ctr == nil {
+		return grpcStatus.Error(codes.InvalidArgument, "Container cannot be nil")
+	}
+
+	if proc == nil {
+		return grpcStatus.Error(codes.InvalidArgument, "Process cannot be nil")
+	}
+
+	criuOpts, err := buildCriuRestoreOpts(opts)
+	if err != nil {
+		return err
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"container":  ctr.id,
+		"image-path": criuOpts.ImagesDirectory,
+		"work-path":  criuOpts.WorkDirectory,
+	}).Info("restoring container from checkpoint")
+
+	// The restored processes are children of the agent, take the reaper
+	// lock for the same reasons as execProcess().
+	a.sandbox.subreaper.lock()
+	defer a.sandbox.subreaper.unlock()
+
+	if err := ctr.container.Restore(&proc.process, criuOpts); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not restore container: %v", err)
+	}
+
+	return a.setProcessExitCodeCh(ctr, proc)
+}
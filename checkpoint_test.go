@@ -0,0 +1,266 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCriuRestoreOpts(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "criu")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	imagePath := filepath.Join(tmpDir, "images")
+	workPath := filepath.Join(tmpDir, "work")
+	filePath := filepath.Join(tmpDir, "file")
+
+	assert.NoError(os.Mkdir(imagePath, 0755))
+	assert.NoError(os.Mkdir(workPath, 0755))
+	assert.NoError(createEmptyFile(filePath))
+
+	type testData struct {
+		opts         *pb.RestoreOptions
+		expectedOpts *libcontainer.CriuOpts
+		expectError  bool
+	}
+
+	data := []testData{
+		{nil, nil, true},
+		{&pb.RestoreOptions{}, nil, true},
+		{&pb.RestoreOptions{ImagePath: "images"}, nil, true},
+		{&pb.RestoreOptions{ImagePath: filepath.Join(tmpDir, "foo")}, nil, true},
+		{&pb.RestoreOptions{ImagePath: filePath}, nil, true},
+		{&pb.RestoreOptions{ImagePath: imagePath, WorkPath: filePath}, nil, true},
+		{
+			&pb.RestoreOptions{ImagePath: imagePath},
+			&libcontainer.CriuOpts{
+				ImagesDirectory: imagePath,
+				WorkDirectory:   imagePath,
+			},
+			false,
+		},
+		{
+			&pb.RestoreOptions{
+				ImagePath:               imagePath,
+				WorkPath:                workPath,
+				TcpEstablished:          true,
+				ExternalUnixConnections: true,
+				ShellJob:                true,
+				FileLocks:               true,
+			},
+			&libcontainer.CriuOpts{
+				ImagesDirectory:         imagePath,
+				WorkDirectory:           workPath,
+				TcpEstablished:          true,
+				ExternalUnixConnections: true,
+				ShellJob:                true,
+				FileLocks:               true,
+			},
+			false,
+		},
+	}
+
+	for i, d := range data {
+		opts, err := buildCriuRestoreOpts(d.opts)
+		if d.expectError {
+			assert.Error(err, "test %d", i)
+			continue
+		}
+
+		assert.NoError(err, "test %d", i)
+		assert.Equal(d.expectedOpts, opts, "test %d", i)
+	}
+}
+
+func TestRestoreProcess(t *testing.T) {
+	assert := assert.New(t)
+
+	imagePath, err := ioutil.TempDir("", "criu")
+	assert.NoError(err)
+	defer os.RemoveAll(imagePath)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			subreaper:  &agentReaper{},
+		},
+	}
+
+	opts := &pb.RestoreOptions{
+		ImagePath:      imagePath,
+		TcpEstablished: true,
+	}
+
+	err = a.restoreProcess(nil, &process{}, opts)
+	assert.Error(err)
+
+	mockCtr := &mockContainer{}
+	ctr := &container{container: mockCtr}
+
+	err = a.restoreProcess(ctr, nil, opts)
+	assert.Error(err)
+
+	err = a.restoreProcess(ctr, &process{}, &pb.RestoreOptions{})
+	assert.Error(err)
+	assert.Nil(mockCtr.criuOpts)
+
+	// The mock restore does not start any process, hence the PID of
+	// the init process cannot be retrieved.
+	err = a.restoreProcess(ctr, &process{}, opts)
+	assert.Error(err)
+	assert.NotNil(mockCtr.criuOpts)
+	assert.Equal(imagePath, mockCtr.criuOpts.ImagesDirectory)
+	assert.Equal(imagePath, mockCtr.criuOpts.WorkDirectory)
+	assert.True(mockCtr.criuOpts.TcpEstablished)
+	assert.False(mockCtr.criuOpts.ShellJob)
+}
+
+func TestBuildCriuCheckpointOpts(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "criu")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	imagePath := filepath.Join(tmpDir, "images")
+	workPath := filepath.Join(tmpDir, "work")
+	filePath := filepath.Join(tmpDir, "file")
+
+	assert.NoError(os.Mkdir(workPath, 0755))
+	assert.NoError(createEmptyFile(filePath))
+
+	type testData struct {
+		req          *pb.CheckpointContainerRequest
+		expectedOpts *libcontainer.CriuOpts
+		expectError  bool
+	}
+
+	data := []testData{
+		{&pb.CheckpointContainerRequest{}, nil, true},
+		{&pb.CheckpointContainerRequest{ImagePath: "images"}, nil, true},
+		{&pb.CheckpointContainerRequest{ImagePath: filePath}, nil, true},
+		{&pb.CheckpointContainerRequest{ImagePath: imagePath, ParentPath: "/parent"}, nil, true},
+		{&pb.CheckpointContainerRequest{ImagePath: imagePath, WorkPath: filepath.Join(tmpDir, "foo")}, nil, true},
+		{
+			&pb.CheckpointContainerRequest{ImagePath: imagePath},
+			&libcontainer.CriuOpts{
+				ImagesDirectory: imagePath,
+				WorkDirectory:   imagePath,
+			},
+			false,
+		},
+		{
+			&pb.CheckpointContainerRequest{
+				ImagePath:      imagePath,
+				WorkPath:       workPath,
+				PreDump:        true,
+				TcpEstablished: true,
+			},
+			&libcontainer.CriuOpts{
+				ImagesDirectory: imagePath,
+				WorkDirectory:   workPath,
+				PreDump:         true,
+				TcpEstablished:  true,
+			},
+			false,
+		},
+		{
+			&pb.CheckpointContainerRequest{
+				ImagePath:               imagePath,
+				ParentPath:              "../pre-dump",
+				LeaveRunning:            true,
+				ExternalUnixConnections: true,
+				ShellJob:                true,
+				FileLocks:               true,
+			},
+			&libcontainer.CriuOpts{
+				ImagesDirectory:         imagePath,
+				WorkDirectory:           imagePath,
+				ParentImage:             "../pre-dump",
+				LeaveRunning:            true,
+				ExternalUnixConnections: true,
+				ShellJob:                true,
+				FileLocks:               true,
+			},
+			false,
+		},
+	}
+
+	for i, d := range data {
+		opts, err := buildCriuCheckpointOpts(d.req)
+		if d.expectError {
+			assert.Error(err, "test %d", i)
+			continue
+		}
+
+		assert.NoError(err, "test %d", i)
+		assert.Equal(d.expectedOpts, opts, "test %d", i)
+
+		info, err := os.Stat(imagePath)
+		assert.NoError(err, "test %d", i)
+		assert.True(info.IsDir(), "test %d", i)
+	}
+}
+
+func TestCheckpointContainer(t *testing.T) {
+	assert := assert.New(t)
+
+	imagePath, err := ioutil.TempDir("", "criu")
+	assert.NoError(err)
+	defer os.RemoveAll(imagePath)
+
+	containerID := "1"
+	mockCtr := &mockContainer{
+		id:     containerID,
+		status: libcontainer.Created,
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				containerID: {
+					id:        containerID,
+					container: mockCtr,
+				},
+			},
+		},
+	}
+
+	req := &pb.CheckpointContainerRequest{
+		ContainerId:  containerID,
+		ImagePath:    imagePath,
+		LeaveRunning: true,
+	}
+
+	_, err = a.CheckpointContainer(context.TODO(), &pb.CheckpointContainerRequest{ContainerId: "2"})
+	assert.Error(err)
+
+	// The container is not running
+	_, err = a.CheckpointContainer(context.TODO(), req)
+	assert.Error(err)
+	assert.Nil(mockCtr.criuOpts)
+
+	mockCtr.status = libcontainer.Running
+
+	resp, err := a.CheckpointContainer(context.TODO(), req)
+	assert.NoError(err)
+	assert.Equal(imagePath, resp.ImagePath)
+	assert.NotNil(mockCtr.criuOpts)
+	assert.True(mockCtr.criuOpts.LeaveRunning)
+	assert.Equal(imagePath, mockCtr.criuOpts.ImagesDirectory)
+}
@@ -0,0 +1,168 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Initial interval between the attempts to change the ownership of a file.
+var chownRetryInterval = 10 * time.Millisecond
+
+// Maximum number of failing paths detailed by the error of a storage
+// ownership change, all of them being logged.
+const maxReportedChownFailures = 10
+
+// isOwnedBy returns whether the file described by info is owned by uid and
+// gid.
+func isOwnedBy(info os.FileInfo, uid, gid int) bool {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	return ok && int(stat.Uid) == uid && int(stat.Gid) == gid
+}
+
+// lchownRetry changes the ownership of path, retrying up to retries times
+// on failure.
+func lchownRetry(ctx context.Context, path string, uid, gid, retries int) error {
+	b := newBackoff(chownRetryInterval, 10*chownRetryInterval)
+
+	for attempt := 0; ; attempt++ {
+		err := os.Lchown(path, uid, gid)
+		if err == nil || attempt >= retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(b.next()):
+		}
+	}
+}
+
+// chownTree changes the ownership of root and of everything below it to
+// uid and gid, without following the symbolic links. The files already
+// owned by uid and gid are left untouched. A failing file is retried up to
+// retries times, then reported with its path, and the walk goes on with the
+// other files. The walk stops once ctx is done. All the failures are
+// returned. The ownership of root is only changed last, once everything
+// below it was changed, so that root being owned by uid and gid means a
+// previous change completed.
+func chownTree(ctx context.Context, root string, uid, gid, retries int) []error {
+	var errs []error
+	var rootInfo os.FileInfo
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		// The directories which cannot be read are reported and skipped.
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+
+		if isOwnedBy(info, uid, gid) {
+			return nil
+		}
+
+		if path == root {
+			rootInfo = info
+			return nil
+		}
+
+		if err := lchownRetry(ctx, path, uid, gid, retries); err != nil {
+			errs = append(errs, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		errs = append(errs, fmt.Errorf("ownership change of %s interrupted: %v", root, err))
+	}
+
+	if len(errs) == 0 && rootInfo != nil {
+		if err := lchownRetry(ctx, root, uid, gid, retries); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// setStorageOwnership applies the ownership requested by storage to the
+// files mounted at mountPoint.
+func setStorageOwnership(ctx context.Context, storage pb.Storage, mountPoint string) error {
+	owner := storage.Ownership
+	if owner == nil || mountPoint == "" {
+		return nil
+	}
+
+	uid, gid := int(owner.Uid), int(owner.Gid)
+
+	fieldLogger := agentLog.WithFields(logrus.Fields{
+		"mount-point": mountPoint,
+		"uid":         uid,
+		"gid":         gid,
+	})
+
+	if owner.SkipOnMatch {
+		info, err := os.Lstat(mountPoint)
+		if err != nil {
+			return err
+		}
+
+		if isOwnedBy(info, uid, gid) {
+			fieldLogger.Debug("Storage already owned, skipping ownership change")
+			return nil
+		}
+	}
+
+	if owner.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(owner.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	errs := chownTree(ctx, mountPoint, uid, gid, int(owner.Retries))
+	if len(errs) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, maxReportedChownFailures+1)
+	for i, err := range errs {
+		fieldLogger.WithError(err).Warn("Could not change file ownership")
+
+		if i < maxReportedChownFailures {
+			msgs = append(msgs, err.Error())
+		}
+	}
+
+	if len(errs) > maxReportedChownFailures {
+		msgs = append(msgs, fmt.Sprintf("and %d more", len(errs)-maxReportedChownFailures))
+	}
+
+	code := codes.Internal
+	if ctx.Err() == context.DeadlineExceeded {
+		code = codes.DeadlineExceeded
+	}
+
+	return grpcStatus.Errorf(code, "Could not change the ownership of storage %s: %s",
+		mountPoint, strings.Join(msgs, "; "))
+}
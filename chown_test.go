@@ -0,0 +1,214 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	testChownUID = 1000
+	testChownGID = 1001
+)
+
+// createChownTree creates a directory tree with files in root, returning
+// its paths.
+func createChownTree(t *testing.T, root string, files int) []string {
+	paths := []string{root}
+
+	dir := filepath.Join(root, "dir")
+	err := os.Mkdir(dir, 0755)
+	assert.NoError(t, err)
+	paths = append(paths, dir)
+
+	for i := 0; i < files; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("file%d", i))
+		err := ioutil.WriteFile(path, nil, 0644)
+		assert.NoError(t, err)
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+func assertOwnedBy(t *testing.T, path string, uid, gid int) {
+	info, err := os.Lstat(path)
+	assert.NoError(t, err)
+	assert.True(t, isOwnedBy(info, uid, gid), "%s owned by %+v", path, info.Sys())
+}
+
+func TestChownTree(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "chown")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	root := filepath.Join(tmpDir, "root")
+	err = os.Mkdir(root, 0755)
+	assert.NoError(err)
+	paths := createChownTree(t, root, 3)
+
+	// The symbolic links are changed, not their targets.
+	target := filepath.Join(tmpDir, "target")
+	err = ioutil.WriteFile(target, nil, 0644)
+	assert.NoError(err)
+	link := filepath.Join(root, "link")
+	err = os.Symlink(target, link)
+	assert.NoError(err)
+
+	errs := chownTree(context.Background(), root, testChownUID, testChownGID, 0)
+	assert.Empty(errs)
+
+	for _, path := range append(paths, link) {
+		assertOwnedBy(t, path, testChownUID, testChownGID)
+	}
+	assertOwnedBy(t, target, 0, 0)
+
+	// Nothing is changed once the walk is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs = chownTree(ctx, root, 0, 0, 0)
+	assert.Len(errs, 1)
+	assertOwnedBy(t, root, testChownUID, testChownGID)
+}
+
+func TestChownTreeFailures(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	savedInterval := chownRetryInterval
+	chownRetryInterval = 0
+	defer func() {
+		chownRetryInterval = savedInterval
+	}()
+
+	root, err := ioutil.TempDir("", "chown")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	err = syscall.Mount("tmpfs", root, "tmpfs", 0, "")
+	assert.NoError(err)
+	defer syscall.Unmount(root, 0)
+
+	paths := createChownTree(t, root, maxReportedChownFailures)
+
+	err = syscall.Mount("", root, "", syscall.MS_REMOUNT|syscall.MS_RDONLY, "")
+	assert.NoError(err)
+
+	// Every file fails, and is reported once, without stopping the walk.
+	// The root is left untouched.
+	errs := chownTree(context.Background(), root, testChownUID, testChownGID, 2)
+	assert.Len(errs, len(paths)-1)
+	for i, err := range errs {
+		assert.Equal(&os.PathError{Op: "lchown", Path: paths[i+1], Err: syscall.EROFS}, err, "test %d", i)
+	}
+	assertOwnedBy(t, root, 0, 0)
+
+	storage := pb.Storage{Ownership: &pb.StorageOwnership{Uid: testChownUID, Gid: testChownGID}}
+	err = setStorageOwnership(context.Background(), storage, root)
+	assert.Equal(codes.Internal, grpcStatus.Code(err))
+	assert.Contains(err.Error(), fmt.Sprintf("and %d more", len(paths)-1-maxReportedChownFailures))
+}
+
+func TestSetStorageOwnershipRerun(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "chown")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	paths := createChownTree(t, root, 2)
+
+	// A previous change failed, a directory of the tree being read-only.
+	readOnly := filepath.Join(root, "read-only")
+	err = os.Mkdir(readOnly, 0755)
+	assert.NoError(err)
+	paths = append(paths, readOnly)
+
+	err = syscall.Mount("tmpfs", readOnly, "tmpfs", syscall.MS_RDONLY, "")
+	assert.NoError(err)
+
+	ownership := &pb.StorageOwnership{Uid: testChownUID, Gid: testChownGID, SkipOnMatch: true}
+	err = setStorageOwnership(context.Background(), pb.Storage{Ownership: ownership}, root)
+	assert.Error(err)
+	assertOwnedBy(t, root, 0, 0)
+
+	err = syscall.Unmount(readOnly, 0)
+	assert.NoError(err)
+
+	// The rerun is not skipped, and completes the change.
+	err = setStorageOwnership(context.Background(), pb.Storage{Ownership: ownership}, root)
+	assert.NoError(err)
+
+	for _, path := range paths {
+		assertOwnedBy(t, path, testChownUID, testChownGID)
+	}
+}
+
+func TestSetStorageOwnership(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "chown")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	paths := createChownTree(t, root, 2)
+
+	type testData struct {
+		ownership *pb.StorageOwnership
+		rootOwned bool
+		// ownership of the last file
+		expectedUID int
+		expectedGID int
+	}
+
+	data := []testData{
+		{nil, false, 0, 0},
+		{&pb.StorageOwnership{Uid: testChownUID, Gid: testChownGID}, false, testChownUID, testChownGID},
+		// The mount point already has the ownership, the files are
+		// skipped.
+		{&pb.StorageOwnership{Uid: testChownUID, Gid: testChownGID, SkipOnMatch: true}, true, 0, 0},
+		{&pb.StorageOwnership{Uid: testChownUID, Gid: testChownGID, SkipOnMatch: true}, false, testChownUID, testChownGID},
+		{&pb.StorageOwnership{Uid: testChownUID, Gid: testChownGID, Timeout: 10}, true, testChownUID, testChownGID},
+	}
+
+	for i, d := range data {
+		for _, path := range paths {
+			err := os.Lchown(path, 0, 0)
+			assert.NoError(err)
+		}
+
+		if d.rootOwned {
+			err := os.Lchown(root, testChownUID, testChownGID)
+			assert.NoError(err)
+		}
+
+		err := setStorageOwnership(context.Background(), pb.Storage{Ownership: d.ownership}, root)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assertOwnedBy(t, paths[len(paths)-1], d.expectedUID, d.expectedGID)
+	}
+}
@@ -8,6 +8,8 @@ package main
 
 import (
 	"io/ioutil"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -27,8 +29,37 @@ const (
 	debugConsoleFlag           = optionPrefix + "debug_console"
 	debugConsoleVPortFlag      = optionPrefix + "debug_console_vport"
 	hotplugTimeoutFlag         = optionPrefix + "hotplug_timeout"
+	hotplugMaxIntervalFlag     = optionPrefix + "hotplug_max_interval"
 	unifiedCgroupHierarchyFlag = optionPrefix + "unified_cgroup_hierarchy"
 	containerPipeSizeFlag      = optionPrefix + "container_pipe_size"
+	containerUmaskFlag         = optionPrefix + "container_umask"
+	containerLogDirFlag        = optionPrefix + "container_log_dir"
+	containerLogMaxSizeFlag    = optionPrefix + "container_log_max_size"
+	containerLogMaxBackupsFlag = optionPrefix + "container_log_max_backups"
+	containerLogCompressFlag   = optionPrefix + "container_log_compress"
+	hookEnvAllowlistFlag       = optionPrefix + "hook_env_allowlist"
+	resolvConfModeFlag         = optionPrefix + "resolv_conf_mode"
+	indentSpecFileFlag         = optionPrefix + "indent_spec_file"
+	hookNonExecutableFlag      = optionPrefix + "hook_non_executable"
+	hooksMaxFlag               = optionPrefix + "hooks_max"
+	hooksTimeoutFlag           = optionPrefix + "hooks_timeout"
+	hooksPolicyFlag            = optionPrefix + "hooks_policy"
+	createTimeoutFlag          = optionPrefix + "create_timeout"
+	idleTimeoutFlag            = optionPrefix + "idle_timeout"
+	resolveUserGroupsFlag      = optionPrefix + "resolve_user_groups"
+	mountLogThresholdFlag      = optionPrefix + "mount_log_threshold"
+	logRedactEnvFlag           = optionPrefix + "log_redact_env"
+	logRedactAnnotationsFlag   = optionPrefix + "log_redact_annotations"
+	ociConfigFallbackPathFlag  = optionPrefix + "oci_config_fallback_path"
+	logBufferSizeFlag          = optionPrefix + "log_buffer_size"
+	mountOptionsDenyFlag       = optionPrefix + "mount_options_deny"
+	mountOptionsAllowFlag      = optionPrefix + "mount_options_allow"
+	mountOptionsPolicyFlag     = optionPrefix + "mount_options_policy"
+	mountOptionsPolicyStrip    = "strip"
+	mountOptionsPolicyReject   = "reject"
+	streamBufferSizeFlag       = optionPrefix + "stream_buffer_size"
+	streamBufferTotalFlag      = optionPrefix + "stream_buffer_total"
+	streamBufferPolicyFlag     = optionPrefix + "stream_buffer_policy"
 	traceModeStatic            = "static"
 	traceModeDynamic           = "dynamic"
 	traceTypeIsolated          = "isolated"
@@ -124,12 +155,193 @@ func parseCmdlineOption(option string) error {
 		if timeout > 0 {
 			hotplugTimeout = timeout
 		}
+	case hotplugMaxIntervalFlag:
+		interval, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		// Only use the provided interval if a positive value is provided
+		if interval > 0 {
+			hotplugMaxInterval = interval
+		}
 	case containerPipeSizeFlag:
 		size, err := strconv.ParseUint(split[valuePosition], 10, 32)
 		if err != nil {
 			return err
 		}
 		containerPipeSize = uint32(size)
+	case containerUmaskFlag:
+		umask, err := strconv.ParseUint(split[valuePosition], 8, 32)
+		if err != nil {
+			return err
+		}
+		if umask&^0777 != 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid container umask %#o", umask)
+		}
+		containerDefaultUmask = uint32(umask)
+	case containerLogDirFlag:
+		if !filepath.IsAbs(split[valuePosition]) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Container log directory %q must be absolute", split[valuePosition])
+		}
+		containerLogDir = split[valuePosition]
+	case containerLogMaxSizeFlag:
+		size, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		if size > 0 {
+			containerLogMaxSize = uint32(size)
+		}
+	case containerLogMaxBackupsFlag:
+		backups, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		containerLogMaxBackups = uint32(backups)
+	case containerLogCompressFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		containerLogCompress = flag
+	case hookEnvAllowlistFlag:
+		hookEnvAllowlist = splitOptionList(split[valuePosition])
+	case indentSpecFileFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		indentSpecFile = flag
+	case hookNonExecutableFlag:
+		switch split[valuePosition] {
+		case hookNonExecutableReject, hookNonExecutableShebang:
+			hookNonExecutablePolicy = split[valuePosition]
+		default:
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid non executable hook policy %q", split[valuePosition])
+		}
+	case hooksMaxFlag:
+		max, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		hooksMax = uint32(max)
+	case hooksTimeoutFlag:
+		timeout, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		if timeout < 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid hooks timeout %q", split[valuePosition])
+		}
+		hooksTimeout = timeout
+	case hooksPolicyFlag:
+		switch split[valuePosition] {
+		case hooksPolicyOrdered, hooksPolicySorted, hooksPolicyConcurrent:
+			hooksPolicy = split[valuePosition]
+		default:
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid hooks policy %q", split[valuePosition])
+		}
+	case createTimeoutFlag:
+		timeout, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		if timeout < 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid create timeout %q", split[valuePosition])
+		}
+		createTimeout = timeout
+	case idleTimeoutFlag:
+		timeout, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		if timeout < 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid idle timeout %q", split[valuePosition])
+		}
+		idleTimeout = timeout
+	case resolveUserGroupsFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		resolveUserGroups = flag
+	case mountLogThresholdFlag:
+		threshold, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		if threshold < 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid mount log threshold %q", split[valuePosition])
+		}
+		mountLogThreshold = threshold
+	case logRedactEnvFlag:
+		// The pattern itself may hold a separator.
+		value := strings.SplitN(option, optionSeparator, 2)[valuePosition]
+		if value == "" {
+			redactEnvPattern = nil
+			break
+		}
+		pattern, err := regexp.Compile(value)
+		if err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid log redaction pattern %q: %v", value, err)
+		}
+		redactEnvPattern = pattern
+	case logRedactAnnotationsFlag:
+		redactAnnotations = splitOptionList(split[valuePosition])
+	case ociConfigFallbackPathFlag:
+		if !filepath.IsAbs(split[valuePosition]) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid OCI config fallback path %q: must be absolute", split[valuePosition])
+		}
+		ociConfigFallbackPath = filepath.Clean(split[valuePosition])
+	case resolvConfModeFlag:
+		switch split[valuePosition] {
+		case resolvConfModeShared, resolvConfModeCopy:
+			resolvConfMode = split[valuePosition]
+		default:
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid resolv.conf mode %q", split[valuePosition])
+		}
+	case logBufferSizeFlag:
+		size, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		logBufferSize = uint32(size)
+	case mountOptionsDenyFlag:
+		deniedMountOptions = splitOptionList(split[valuePosition])
+	case mountOptionsAllowFlag:
+		allowedMountOptions = splitOptionList(split[valuePosition])
+	case mountOptionsPolicyFlag:
+		switch split[valuePosition] {
+		case mountOptionsPolicyStrip:
+			rejectForbiddenMountOptions = false
+		case mountOptionsPolicyReject:
+			rejectForbiddenMountOptions = true
+		default:
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid mount options policy %q", split[valuePosition])
+		}
+	case streamBufferSizeFlag:
+		size, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		if size > 0 {
+			streamBufferSize = uint32(size)
+		}
+	case streamBufferTotalFlag:
+		size, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		if size > 0 {
+			streamBufferTotal = uint32(size)
+		}
+	case streamBufferPolicyFlag:
+		switch split[valuePosition] {
+		case streamBufferPolicyBlock, streamBufferPolicyDrop:
+			streamBufferPolicy = split[valuePosition]
+		default:
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid stream buffer policy %q", split[valuePosition])
+		}
 	case traceModeFlag:
 		switch split[valuePosition] {
 		case traceTypeIsolated:
@@ -164,6 +376,18 @@ func parseCmdlineOption(option string) error {
 	return nil
 }
 
+// splitOptionList splits a comma separated list, ignoring empty elements.
+func splitOptionList(value string) []string {
+	var list []string
+	for _, elem := range strings.Split(value, ",") {
+		if elem != "" {
+			list = append(list, elem)
+		}
+	}
+
+	return list
+}
+
 func enableTracing(traceMode, traceType string) {
 	tracing = true
 
@@ -9,6 +9,7 @@ package main
 import (
 	"io/ioutil"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -411,6 +412,43 @@ func TestParseCmdlineOptionHotplugTimeout(t *testing.T) {
 	}
 }
 
+func TestParseCmdlineOptionHotplugMaxInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option           string
+		shouldErr        bool
+		expectedInterval time.Duration
+	}
+
+	defaultInterval := 500 * time.Millisecond
+
+	data := []testData{
+		{"", false, defaultInterval},
+		{"hotplug_max_interval=1s", false, defaultInterval},
+		{"agent.hotplug_max_interval", false, defaultInterval},
+		{"agent.hotplug_max_interval=1s", false, 1 * time.Second},
+		{"agent.hotplug_max_interval=20ms", false, 20 * time.Millisecond},
+		{"agent.hotplug_max_interval=0", false, defaultInterval},
+		{"agent.hotplug_max_interval=-1", true, defaultInterval},
+		{"agent.hotplug_max_interval=foobar", true, defaultInterval},
+	}
+
+	for i, d := range data {
+		// reset the hotplug max interval
+		hotplugMaxInterval = defaultInterval
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedInterval, hotplugMaxInterval, "test %d (%+v)", i, d)
+	}
+}
+
 func TestParseCmdlineOptionUnifiedCgroupHierarchy(t *testing.T) {
 	assert := assert.New(t)
 
@@ -486,3 +524,713 @@ func TestParseCmdlineOptionContainerPipeSize(t *testing.T) {
 		assert.Equal(d.expectedContainerPipeSize, containerPipeSize, "test %d (%+v)", i, d)
 	}
 }
+
+func TestParseCmdlineOptionContainerUmask(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option        string
+		shouldErr     bool
+		expectedUmask uint32
+	}
+
+	data := []testData{
+		{"", false, defaultContainerUmask},
+		{"container_umask=077", false, defaultContainerUmask},
+		{"agent.container_umask=0077", false, 0077},
+		{"agent.container_umask=027", false, 0027},
+		{"agent.container_umask=0", false, 0},
+		{"agent.container_umask=0778", true, defaultContainerUmask},
+		{"agent.container_umask=01777", true, defaultContainerUmask},
+		{"agent.container_umask=foo", true, defaultContainerUmask},
+	}
+
+	defer func() {
+		containerDefaultUmask = defaultContainerUmask
+	}()
+
+	for i, d := range data {
+		containerDefaultUmask = defaultContainerUmask
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedUmask, containerDefaultUmask, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionContainerLog(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option             string
+		shouldErr          bool
+		expectedDir        string
+		expectedMaxSize    uint32
+		expectedMaxBackups uint32
+		expectedCompress   bool
+	}
+
+	data := []testData{
+		{"", false, "", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, false},
+		{"container_log_dir=/run/logs", false, "", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, false},
+		{"agent.container_log_dir=/run/logs", false, "/run/logs", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, false},
+		{"agent.container_log_dir=run/logs", true, "", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, false},
+		{"agent.container_log_max_size=1024", false, "", 1024, defaultContainerLogMaxBackups, false},
+		{"agent.container_log_max_size=0", false, "", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, false},
+		{"agent.container_log_max_size=foo", true, "", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, false},
+		{"agent.container_log_max_backups=0", false, "", defaultContainerLogMaxSize, 0, false},
+		{"agent.container_log_max_backups=-1", true, "", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, false},
+		{"agent.container_log_compress=true", false, "", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, true},
+		{"agent.container_log_compress=foo", true, "", defaultContainerLogMaxSize, defaultContainerLogMaxBackups, false},
+	}
+
+	reset := func() {
+		containerLogDir = ""
+		containerLogMaxSize = defaultContainerLogMaxSize
+		containerLogMaxBackups = defaultContainerLogMaxBackups
+		containerLogCompress = false
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedDir, containerLogDir, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedMaxSize, containerLogMaxSize, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedMaxBackups, containerLogMaxBackups, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedCompress, containerLogCompress, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionHookEnvAllowlist(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option            string
+		expectedAllowlist []string
+	}
+
+	data := []testData{
+		{"", defaultHookEnvAllowlist},
+		{"hook_env_allowlist=HOME", defaultHookEnvAllowlist},
+		{"agent.hook_env_allowlist", defaultHookEnvAllowlist},
+		{"agent.hook_env_allowlist=", nil},
+		{"agent.hook_env_allowlist=PATH,HOME", []string{"PATH", "HOME"}},
+		{"agent.hook_env_allowlist=PATH,,HOME", []string{"PATH", "HOME"}},
+	}
+
+	reset := func() {
+		hookEnvAllowlist = defaultHookEnvAllowlist
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		assert.NoError(err)
+
+		assert.Equal(d.expectedAllowlist, hookEnvAllowlist, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionResolvConfMode(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option       string
+		shouldErr    bool
+		expectedMode string
+	}
+
+	data := []testData{
+		{"", false, ""},
+		{"resolv_conf_mode=shared", false, ""},
+		{"agent.resolv_conf_mode", false, ""},
+		{"agent.resolv_conf_mode=shared", false, resolvConfModeShared},
+		{"agent.resolv_conf_mode=copy", false, resolvConfModeCopy},
+		{"agent.resolv_conf_mode=foobar", true, ""},
+	}
+
+	reset := func() {
+		resolvConfMode = ""
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedMode, resolvConfMode, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionIndentSpecFile(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option         string
+		shouldErr      bool
+		expectedIndent bool
+	}
+
+	data := []testData{
+		{"", false, false},
+		{"indent_spec_file=true", false, false},
+		{"agent.indent_spec_file", false, false},
+		{"agent.indent_spec_file=true", false, true},
+		{"agent.indent_spec_file=false", false, false},
+		{"agent.indent_spec_file=foo", true, false},
+	}
+
+	reset := func() {
+		indentSpecFile = false
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedIndent, indentSpecFile, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionHookNonExecutable(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option         string
+		shouldErr      bool
+		expectedPolicy string
+	}
+
+	data := []testData{
+		{"", false, hookNonExecutableReject},
+		{"hook_non_executable=shebang", false, hookNonExecutableReject},
+		{"agent.hook_non_executable", false, hookNonExecutableReject},
+		{"agent.hook_non_executable=shebang", false, hookNonExecutableShebang},
+		{"agent.hook_non_executable=reject", false, hookNonExecutableReject},
+		{"agent.hook_non_executable=foobar", true, hookNonExecutableReject},
+	}
+
+	reset := func() {
+		hookNonExecutablePolicy = hookNonExecutableReject
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedPolicy, hookNonExecutablePolicy, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionHooksLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option          string
+		shouldErr       bool
+		expectedMax     uint32
+		expectedTimeout time.Duration
+	}
+
+	data := []testData{
+		{"", false, 0, 0},
+		{"hooks_max=10", false, 0, 0},
+		{"agent.hooks_max", false, 0, 0},
+		{"agent.hooks_max=10", false, 10, 0},
+		{"agent.hooks_max=0", false, 0, 0},
+		{"agent.hooks_max=-1", true, 0, 0},
+		{"agent.hooks_max=foo", true, 0, 0},
+		{"agent.hooks_timeout=30s", false, 0, 30 * time.Second},
+		{"agent.hooks_timeout=0", false, 0, 0},
+		{"agent.hooks_timeout=-1s", true, 0, 0},
+		{"agent.hooks_timeout=10", true, 0, 0},
+	}
+
+	reset := func() {
+		hooksMax = 0
+		hooksTimeout = 0
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedMax, hooksMax, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedTimeout, hooksTimeout, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionCreateTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option          string
+		shouldErr       bool
+		expectedTimeout time.Duration
+	}
+
+	data := []testData{
+		{"", false, 0},
+		{"create_timeout=30s", false, 0},
+		{"agent.create_timeout", false, 0},
+		{"agent.create_timeout=30s", false, 30 * time.Second},
+		{"agent.create_timeout=1m30s", false, 90 * time.Second},
+		{"agent.create_timeout=0", false, 0},
+		{"agent.create_timeout=-1s", true, 0},
+		{"agent.create_timeout=10", true, 0},
+	}
+
+	reset := func() {
+		createTimeout = 0
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedTimeout, createTimeout, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionIdleTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option          string
+		shouldErr       bool
+		expectedTimeout time.Duration
+	}
+
+	data := []testData{
+		{"", false, 0},
+		{"idle_timeout=10m", false, 0},
+		{"agent.idle_timeout", false, 0},
+		{"agent.idle_timeout=10m", false, 10 * time.Minute},
+		{"agent.idle_timeout=0", false, 0},
+		{"agent.idle_timeout=-1m", true, 0},
+		{"agent.idle_timeout=600", true, 0},
+	}
+
+	reset := func() {
+		idleTimeout = 0
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedTimeout, idleTimeout, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionResolveUserGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option        string
+		shouldErr     bool
+		expectedValue bool
+	}
+
+	data := []testData{
+		{"", false, false},
+		{"resolve_user_groups=true", false, false},
+		{"agent.resolve_user_groups", false, false},
+		{"agent.resolve_user_groups=true", false, true},
+		{"agent.resolve_user_groups=1", false, true},
+		{"agent.resolve_user_groups=false", false, false},
+		{"agent.resolve_user_groups=yes", true, false},
+	}
+
+	reset := func() {
+		resolveUserGroups = false
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedValue, resolveUserGroups, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionMountLogThreshold(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option            string
+		shouldErr         bool
+		expectedThreshold time.Duration
+	}
+
+	data := []testData{
+		{"", false, 0},
+		{"mount_log_threshold=1s", false, 0},
+		{"agent.mount_log_threshold", false, 0},
+		{"agent.mount_log_threshold=1s", false, time.Second},
+		{"agent.mount_log_threshold=500ms", false, 500 * time.Millisecond},
+		{"agent.mount_log_threshold=0", false, 0},
+		{"agent.mount_log_threshold=-1s", true, 0},
+		{"agent.mount_log_threshold=100", true, 0},
+	}
+
+	reset := func() {
+		mountLogThreshold = 0
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedThreshold, mountLogThreshold, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionLogRedactEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option          string
+		shouldErr       bool
+		expectedPattern string
+	}
+
+	data := []testData{
+		{"", false, defaultRedactEnvPattern},
+		{"log_redact_env=^APP_", false, defaultRedactEnvPattern},
+		{"agent.log_redact_env", false, defaultRedactEnvPattern},
+		{"agent.log_redact_env=^APP_", false, "^APP_"},
+		{"agent.log_redact_env=^(KEY|SECRET)=?$", false, "^(KEY|SECRET)=?$"},
+		{"agent.log_redact_env=", false, ""},
+		{"agent.log_redact_env=(", true, defaultRedactEnvPattern},
+	}
+
+	reset := func() {
+		redactEnvPattern = regexp.MustCompile(defaultRedactEnvPattern)
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		if d.expectedPattern == "" {
+			assert.Nil(redactEnvPattern, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.Equal(d.expectedPattern, redactEnvPattern.String(), "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionLogRedactAnnotations(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option              string
+		expectedAnnotations []string
+	}
+
+	data := []testData{
+		{"", nil},
+		{"log_redact_annotations=foo", nil},
+		{"agent.log_redact_annotations", nil},
+		{"agent.log_redact_annotations=", nil},
+		{"agent.log_redact_annotations=com.example.secret", []string{"com.example.secret"}},
+		{"agent.log_redact_annotations=foo,,bar", []string{"foo", "bar"}},
+	}
+
+	reset := func() {
+		redactAnnotations = nil
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		assert.NoError(err)
+		assert.Equal(d.expectedAnnotations, redactAnnotations, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionHooksPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option         string
+		shouldErr      bool
+		expectedPolicy string
+	}
+
+	data := []testData{
+		{"", false, hooksPolicyOrdered},
+		{"hooks_policy=sorted", false, hooksPolicyOrdered},
+		{"agent.hooks_policy", false, hooksPolicyOrdered},
+		{"agent.hooks_policy=sorted", false, hooksPolicySorted},
+		{"agent.hooks_policy=concurrent", false, hooksPolicyConcurrent},
+		{"agent.hooks_policy=ordered", false, hooksPolicyOrdered},
+		{"agent.hooks_policy=random", true, hooksPolicyOrdered},
+	}
+
+	reset := func() {
+		hooksPolicy = hooksPolicyOrdered
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedPolicy, hooksPolicy, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionOCIConfigFallbackPath(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option       string
+		shouldErr    bool
+		expectedPath string
+	}
+
+	data := []testData{
+		{"", false, ""},
+		{"oci_config_fallback_path=/tmp/oci", false, ""},
+		{"agent.oci_config_fallback_path", false, ""},
+		{"agent.oci_config_fallback_path=/tmp/oci", false, "/tmp/oci"},
+		{"agent.oci_config_fallback_path=/tmp//oci/", false, "/tmp/oci"},
+		{"agent.oci_config_fallback_path=tmp/oci", true, ""},
+		{"agent.oci_config_fallback_path=", true, ""},
+	}
+
+	reset := func() {
+		ociConfigFallbackPath = ""
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedPath, ociConfigFallbackPath, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionLogBufferSize(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option             string
+		shouldErr          bool
+		expectedBufferSize uint32
+	}
+
+	data := []testData{
+		{"", false, defaultLogBufferSize},
+		{"log_buffer_size=3", false, defaultLogBufferSize},
+		{"agent.log_buffer_size", false, defaultLogBufferSize},
+		{"agent.log_buffer_size=3", false, 3},
+		{"agent.log_buffer_size=0", false, 0},
+		{"agent.log_buffer_size=-1", true, defaultLogBufferSize},
+		{"agent.log_buffer_size=foobar", true, defaultLogBufferSize},
+	}
+
+	for i, d := range data {
+		// reset the log buffer size
+		logBufferSize = uint32(defaultLogBufferSize)
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedBufferSize, logBufferSize, "test %d (%+v)", i, d)
+	}
+
+	logBufferSize = uint32(defaultLogBufferSize)
+}
+
+func TestParseCmdlineOptionMountOptionsPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option         string
+		shouldErr      bool
+		expectedDenied []string
+		expectedAllow  []string
+		expectedReject bool
+	}
+
+	data := []testData{
+		{"", false, nil, nil, false},
+		{"mount_options_deny=suid", false, nil, nil, false},
+		{"agent.mount_options_deny", false, nil, nil, false},
+		{"agent.mount_options_deny=suid", false, []string{"suid"}, nil, false},
+		{"agent.mount_options_deny=suid,,dev,exec", false, []string{"suid", "dev", "exec"}, nil, false},
+		{"agent.mount_options_allow=ro,nodev", false, nil, []string{"ro", "nodev"}, false},
+		{"agent.mount_options_policy=strip", false, nil, nil, false},
+		{"agent.mount_options_policy=reject", false, nil, nil, true},
+		{"agent.mount_options_policy=foobar", true, nil, nil, false},
+	}
+
+	reset := func() {
+		deniedMountOptions = nil
+		allowedMountOptions = nil
+		rejectForbiddenMountOptions = false
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedDenied, deniedMountOptions, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedAllow, allowedMountOptions, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedReject, rejectForbiddenMountOptions, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionStreamBuffer(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		option         string
+		shouldErr      bool
+		expectedSize   uint32
+		expectedTotal  uint32
+		expectedPolicy string
+	}
+
+	data := []testData{
+		{"", false, defaultStreamBufferSize, defaultStreamBufferTotal, streamBufferPolicyBlock},
+		{"stream_buffer_size=3", false, defaultStreamBufferSize, defaultStreamBufferTotal, streamBufferPolicyBlock},
+		{"agent.stream_buffer_size=4096", false, 4096, defaultStreamBufferTotal, streamBufferPolicyBlock},
+		{"agent.stream_buffer_size=0", false, defaultStreamBufferSize, defaultStreamBufferTotal, streamBufferPolicyBlock},
+		{"agent.stream_buffer_size=-1", true, defaultStreamBufferSize, defaultStreamBufferTotal, streamBufferPolicyBlock},
+		{"agent.stream_buffer_total=65536", false, defaultStreamBufferSize, 65536, streamBufferPolicyBlock},
+		{"agent.stream_buffer_total=foo", true, defaultStreamBufferSize, defaultStreamBufferTotal, streamBufferPolicyBlock},
+		{"agent.stream_buffer_policy=drop", false, defaultStreamBufferSize, defaultStreamBufferTotal, streamBufferPolicyDrop},
+		{"agent.stream_buffer_policy=block", false, defaultStreamBufferSize, defaultStreamBufferTotal, streamBufferPolicyBlock},
+		{"agent.stream_buffer_policy=foo", true, defaultStreamBufferSize, defaultStreamBufferTotal, streamBufferPolicyBlock},
+	}
+
+	reset := func() {
+		streamBufferSize = defaultStreamBufferSize
+		streamBufferTotal = defaultStreamBufferTotal
+		streamBufferPolicy = streamBufferPolicyBlock
+	}
+	defer reset()
+
+	for i, d := range data {
+		reset()
+
+		err := parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+
+		assert.Equal(d.expectedSize, streamBufferSize, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedTotal, streamBufferTotal, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedPolicy, streamBufferPolicy, "test %d (%+v)", i, d)
+	}
+}
@@ -0,0 +1,384 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	defaultContainerLogMaxSize    = 10 * 1024 * 1024
+	defaultContainerLogMaxBackups = 5
+
+	containerLogStdout = "stdout"
+	containerLogStderr = "stderr"
+)
+
+// Interval at which a followed log is checked for new data.
+var containerLogFollowInterval = 100 * time.Millisecond
+
+// containerLogPath returns the log file of the stream of the process execID
+// of the container cid.
+func containerLogPath(cid, execID, stream string) (string, error) {
+	if containerLogDir == "" {
+		return "", grpcStatus.Error(codes.FailedPrecondition, "Container logs are disabled")
+	}
+
+	if stream != containerLogStdout && stream != containerLogStderr {
+		return "", grpcStatus.Errorf(codes.InvalidArgument, "Invalid stream %q", stream)
+	}
+
+	for _, name := range []string{cid, execID} {
+		if name == "" || name == "." || name == ".." || strings.Contains(name, "/") {
+			return "", grpcStatus.Errorf(codes.InvalidArgument, "Invalid log name %q", name)
+		}
+	}
+
+	return filepath.Join(containerLogDir, cid, execID+"-"+stream+".log"), nil
+}
+
+// removeContainerLogs removes the logs of all the processes of the container
+// cid.
+func removeContainerLogs(cid string) error {
+	if containerLogDir == "" {
+		return nil
+	}
+
+	return os.RemoveAll(filepath.Join(containerLogDir, cid))
+}
+
+// containerLogBackupPath returns the path of the index-th backup of the log
+// path, the most recent one being 1.
+func containerLogBackupPath(path string, index int) string {
+	return fmt.Sprintf("%s.%d", path, index)
+}
+
+// rotatingLog is a log file which is rotated once it reaches the configured
+// size, keeping the configured number of backups.
+type rotatingLog struct {
+	sync.Mutex
+
+	path string
+	file *os.File
+	size int64
+}
+
+func openRotatingLog(path string) (*rotatingLog, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, err
+	}
+
+	l := &rotatingLog{path: path}
+	if err := l.openLocked(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l *rotatingLog) openLocked() error {
+	file, err := os.OpenFile(l.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+
+	fi, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	l.file = file
+	l.size = fi.Size()
+
+	return nil
+}
+
+// Write appends p to the log, rotating it first if p does not fit. Data is
+// never split across log files.
+func (l *rotatingLog) Write(p []byte) (int, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.size > 0 && l.size+int64(len(p)) > int64(containerLogMaxSize) {
+		if err := l.rotateLocked(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := l.file.Write(p)
+	l.size += int64(n)
+
+	return n, err
+}
+
+// rotateLocked moves the log to its first backup, shifting the previous
+// backups and removing the oldest one, then opens a new log.
+func (l *rotatingLog) rotateLocked() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+
+	maxBackups := int(containerLogMaxBackups)
+
+	for i := maxBackups; i > 0; i-- {
+		for _, ext := range []string{"", ".gz"} {
+			backup := containerLogBackupPath(l.path, i) + ext
+
+			var err error
+			if i == maxBackups {
+				err = os.Remove(backup)
+			} else {
+				err = os.Rename(backup, containerLogBackupPath(l.path, i+1)+ext)
+			}
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	if maxBackups == 0 {
+		if err := os.Remove(l.path); err != nil {
+			return err
+		}
+		return l.openLocked()
+	}
+
+	backup := containerLogBackupPath(l.path, 1)
+	if err := os.Rename(l.path, backup); err != nil {
+		return err
+	}
+
+	if err := l.openLocked(); err != nil {
+		return err
+	}
+
+	if containerLogCompress {
+		if err := compressFile(backup); err != nil {
+			agentLog.WithError(err).WithField("path", backup).Warn("Could not compress rotated log")
+		}
+	}
+
+	return nil
+}
+
+func (l *rotatingLog) Close() error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.file.Close()
+}
+
+// compressFile replaces path with its gzip compressed version, suffixed
+// with .gz.
+func compressFile(path string) (err error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dstPath := path + ".gz"
+	dst, err := os.OpenFile(dstPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(dstPath)
+		}
+	}()
+
+	zw := gzip.NewWriter(dst)
+	_, err = io.Copy(zw, src)
+	if closeErr := zw.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// teeContainerLog returns a pipe fed with the data read from r, the stream
+// of the process execID of the container cid, which is also written to its
+// log file. The log keeps being written once the returned pipe is closed.
+func teeContainerLog(cid, execID, stream string, r *os.File) (*os.File, error) {
+	path, err := containerLogPath(cid, execID, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	log, err := openRotatingLog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw, err := createExtendedPipe()
+	if err != nil {
+		log.Close()
+		return nil, err
+	}
+
+	go func() {
+		defer r.Close()
+		defer log.Close()
+
+		logging, piped := true, true
+		buf := make([]byte, 32*1024)
+
+		for {
+			n, err := r.Read(buf)
+			if n > 0 {
+				if _, err := log.Write(buf[:n]); err != nil && logging {
+					agentLog.WithError(err).WithFields(logrus.Fields{
+						"container": cid,
+						"exec-id":   execID,
+						"stream":    stream,
+					}).Warn("Could not write container log")
+					logging = false
+				}
+
+				// The reader went away, keep on logging.
+				if piped {
+					if _, err := pw.Write(buf[:n]); err != nil {
+						pw.Close()
+						piped = false
+					}
+				}
+			}
+			if err != nil {
+				break
+			}
+		}
+
+		if piped {
+			pw.Close()
+		}
+	}()
+
+	return pr, nil
+}
+
+// logFollower reads a log file, reopening it once it has been rotated.
+type logFollower struct {
+	path string
+	file *os.File
+}
+
+func openLogFollower(path string) (*logFollower, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, grpcStatus.Errorf(codes.NotFound, "Log %s not found", path)
+		}
+		return nil, err
+	}
+
+	return &logFollower{path: path, file: file}, nil
+}
+
+// rotated returns whether the followed file is not the log anymore.
+func (f *logFollower) rotated() bool {
+	fi, err := os.Stat(f.path)
+	if err != nil {
+		// The new log has not been created yet.
+		return false
+	}
+
+	current, err := f.file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return !os.SameFile(fi, current)
+}
+
+// Read reads the log, returning io.EOF once its end is reached.
+func (f *logFollower) Read(p []byte) (int, error) {
+	n, err := f.file.Read(p)
+	if n > 0 || err != io.EOF {
+		return n, err
+	}
+
+	if !f.rotated() {
+		return 0, io.EOF
+	}
+
+	// Some data may have been written before the rotation.
+	if n, err = f.file.Read(p); n > 0 || err != io.EOF {
+		return n, err
+	}
+
+	file, err := os.Open(f.path)
+	if err != nil {
+		return 0, io.EOF
+	}
+
+	f.file.Close()
+	f.file = file
+
+	return f.file.Read(p)
+}
+
+func (f *logFollower) Close() error {
+	return f.file.Close()
+}
+
+// readContainerLog sends the content of the log path through send. If
+// follow is set, the data appended to the log is sent until ctx is done.
+func readContainerLog(ctx context.Context, path string, follow bool, send func([]byte) error) error {
+	f, err := openLogFollower(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := make([]byte, 32*1024)
+
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			if err := send(data); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err != io.EOF {
+			return err
+		}
+
+		if !follow {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(containerLogFollowInterval):
+		}
+	}
+}
@@ -0,0 +1,293 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// setupContainerLogs points the container log directory to a temporary
+// directory, returned along with a function restoring the configuration.
+func setupContainerLogs(t *testing.T, maxSize, maxBackups uint32, compress bool) (string, func()) {
+	dir, err := ioutil.TempDir("", "container-log")
+	assert.NoError(t, err)
+
+	savedDir, savedMaxSize, savedMaxBackups, savedCompress := containerLogDir, containerLogMaxSize, containerLogMaxBackups, containerLogCompress
+	savedInterval := containerLogFollowInterval
+
+	containerLogDir, containerLogMaxSize, containerLogMaxBackups, containerLogCompress = dir, maxSize, maxBackups, compress
+	containerLogFollowInterval = time.Millisecond
+
+	return dir, func() {
+		containerLogDir, containerLogMaxSize, containerLogMaxBackups, containerLogCompress = savedDir, savedMaxSize, savedMaxBackups, savedCompress
+		containerLogFollowInterval = savedInterval
+		os.RemoveAll(dir)
+	}
+}
+
+func readGzipFile(t *testing.T, path string) string {
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(zr)
+	assert.NoError(t, err)
+
+	return string(data)
+}
+
+func TestContainerLogPath(t *testing.T) {
+	assert := assert.New(t)
+
+	_, cleanup := setupContainerLogs(t, 100, 1, false)
+	defer cleanup()
+
+	type testData struct {
+		cid    string
+		execID string
+		stream string
+		valid  bool
+	}
+
+	data := []testData{
+		{"c1", "e1", containerLogStdout, true},
+		{"c1", "e1", containerLogStderr, true},
+		{"c1", "e1", "stdin", false},
+		{"", "e1", containerLogStdout, false},
+		{"c1", "", containerLogStdout, false},
+		{"..", "e1", containerLogStdout, false},
+		{"c1", "../e1", containerLogStdout, false},
+	}
+
+	for i, d := range data {
+		path, err := containerLogPath(d.cid, d.execID, d.stream)
+		if d.valid {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(filepath.Join(containerLogDir, d.cid, d.execID+"-"+d.stream+".log"), path)
+		} else {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		}
+	}
+
+	containerLogDir = ""
+	_, err := containerLogPath("c1", "e1", containerLogStdout)
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+}
+
+func TestRotatingLog(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		maxBackups      uint32
+		compress        bool
+		expectedFiles   []string
+		expectedBackups []string
+	}
+
+	data := []testData{
+		{0, false, []string{"log"}, nil},
+		{1, false, []string{"log", "log.1"}, []string{"bbbbb"}},
+		{2, false, []string{"log", "log.1", "log.2"}, []string{"bbbbb", "aaaaa"}},
+		{2, true, []string{"log", "log.1.gz", "log.2.gz"}, []string{"bbbbb", "aaaaa"}},
+		{5, false, []string{"log", "log.1", "log.2"}, []string{"bbbbb", "aaaaa"}},
+	}
+
+	for i, d := range data {
+		dir, cleanup := setupContainerLogs(t, 8, d.maxBackups, d.compress)
+
+		path := filepath.Join(dir, "log")
+		l, err := openRotatingLog(path)
+		assert.NoError(err)
+
+		// Each write but the last one rotates the log.
+		for _, s := range []string{"aaaaa", "bbbbb", "ccccc", "dd"} {
+			n, err := l.Write([]byte(s))
+			assert.NoError(err, "test %d", i)
+			assert.Equal(len(s), n)
+		}
+		assert.NoError(l.Close())
+
+		assert.Equal(d.expectedFiles, listFiles(t, dir), "test %d", i)
+
+		content, err := ioutil.ReadFile(path)
+		assert.NoError(err)
+		assert.Equal("cccccdd", string(content), "test %d", i)
+
+		for j, expected := range d.expectedBackups {
+			backup := containerLogBackupPath(path, j+1)
+			if d.compress {
+				assert.Equal(expected, readGzipFile(t, backup+".gz"), "test %d", i)
+				continue
+			}
+
+			content, err := ioutil.ReadFile(backup)
+			assert.NoError(err)
+			assert.Equal(expected, string(content), "test %d", i)
+		}
+
+		cleanup()
+	}
+}
+
+func TestRotatingLogReopen(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, cleanup := setupContainerLogs(t, 8, 1, false)
+	defer cleanup()
+
+	path := filepath.Join(dir, "sub", "log")
+	l, err := openRotatingLog(path)
+	assert.NoError(err)
+	_, err = l.Write([]byte("aaaaaa"))
+	assert.NoError(err)
+	assert.NoError(l.Close())
+
+	// The size of the existing log is accounted for.
+	l, err = openRotatingLog(path)
+	assert.NoError(err)
+	_, err = l.Write([]byte("bbbbbb"))
+	assert.NoError(err)
+	assert.NoError(l.Close())
+
+	assert.Equal([]string{"log", "log.1"}, listFiles(t, filepath.Dir(path)))
+}
+
+func TestReadContainerLogFollow(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, cleanup := setupContainerLogs(t, 8, 1, false)
+	defer cleanup()
+
+	path := filepath.Join(dir, "log")
+	l, err := openRotatingLog(path)
+	assert.NoError(err)
+	defer l.Close()
+
+	_, err = l.Write([]byte("aaaa"))
+	assert.NoError(err)
+
+	// Without follow, only the current content is read.
+	var data []byte
+	err = readContainerLog(context.Background(), path, false, func(b []byte) error {
+		data = append(data, b...)
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal("aaaa", string(data))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	expected := "aaaabbbbccccddddeeee"
+
+	var received bytes.Buffer
+	done := make(chan error)
+	go func() {
+		done <- readContainerLog(ctx, path, true, func(b []byte) error {
+			received.Write(b)
+			if received.Len() == len(expected) {
+				cancel()
+			}
+			return nil
+		})
+	}()
+
+	// The log is rotated twice, the follower keeps on reading while the
+	// data is written across several files.
+	for _, s := range []string{"bbbb", "cccc", "dddd", "eeee"} {
+		time.Sleep(10 * time.Millisecond)
+		_, err = l.Write([]byte(s))
+		assert.NoError(err)
+	}
+
+	assert.NoError(<-done)
+	assert.Equal(expected, received.String())
+	assert.NotEqual(context.DeadlineExceeded, ctx.Err())
+
+	_, err = openLogFollower(filepath.Join(dir, "missing"))
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+}
+
+func TestTeeContainerLog(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, cleanup := setupContainerLogs(t, 1024, 1, false)
+	defer cleanup()
+
+	r, w, err := os.Pipe()
+	assert.NoError(err)
+
+	teed, err := teeContainerLog("c1", "e1", containerLogStdout, r)
+	assert.NoError(err)
+
+	_, err = w.Write([]byte("hello\n"))
+	assert.NoError(err)
+
+	buf := make([]byte, 64)
+	n, err := teed.Read(buf)
+	assert.NoError(err)
+	assert.Equal("hello\n", string(buf[:n]))
+
+	// The log keeps being written once the reader is gone.
+	assert.NoError(teed.Close())
+	_, err = w.Write([]byte("world\n"))
+	assert.NoError(err)
+	assert.NoError(w.Close())
+
+	path := filepath.Join(dir, "c1", "e1-stdout.log")
+	var content []byte
+	for i := 0; i < 500 && string(content) != "hello\nworld\n"; i++ {
+		time.Sleep(time.Millisecond)
+		content, err = ioutil.ReadFile(path)
+		assert.NoError(err)
+	}
+	assert.Equal("hello\nworld\n", string(content))
+}
+
+func TestRemoveContainerLogs(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, cleanup := setupContainerLogs(t, 100, 1, false)
+	defer cleanup()
+
+	for _, cid := range []string{"c1", "c2"} {
+		path, err := containerLogPath(cid, "e1", containerLogStdout)
+		assert.NoError(err)
+
+		log, err := openRotatingLog(path)
+		assert.NoError(err)
+		log.Close()
+	}
+
+	err := removeContainerLogs("c1")
+	assert.NoError(err)
+
+	_, err = os.Stat(filepath.Join(dir, "c1"))
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "c2"))
+	assert.NoError(err)
+
+	// Nothing to remove when the logs are disabled.
+	containerLogDir = ""
+	assert.NoError(removeContainerLogs("c2"))
+	_, err = os.Stat(filepath.Join(dir, "c2"))
+	assert.NoError(err)
+}
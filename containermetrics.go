@@ -0,0 +1,167 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Number of the fields of an interface line of /proc/net/dev: the bytes,
+// packets, errs, drop, fifo, frame, compressed and multicast received, then
+// the bytes, packets, errs, drop, fifo, colls, carrier and compressed
+// transmitted.
+const netDevFields = 16
+
+// convertCgroupStats returns the cgroup statistics of stats, which have the
+// same JSON representation.
+func convertCgroupStats(stats *libcontainer.Stats) (*pb.CgroupStats, error) {
+	data, err := json.Marshal(stats.CgroupStats)
+	if err != nil {
+		return nil, err
+	}
+
+	var cgroupStats pb.CgroupStats
+	if err := json.Unmarshal(data, &cgroupStats); err != nil {
+		return nil, err
+	}
+
+	return &cgroupStats, nil
+}
+
+// parseNetDev parses the interface statistics of a /proc/<pid>/net/dev file,
+// sorting them by interface name.
+func parseNetDev(path string) ([]*pb.NetworkStats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	networkStats := []*pb.NetworkStats{}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// The header lines have no colon after the interface name.
+		//   eth0: 1024 8 0 0 0 0 0 0 2048 16 0 0 0 0 0 0
+		fields := strings.SplitN(line, ":", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		counters := strings.Fields(fields[1])
+		if len(counters) != netDevFields {
+			return nil, fmt.Errorf("invalid line %q of %s", line, path)
+		}
+
+		values := make([]uint64, netDevFields)
+		for i, counter := range counters {
+			values[i], err = strconv.ParseUint(counter, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value in line %q of %s: %v", line, path, err)
+			}
+		}
+
+		networkStats = append(networkStats, &pb.NetworkStats{
+			Name:      strings.TrimSpace(fields[0]),
+			RxBytes:   values[0],
+			RxPackets: values[1],
+			RxErrors:  values[2],
+			RxDropped: values[3],
+			TxBytes:   values[8],
+			TxPackets: values[9],
+			TxErrors:  values[10],
+			TxDropped: values[11],
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(networkStats, func(i, j int) bool {
+		return networkStats[i].Name < networkStats[j].Name
+	})
+
+	return networkStats, nil
+}
+
+// getContainerMetrics returns the cgroup statistics of ctr, along with the
+// statistics of the interfaces of its network namespace, read through its
+// init process.
+func getContainerMetrics(ctr *container) (*pb.ContainerMetrics, error) {
+	notRunning := grpcStatus.Errorf(codes.FailedPrecondition, "Container %s is not running", ctr.id)
+
+	if ctr.initProcess == nil {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Container %s has no init process", ctr.id)
+	}
+
+	isStopped := func() (bool, error) {
+		status, err := ctr.container.Status()
+		return status == libcontainer.Stopped, err
+	}
+
+	if stopped, err := isStopped(); err != nil {
+		return nil, err
+	} else if stopped {
+		return nil, notRunning
+	}
+
+	pid, err := ctr.initProcess.pid()
+	if err != nil {
+		return nil, notRunning
+	}
+
+	// Both statistics are read right after each other, for them to
+	// describe the same moment.
+	timestamp := time.Now()
+
+	stats, err := ctr.container.Stats()
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not get cgroup stats of container %s: %v", ctr.id, err)
+	}
+
+	networkStats, err := parseNetDev(filepath.Join(procDir, strconv.Itoa(pid), "net", "dev"))
+	if os.IsNotExist(err) {
+		return nil, notRunning
+	} else if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not get network stats of container %s: %v", ctr.id, err)
+	}
+
+	// The init process may have exited meanwhile, its PID being reused
+	// in another network namespace.
+	if stopped, err := isStopped(); err != nil {
+		return nil, err
+	} else if stopped {
+		return nil, notRunning
+	}
+
+	cgroupStats, err := convertCgroupStats(stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ContainerMetrics{
+		CgroupStats:  cgroupStats,
+		NetworkStats: networkStats,
+		Timestamp:    uint64(timestamp.UnixNano()),
+	}, nil
+}
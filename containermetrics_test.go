@@ -0,0 +1,160 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/opencontainers/runc/libcontainer/cgroups"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const testNetDev = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+  eth0:    2048      16    1    2    0     0          0         0     4096      32    3    4    0     0       0          0
+    lo:     100       1    0    0    0     0          0         0      100       1    0    0    0     0       0          0
+`
+
+func TestParseNetDev(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "net-dev")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	type testData struct {
+		content       string
+		expectError   bool
+		expectedStats []*pb.NetworkStats
+	}
+
+	data := []testData{
+		{testNetDev, false, []*pb.NetworkStats{
+			{Name: "eth0", RxBytes: 2048, RxPackets: 16, RxErrors: 1, RxDropped: 2, TxBytes: 4096, TxPackets: 32, TxErrors: 3, TxDropped: 4},
+			{Name: "lo", RxBytes: 100, RxPackets: 1, TxBytes: 100, TxPackets: 1},
+		}},
+		// The interfaces are sorted.
+		{"b: 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0\na: 1 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0\n", false, []*pb.NetworkStats{
+			{Name: "a", RxBytes: 1},
+			{Name: "b"},
+		}},
+		{"", false, []*pb.NetworkStats{}},
+		{"eth0: 1 2 3\n", true, nil},
+		{"eth0: 1 0 0 0 0 0 0 0 0 0 0 0 0 0 0 -1\n", true, nil},
+	}
+
+	path := filepath.Join(tmpDir, "dev")
+
+	for i, d := range data {
+		err := ioutil.WriteFile(path, []byte(d.content), 0644)
+		assert.NoError(err)
+
+		stats, err := parseNetDev(path)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedStats, stats, "test %d (%+v)", i, d)
+	}
+
+	_, err = parseNetDev(path + ".missing")
+	assert.True(os.IsNotExist(err))
+}
+
+func TestGetContainerMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "proc")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedProcDir := procDir
+	procDir = tmpDir
+	defer func() {
+		procDir = savedProcDir
+	}()
+
+	err = os.MkdirAll(filepath.Join(tmpDir, "1234", "net"), 0755)
+	assert.NoError(err)
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "1234", "net", "dev"), []byte(testNetDev), 0644)
+	assert.NoError(err)
+
+	stats := libcontainer.Stats{
+		CgroupStats: &cgroups.Stats{
+			CpuStats: cgroups.CpuStats{
+				CpuUsage: cgroups.CpuUsage{TotalUsage: 1000},
+			},
+			MemoryStats: cgroups.MemoryStats{
+				Usage: cgroups.MemoryData{Usage: 4096},
+			},
+			PidsStats: cgroups.PidsStats{Current: 3, Limit: 10},
+			BlkioStats: cgroups.BlkioStats{
+				IoServiceBytesRecursive: []cgroups.BlkioStatEntry{{Major: 8, Op: "Read", Value: 512}},
+			},
+		},
+	}
+
+	type testData struct {
+		initProcess  *process
+		status       libcontainer.Status
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{&process{recoveredPid: 1234}, libcontainer.Running, codes.OK},
+		{&process{recoveredPid: 1234}, libcontainer.Paused, codes.OK},
+		{&process{recoveredPid: 1234}, libcontainer.Stopped, codes.FailedPrecondition},
+		{&process{recoveredPid: 5678}, libcontainer.Running, codes.FailedPrecondition},
+		{nil, libcontainer.Running, codes.FailedPrecondition},
+	}
+
+	for i, d := range data {
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{
+					"ctr": {
+						id:          "ctr",
+						initProcess: d.initProcess,
+						container:   &mockContainer{status: d.status, stats: stats},
+					},
+				},
+			},
+		}
+
+		metrics, err := a.GetContainerMetrics(context.Background(), &pb.GetContainerMetricsRequest{ContainerId: "ctr"})
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		if d.expectedCode != codes.OK {
+			continue
+		}
+
+		assert.Equal(uint64(1000), metrics.CgroupStats.CpuStats.CpuUsage.TotalUsage, "test %d (%+v)", i, d)
+		assert.Equal(uint64(4096), metrics.CgroupStats.MemoryStats.Usage.Usage, "test %d (%+v)", i, d)
+		assert.Equal(&pb.PidsStats{Current: 3, Limit: 10}, metrics.CgroupStats.PidsStats, "test %d (%+v)", i, d)
+		assert.Equal([]*pb.BlkioStatsEntry{{Major: 8, Op: "Read", Value: 512}}, metrics.CgroupStats.BlkioStats.IoServiceBytesRecursive, "test %d (%+v)", i, d)
+
+		assert.Len(metrics.NetworkStats, 2, "test %d (%+v)", i, d)
+		assert.Equal("eth0", metrics.NetworkStats[0].Name, "test %d (%+v)", i, d)
+		assert.Equal(uint64(4096), metrics.NetworkStats[0].TxBytes, "test %d (%+v)", i, d)
+
+		assert.WithinDuration(time.Now(), time.Unix(0, int64(metrics.Timestamp)), time.Minute, "test %d (%+v)", i, d)
+	}
+
+	a := &agentGRPC{sandbox: &sandbox{containers: make(map[string]*container)}}
+	_, err = a.GetContainerMetrics(context.Background(), &pb.GetContainerMetricsRequest{ContainerId: "missing"})
+	assert.Error(err)
+}
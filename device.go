@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path"
 	"path/filepath"
 	"strconv"
@@ -39,6 +40,9 @@ const (
 
 const (
 	pciBusMode = 0220
+
+	// First interval between two checks for a hotplugged device
+	hotplugInitialInterval = 10 * time.Millisecond
 )
 
 var (
@@ -67,6 +71,7 @@ var (
 	sysClassPrefix  = sysfsDir + "/class"
 	scsiBlockSuffix = "block"
 	scsiHostPath    = filepath.Join(sysClassPrefix, "scsi_host")
+	sysBlockPath    = filepath.Join(sysClassPrefix, "block")
 )
 
 // Stores a mapping of device names (in host / outer container naming)
@@ -145,7 +150,7 @@ func pciPathToSysfsImpl(pciPath PciPath) (string, error) {
 	return relPath, nil
 }
 
-func getDeviceName(s *sandbox, devID string) (string, error) {
+func getDeviceName(ctx context.Context, s *sandbox, devID string) (string, error) {
 	var devName string
 	var notifyChan chan string
 
@@ -174,23 +179,95 @@ func getDeviceName(s *sandbox, devID string) (string, error) {
 
 	if devName == "" {
 		fieldLogger.Infof("Waiting on channel for device: %s notification", devID)
-		select {
-		case devName = <-notifyChan:
-		case <-time.After(hotplugTimeout):
-			s.Lock()
-			delete(s.deviceWatchers, devID)
-			s.Unlock()
-
-			return "", grpcStatus.Errorf(codes.DeadlineExceeded,
-				"Timeout reached after %s waiting for device %s",
-				hotplugTimeout, devID)
+
+		deadline := time.NewTimer(hotplugTimeout)
+		defer deadline.Stop()
+
+		// The uevent may never reach us if the device showed up before
+		// the listener was ready, hence sysfs is also checked periodically.
+		b := newBackoff(hotplugInitialInterval, hotplugMaxInterval)
+
+		for devName == "" {
+			poll := time.NewTimer(b.next())
+
+			select {
+			case devName = <-notifyChan:
+			case <-poll.C:
+				if name := getBlockDeviceNameFromSysfs(devID); name != "" {
+					fieldLogger.Infof("Device: %s found in sysfs", devID)
+					removeDeviceWatcher(s, devID, notifyChan)
+					devName = name
+				}
+			case <-ctx.Done():
+				poll.Stop()
+				removeDeviceWatcher(s, devID, notifyChan)
+
+				return "", grpcStatus.Errorf(codes.Canceled,
+					"Stopped waiting for device %s: %v", devID, ctx.Err())
+			case <-deadline.C:
+				poll.Stop()
+				removeDeviceWatcher(s, devID, notifyChan)
+
+				return "", grpcStatus.Errorf(codes.DeadlineExceeded,
+					"Timeout reached after %s waiting for device %s",
+					hotplugTimeout, devID)
+			}
+
+			poll.Stop()
 		}
 	}
 
 	return filepath.Join(systemDevPath, devName), nil
 }
 
-func getPCIDeviceNameImpl(s *sandbox, pciPath PciPath) (string, error) {
+// removeDeviceWatcher removes the watcher registered for devID, unless it has
+// already been replaced by another waiter.
+func removeDeviceWatcher(s *sandbox, devID string, notifyChan chan string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.deviceWatchers[devID] == notifyChan {
+		delete(s.deviceWatchers, devID)
+	}
+}
+
+// getBlockDeviceNameFromSysfs looks for a block device whose sysfs path
+// matches devID, and returns its name or an empty string if none is found.
+func getBlockDeviceNameFromSysfs(devID string) string {
+	if devID == "" {
+		return ""
+	}
+
+	entries, err := ioutil.ReadDir(sysBlockPath)
+	if err != nil {
+		return ""
+	}
+
+	for _, entry := range entries {
+		// for example: ../../devices/pci0000:00/0000:00:02.0/virtio2/block/vda
+		target, err := os.Readlink(filepath.Join(sysBlockPath, entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		// Partitions live one level below their parent disk.
+		if filepath.Base(filepath.Dir(target)) != scsiBlockSuffix {
+			continue
+		}
+
+		if strings.Contains(target, devID) {
+			return entry.Name()
+		}
+	}
+
+	return ""
+}
+
+func getPCIDeviceNameImpl(ctx context.Context, s *sandbox, pciPath PciPath) (string, error) {
+	if devPath, ok := s.pciDevices.get(pciPath.path); ok {
+		return devPath, nil
+	}
+
 	sysfsRelPath, err := pciPathToSysfs(pciPath)
 	if err != nil {
 		return "", err
@@ -204,7 +281,14 @@ func getPCIDeviceNameImpl(s *sandbox, pciPath PciPath) (string, error) {
 		return "", err
 	}
 
-	return getDeviceName(s, sysfsRelPath)
+	devPath, err := getDeviceName(ctx, s, sysfsRelPath)
+	if err != nil {
+		return "", err
+	}
+
+	s.pciDevices.add(pciPath.path, sysfsRelPath, devPath)
+
+	return devPath, nil
 }
 
 // device.Id should be the predicted device name (vda, vdb, ...)
@@ -218,7 +302,7 @@ func virtioMmioBlkDeviceHandler(_ context.Context, device pb.Device, spec *pb.Sp
 }
 
 func virtioBlkCCWDeviceHandler(ctx context.Context, device pb.Device, spec *pb.Spec, s *sandbox, devIdx devIndex) error {
-	devPath, err := getBlkCCWDevPath(s, device.Id)
+	devPath, err := getBlkCCWDevPath(ctx, s, device.Id)
 	if err != nil {
 		return err
 	}
@@ -233,10 +317,10 @@ func virtioBlkCCWDeviceHandler(ctx context.Context, device pb.Device, spec *pb.S
 }
 
 // device.Id should be a PCI path (see type PciPath)
-func virtioBlkDeviceHandler(_ context.Context, device pb.Device, spec *pb.Spec, s *sandbox, devIdx devIndex) error {
+func virtioBlkDeviceHandler(ctx context.Context, device pb.Device, spec *pb.Spec, s *sandbox, devIdx devIndex) error {
 	// When "Id" (PCI path) is not set, we allow to use the predicted "VmPath" passed from kata-runtime
 	if device.Id != "" {
-		devPath, err := getPCIDeviceName(s, PciPath{device.Id})
+		devPath, err := getPCIDeviceName(ctx, s, PciPath{device.Id})
 		if err != nil {
 			return err
 		}
@@ -249,7 +333,7 @@ func virtioBlkDeviceHandler(_ context.Context, device pb.Device, spec *pb.Spec,
 // device.Id should be the SCSI address of the disk in the format "scsiID:lunID"
 func virtioSCSIDeviceHandler(ctx context.Context, device pb.Device, spec *pb.Spec, s *sandbox, devIdx devIndex) error {
 	// Retrieve the device path from SCSI address.
-	devPath, err := getSCSIDevPath(s, device.Id)
+	devPath, err := getSCSIDevPath(ctx, s, device.Id)
 	if err != nil {
 		return err
 	}
@@ -358,21 +442,21 @@ func scanSCSIBusImpl(scsiAddr string) error {
 // getSCSIDevPathImpl scans SCSI bus looking for the provided SCSI address, then
 // it waits for the SCSI disk to become available and returns the device path
 // associated with the disk.
-func getSCSIDevPathImpl(s *sandbox, scsiAddr string) (string, error) {
+func getSCSIDevPathImpl(ctx context.Context, s *sandbox, scsiAddr string) (string, error) {
 	if err := scanSCSIBus(scsiAddr); err != nil {
 		return "", err
 	}
 
 	devPath := filepath.Join(scsiHostChannel+scsiAddr, scsiBlockSuffix)
 
-	return getDeviceName(s, devPath)
+	return getDeviceName(ctx, s, devPath)
 }
 
-func getPmemDevPathImpl(s *sandbox, devPmemPath string) (string, error) {
+func getPmemDevPathImpl(ctx context.Context, s *sandbox, devPmemPath string) (string, error) {
 	// for example: /block/pmem1
 	devPath := filepath.Join("/", scsiBlockSuffix, filepath.Base(devPmemPath))
 
-	return getDeviceName(s, devPath)
+	return getDeviceName(ctx, s, devPath)
 }
 
 // checkCCWBusFormat checks the format for the ccw bus. It needs to be in the form 0.<n>.<dddd>
@@ -414,12 +498,12 @@ func checkCCWBusFormat(bus string) error {
 }
 
 // getBlkCCWDevPath returns the CCW block path based on the bus ID
-func getBlkCCWDevPath(s *sandbox, bus string) (string, error) {
+func getBlkCCWDevPath(ctx context.Context, s *sandbox, bus string) (string, error) {
 	if err := checkCCWBusFormat(bus); err != nil {
 		return "", err
 	}
 
-	return getDeviceName(s, path.Join(bus, blkCCWSuffix))
+	return getDeviceName(ctx, s, path.Join(bus, blkCCWSuffix))
 }
 
 func addDevices(ctx context.Context, devices []*pb.Device, spec *pb.Spec, s *sandbox) error {
@@ -476,6 +560,17 @@ func addDevice(ctx context.Context, device *pb.Device, spec *pb.Spec, s *sandbox
 		return grpcStatus.Error(codes.InvalidArgument, "invalid spec")
 	}
 
+	devHandler, err := checkDevice(device)
+	if err != nil {
+		return err
+	}
+
+	return devHandler(ctx, *device, spec, s, devIdx)
+}
+
+// checkDevice validates the description of a device and returns the
+// handler in charge of its type.
+func checkDevice(device *pb.Device) (deviceHandler, error) {
 	// log before validation to help with debugging gRPC protocol
 	// version differences.
 	agentLog.WithFields(logrus.Fields{
@@ -487,27 +582,27 @@ func addDevice(ctx context.Context, device *pb.Device, spec *pb.Spec, s *sandbox
 	}).Debug()
 
 	if device.Type == "" {
-		return grpcStatus.Errorf(codes.InvalidArgument,
+		return nil, grpcStatus.Errorf(codes.InvalidArgument,
 			"invalid type for device %v", device)
 	}
 
 	if device.Id == "" && device.VmPath == "" {
-		return grpcStatus.Errorf(codes.InvalidArgument,
+		return nil, grpcStatus.Errorf(codes.InvalidArgument,
 			"invalid ID and VM path for device %v", device)
 	}
 
 	if device.ContainerPath == "" {
-		return grpcStatus.Errorf(codes.InvalidArgument,
+		return nil, grpcStatus.Errorf(codes.InvalidArgument,
 			"invalid container path for device %v", device)
 	}
 
 	devHandler, ok := deviceHandlerList[device.Type]
 	if !ok {
-		return grpcStatus.Errorf(codes.InvalidArgument,
+		return nil, grpcStatus.Errorf(codes.InvalidArgument,
 			"Unknown device type %q", device.Type)
 	}
 
-	return devHandler(ctx, *device, spec, s, devIdx)
+	return devHandler, nil
 }
 
 // updateDeviceCgroupForGuestRootfs updates the device cgroup for container
@@ -16,10 +16,13 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	pb "github.com/kata-containers/agent/protocols/grpc"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 var (
@@ -52,7 +55,7 @@ func testVirtioBlkDeviceHandlerFailure(t *testing.T, device pb.Device, spec *pb.
 	assert.NotNil(t, err, "blockDeviceHandler() should have failed")
 
 	savedFunc := getPCIDeviceName
-	getPCIDeviceName = func(s *sandbox, pciPath PciPath) (string, error) {
+	getPCIDeviceName = func(ctx context.Context, s *sandbox, pciPath PciPath) (string, error) {
 		return "foo", nil
 	}
 
@@ -805,7 +808,7 @@ func TestVirtioSCSIDeviceHandler(t *testing.T) {
 	cancel()
 
 	savedFunc := getSCSIDevPath
-	getSCSIDevPath = func(s *sandbox, scsiAddr string) (string, error) {
+	getSCSIDevPath = func(ctx context.Context, s *sandbox, scsiAddr string) (string, error) {
 		return "foo", nil
 	}
 
@@ -863,14 +866,14 @@ func TestGetPCIDeviceName(t *testing.T) {
 		deviceWatchers: make(map[string](chan string)),
 	}
 
-	_, err = getPCIDeviceNameImpl(&sb, PciPath{""})
+	_, err = getPCIDeviceNameImpl(context.Background(), &sb, PciPath{""})
 	assert.Error(err)
 
 	rescanDir := filepath.Dir(pciBusRescanFile)
 	err = os.MkdirAll(rescanDir, testDirMode)
 	assert.NoError(err)
 
-	_, err = getPCIDeviceNameImpl(&sb, PciPath{""})
+	_, err = getPCIDeviceNameImpl(context.Background(), &sb, PciPath{""})
 	assert.Error(err)
 }
 
@@ -891,7 +894,7 @@ func TestGetSCSIDevPath(t *testing.T) {
 
 	sb := sandbox{deviceWatchers: make(map[string](chan string))}
 
-	_, err := getSCSIDevPathImpl(&sb, "")
+	_, err := getSCSIDevPathImpl(context.Background(), &sb, "")
 	assert.Error(err)
 }
 
@@ -926,7 +929,7 @@ func TestGetDeviceName(t *testing.T) {
 		sysToDevMap:    systodevmap,
 	}
 
-	name, err := getDeviceName(&sb, busID)
+	name, err := getDeviceName(context.Background(), &sb, busID)
 
 	assert.Nil(err)
 	assert.Equal(name, path.Join(devRootPath, devName))
@@ -954,12 +957,102 @@ func TestGetDeviceName(t *testing.T) {
 		sb.Unlock()
 	}()
 
-	name, err = getDeviceName(&sb, path.Join(busID, blkCCWSuffix))
+	name, err = getDeviceName(context.Background(), &sb, path.Join(busID, blkCCWSuffix))
 
 	assert.Nil(err)
 	assert.Equal(name, path.Join(devRootPath, devName))
 }
 
+func TestGetDeviceNameFromSysfs(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "sys-block")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedSysBlockPath := sysBlockPath
+	sysBlockPath = tmpDir
+	defer func() {
+		sysBlockPath = savedSysBlockPath
+	}()
+
+	pciID := "0000:00:05.0"
+	err = os.Symlink("../../devices/pci0000:00/"+pciID+"/virtio5/block/vdb", filepath.Join(tmpDir, "vdb"))
+	assert.NoError(err)
+	err = os.Symlink("../../devices/pci0000:00/"+pciID+"/virtio5/block/vdb/vdb1", filepath.Join(tmpDir, "vdb1"))
+	assert.NoError(err)
+
+	sb := sandbox{
+		deviceWatchers: make(map[string](chan string)),
+		sysToDevMap:    make(map[string]string),
+	}
+
+	// No uevent is ever received, the device must be found through sysfs.
+	name, err := getDeviceName(context.Background(), &sb, pciID)
+	assert.NoError(err)
+	assert.Equal(filepath.Join(systemDevPath, "vdb"), name)
+	assert.Empty(sb.deviceWatchers)
+}
+
+func TestGetDeviceNameCancel(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "sys-block")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedSysBlockPath := sysBlockPath
+	savedHotplugTimeout := hotplugTimeout
+	sysBlockPath = tmpDir
+	hotplugTimeout = time.Minute
+	defer func() {
+		sysBlockPath = savedSysBlockPath
+		hotplugTimeout = savedHotplugTimeout
+	}()
+
+	sb := sandbox{
+		deviceWatchers: make(map[string](chan string)),
+		sysToDevMap:    make(map[string]string),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err = getDeviceName(ctx, &sb, "0000:00:06.0")
+	assert.Error(err)
+	assert.Equal(codes.Canceled, grpcStatus.Code(err))
+	assert.True(time.Since(start) < time.Second, "cancellation took %s", time.Since(start))
+	assert.Empty(sb.deviceWatchers)
+}
+
+func TestGetDeviceNameTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "sys-block")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedSysBlockPath := sysBlockPath
+	savedHotplugTimeout := hotplugTimeout
+	sysBlockPath = tmpDir
+	hotplugTimeout = 100 * time.Millisecond
+	defer func() {
+		sysBlockPath = savedSysBlockPath
+		hotplugTimeout = savedHotplugTimeout
+	}()
+
+	sb := sandbox{
+		deviceWatchers: make(map[string](chan string)),
+		sysToDevMap:    make(map[string]string),
+	}
+
+	_, err = getDeviceName(context.Background(), &sb, "0000:00:07.0")
+	assert.Error(err)
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.Empty(sb.deviceWatchers)
+}
+
 func TestUpdateDeviceCgroupForGuestRootfs(t *testing.T) {
 	skipUnlessRoot(t)
 	assert := assert.New(t)
@@ -0,0 +1,98 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"strings"
+	"sync"
+)
+
+// Number of resolved PCI devices kept by a deviceCache.
+const maxDeviceCacheEntries = 64
+
+type deviceCacheEntry struct {
+	sysfsPath string
+	devPath   string
+}
+
+// deviceCache maps the PCI paths of the devices already resolved to their
+// device node, saving the sysfs walk of later resolutions. The oldest entry
+// is evicted when the cache is full. The zero value is an empty cache.
+type deviceCache struct {
+	sync.Mutex
+
+	entries map[string]deviceCacheEntry
+	// PCI paths, from the oldest to the newest entry.
+	order []string
+}
+
+// get returns the device node cached for pciPath, if it still exists.
+func (c *deviceCache) get(pciPath string) (string, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry, ok := c.entries[pciPath]
+	if !ok {
+		return "", false
+	}
+
+	if _, err := os.Stat(entry.devPath); err != nil {
+		c.remove(pciPath)
+		return "", false
+	}
+
+	return entry.devPath, true
+}
+
+// add caches the device node devPath of the device found at pciPath, whose
+// sysfs path relative to the PCI root bus is sysfsPath.
+func (c *deviceCache) add(pciPath, sysfsPath, devPath string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]deviceCacheEntry)
+	}
+
+	if _, ok := c.entries[pciPath]; ok {
+		c.remove(pciPath)
+	}
+
+	if len(c.order) >= maxDeviceCacheEntries {
+		c.remove(c.order[0])
+	}
+
+	c.entries[pciPath] = deviceCacheEntry{sysfsPath: sysfsPath, devPath: devPath}
+	c.order = append(c.order, pciPath)
+}
+
+// invalidate removes the entries of the devices found under the sysfs path
+// devPath of a removed device.
+func (c *deviceCache) invalidate(devPath string) {
+	c.Lock()
+	defer c.Unlock()
+
+	for pciPath, entry := range c.entries {
+		// Same matching as getDeviceName()
+		if strings.Contains(devPath, entry.sysfsPath) {
+			c.remove(pciPath)
+		}
+	}
+}
+
+// remove removes the entry of pciPath, the cache being locked.
+func (c *deviceCache) remove(pciPath string) {
+	delete(c.entries, pciPath)
+
+	for i, p := range c.order {
+		if p == pciPath {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
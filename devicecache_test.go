@@ -0,0 +1,134 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPCIDeviceNameCache(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "device-cache")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedPciPathToSysfs, savedRescanFile, savedDevPath := pciPathToSysfs, pciBusRescanFile, systemDevPath
+	defer func() {
+		pciPathToSysfs, pciBusRescanFile, systemDevPath = savedPciPathToSysfs, savedRescanFile, savedDevPath
+	}()
+
+	walks := 0
+	pciPathToSysfs = func(pciPath PciPath) (string, error) {
+		walks++
+		return savedPciPathToSysfs(pciPath)
+	}
+	pciBusRescanFile = filepath.Join(tmpDir, "rescan")
+	systemDevPath = tmpDir
+
+	rootBusPath, err := createRootBusPath()
+	assert.NoError(err)
+
+	devNode := filepath.Join(tmpDir, "vdb")
+	err = ioutil.WriteFile(devNode, nil, 0600)
+	assert.NoError(err)
+
+	sysfsPath := "0000:00:02.0"
+	ueventPath := filepath.Join(rootBusPath, sysfsPath, "virtio2", "block", "vdb")
+
+	s := &sandbox{
+		deviceWatchers: make(map[string](chan string)),
+		sysToDevMap:    map[string]string{ueventPath: "vdb"},
+	}
+
+	// The second resolution of the device does not walk sysfs.
+	for i := 0; i < 2; i++ {
+		devPath, err := getPCIDeviceName(context.Background(), s, PciPath{"02"})
+		assert.NoError(err, "resolution %d", i)
+		assert.Equal(devNode, devPath, "resolution %d", i)
+		assert.Equal(1, walks, "resolution %d", i)
+	}
+
+	// The removal of the device invalidates its entry.
+	s.pciDevices.invalidate(ueventPath)
+	_, ok := s.pciDevices.get("02")
+	assert.False(ok)
+
+	devPath, err := getPCIDeviceName(context.Background(), s, PciPath{"02"})
+	assert.NoError(err)
+	assert.Equal(devNode, devPath)
+	assert.Equal(2, walks)
+
+	// An entry whose device node disappeared is not used.
+	err = os.Remove(devNode)
+	assert.NoError(err)
+	_, ok = s.pciDevices.get("02")
+	assert.False(ok)
+}
+
+func TestDeviceCache(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "device-cache")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	var c deviceCache
+
+	_, ok := c.get("02")
+	assert.False(ok)
+
+	// The oldest entry is evicted once the cache is full.
+	for i := 0; i <= maxDeviceCacheEntries; i++ {
+		devPath := filepath.Join(tmpDir, fmt.Sprintf("vd%d", i))
+		err = ioutil.WriteFile(devPath, nil, 0600)
+		assert.NoError(err)
+
+		c.add(fmt.Sprintf("%02x", i), fmt.Sprintf("0000:00:%02x.0", i), devPath)
+	}
+
+	assert.Len(c.entries, maxDeviceCacheEntries)
+	assert.Len(c.order, maxDeviceCacheEntries)
+
+	_, ok = c.get("00")
+	assert.False(ok)
+	devPath, ok := c.get("01")
+	assert.True(ok)
+	assert.Equal(filepath.Join(tmpDir, "vd1"), devPath)
+
+	// Invalidating an unknown device has no effect.
+	c.invalidate("/devices/pci0000:00/0000:00:7f.0")
+	assert.Len(c.entries, maxDeviceCacheEntries)
+
+	c.invalidate("/devices/pci0000:00/0000:00:01.0/virtio1/block/vd1")
+	_, ok = c.get("01")
+	assert.False(ok)
+	assert.Len(c.order, maxDeviceCacheEntries-1)
+
+	// The cache can be used concurrently.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pciPath := fmt.Sprintf("%02x", i)
+			c.add(pciPath, "0000:00:"+pciPath+".0", filepath.Join(tmpDir, "vd2"))
+			c.get(pciPath)
+			c.invalidate("/devices/pci0000:00/0000:00:" + pciPath + ".0")
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(len(c.entries), len(c.order))
+}
@@ -0,0 +1,161 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/specconv"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// createContainerDryRun performs the validation steps of CreateContainer,
+// stopping before anything gets hotplugged, mounted, written or started.
+func (a *agentGRPC) createContainerDryRun(req *pb.CreateContainerRequest) (*pb.CreateContainerDryRunResponse, error) {
+	if req.OCI == nil || req.OCI.Linux == nil || req.OCI.Process == nil {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "Invalid OCI spec")
+	}
+
+	if err := a.createContainerChecks(req); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CreateContainerDryRunResponse{}
+
+	for _, device := range req.Devices {
+		if device == nil {
+			continue
+		}
+
+		if _, err := checkDevice(device); err != nil {
+			return nil, err
+		}
+
+		if device.VmPath != "" {
+			if _, err := os.Stat(device.VmPath); err != nil {
+				resp.Warnings = append(resp.Warnings, fmt.Sprintf("device %s not present yet", device.VmPath))
+			}
+		}
+	}
+
+	storages, err := sortStorages(req.Storages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, storage := range storages {
+		if _, ok := storageHandlerList[storage.Driver]; !ok {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument,
+				"Unknown storage driver %q", storage.Driver)
+		}
+
+		if err := validateMountOptions(storage.Options); err != nil {
+			return nil, err
+		}
+
+		resp.StorageMountPoints = append(resp.StorageMountPoints, storage.MountPoint)
+	}
+
+	if _, err := extractTimeNamespace(req.OCI); err != nil {
+		return nil, err
+	}
+
+	if err := applyAnnotationHints(req.OCI); err != nil {
+		return nil, err
+	}
+
+	ociSpec, err := pb.GRPCtoOCI(req.OCI)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkContainerSpec(req, ociSpec); err != nil {
+		return nil, err
+	}
+
+	if ociSpec.Linux.Resources != nil {
+		if err := a.handleCPUSet(ociSpec); err != nil {
+			return nil, err
+		}
+	}
+
+	if a.sandbox.guestHooksPresent {
+		a.sandbox.addGuestHooks(ociSpec)
+	}
+
+	resp.Hooks = dryRunHooks(ociSpec, resp)
+
+	if ociSpec.Root != nil && !isProvidedByStorage(ociSpec.Root.Path, resp.StorageMountPoints) {
+		if _, err := os.Stat(ociSpec.Root.Path); err != nil {
+			resp.Warnings = append(resp.Warnings, fmt.Sprintf("rootfs %s not present", ociSpec.Root.Path))
+		}
+	}
+
+	config, err := specconv.CreateLibcontainerConfig(&specconv.CreateOpts{
+		CgroupName:   req.ContainerId,
+		NoNewKeyring: true,
+		Spec:         ociSpec,
+		NoPivotRoot:  a.sandbox.noPivotRoot,
+	})
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid OCI spec: %v", err)
+	}
+
+	ctr := &container{
+		id:              req.ContainerId,
+		useSandboxPidNs: req.SandboxPidns,
+		agentPidNs:      req.AgentPidns,
+	}
+
+	if err := a.updateContainerConfig(ociSpec, config, ctr); err != nil {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid OCI spec: %v", err)
+	}
+
+	return resp, nil
+}
+
+// dryRunHooks returns the paths of the hooks of the spec, adding a warning
+// to resp for each hook which cannot be found.
+func dryRunHooks(spec *specs.Spec, resp *pb.CreateContainerDryRunResponse) []string {
+	if spec.Hooks == nil {
+		return nil
+	}
+
+	var paths []string
+	for _, hooks := range [][]specs.Hook{spec.Hooks.Prestart, spec.Hooks.Poststart, spec.Hooks.Poststop} {
+		for _, hook := range hooks {
+			paths = append(paths, hook.Path)
+
+			if _, err := os.Stat(hook.Path); err != nil {
+				resp.Warnings = append(resp.Warnings, fmt.Sprintf("hook %s not found", hook.Path))
+			}
+		}
+	}
+
+	return paths
+}
+
+// isProvidedByStorage returns whether path is one of the mount points or is
+// located under one of them.
+func isProvidedByStorage(path string, mountPoints []string) bool {
+	path = filepath.Clean(path)
+
+	for _, m := range mountPoints {
+		m = filepath.Clean(m)
+		if path == m || strings.HasPrefix(path, m+"/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,216 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func createDryRunRequest(containerID, rootfs string) *pb.CreateContainerRequest {
+	return &pb.CreateContainerRequest{
+		ContainerId: containerID,
+		ExecId:      containerID,
+		Storages: []*pb.Storage{
+			{
+				Driver:     driverLocalType,
+				MountPoint: filepath.Join(rootfs, "volume"),
+				DependsOn:  []string{rootfs},
+			},
+			{
+				Driver:     driverBlkType,
+				Source:     "0000:00:02.0/01.0",
+				Fstype:     "ext4",
+				MountPoint: rootfs,
+			},
+		},
+		Devices: []*pb.Device{
+			{
+				Type:          driverBlkType,
+				VmPath:        "/dev/does-not-exist",
+				ContainerPath: "/dev/vdb",
+			},
+		},
+		OCI: &pb.Spec{
+			Root: &pb.Root{
+				Path: rootfs,
+			},
+			Process: &pb.Process{
+				Args: []string{"/bin/sh"},
+				Cwd:  "/",
+				User: pb.User{},
+			},
+			Linux: &pb.Linux{
+				Namespaces: []pb.LinuxNamespace{
+					{Type: string(specs.MountNamespace)},
+				},
+			},
+			Hooks: &pb.Hooks{
+				Prestart: []pb.Hook{
+					{Path: "/does/not/exist"},
+				},
+			},
+		},
+	}
+}
+
+// setupDryRunHandlers makes sure the handlers used by the dry-run requests
+// are registered, since some tests replace them.
+func setupDryRunHandlers() func() {
+	savedDeviceHandlerList := deviceHandlerList
+	savedStorageHandlerList := storageHandlerList
+
+	deviceHandlerList = map[string]deviceHandler{
+		driverBlkType: virtioBlkDeviceHandler,
+	}
+	storageHandlerList = map[string]storageHandler{
+		driverBlkType:   virtioBlkStorageHandler,
+		driverLocalType: localStorageHandler,
+	}
+
+	return func() {
+		deviceHandlerList = savedDeviceHandlerList
+		storageHandlerList = savedStorageHandlerList
+	}
+}
+
+func TestCreateContainerDryRun(t *testing.T) {
+	assert := assert.New(t)
+
+	defer setupDryRunHandlers()()
+
+	tmpDir, err := ioutil.TempDir("", "dry-run")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	rootfs := filepath.Join(tmpDir, "rootfs")
+	containerID := filepath.Base(tmpDir)
+
+	hookPath := filepath.Join(tmpDir, "hook")
+	err = createFileWithPerms(hookPath, "#!/bin/sh\n", 0755)
+	assert.NoError(err)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers:        make(map[string]*container),
+			storages:          make(map[string]*sandboxStorage),
+			running:           true,
+			guestHooksPresent: true,
+			guestHooks: &specs.Hooks{
+				Poststart: []specs.Hook{
+					{Path: hookPath},
+				},
+			},
+		},
+	}
+
+	req := createDryRunRequest(containerID, rootfs)
+
+	resp, err := a.CreateContainerDryRun(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal([]string{rootfs, filepath.Join(rootfs, "volume")}, resp.StorageMountPoints)
+	assert.Equal([]string{"/does/not/exist", hookPath}, resp.Hooks)
+	assert.Equal([]string{
+		"device /dev/does-not-exist not present yet",
+		"hook /does/not/exist not found",
+	}, resp.Warnings)
+
+	// The rootfs is not provided by a storage anymore
+	req.Storages = req.Storages[:1]
+	req.Storages[0].DependsOn = nil
+	resp, err = a.CreateContainerDryRun(context.Background(), req)
+	assert.NoError(err)
+	assert.Contains(resp.Warnings, "rootfs "+rootfs+" not present")
+
+	// Nothing has been created
+	assert.Empty(a.sandbox.containers)
+	assert.Empty(a.sandbox.storages)
+	_, err = os.Stat(rootfs)
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(ociConfigBasePath, containerID))
+	assert.True(os.IsNotExist(err))
+}
+
+func TestCreateContainerDryRunInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	defer setupDryRunHandlers()()
+
+	tmpDir, err := ioutil.TempDir("", "dry-run")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	rootfs := filepath.Join(tmpDir, "rootfs")
+	containerID := filepath.Base(tmpDir)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			storages:   make(map[string]*sandboxStorage),
+			running:    true,
+		},
+	}
+
+	type testData struct {
+		update func(req *pb.CreateContainerRequest)
+	}
+
+	data := []testData{
+		{func(req *pb.CreateContainerRequest) { req.OCI = nil }},
+		{func(req *pb.CreateContainerRequest) { req.OCI.Process = nil }},
+		{func(req *pb.CreateContainerRequest) { req.Devices[0].Type = "foo" }},
+		{func(req *pb.CreateContainerRequest) { req.Devices[0].ContainerPath = "" }},
+		{func(req *pb.CreateContainerRequest) { req.Storages[0].Driver = "foo" }},
+		{func(req *pb.CreateContainerRequest) { req.Storages[1].DependsOn = []string{req.Storages[0].MountPoint} }},
+		{func(req *pb.CreateContainerRequest) {
+			req.OCI.Linux.Namespaces = append(req.OCI.Linux.Namespaces, pb.LinuxNamespace{Type: "foo"})
+		}},
+		{func(req *pb.CreateContainerRequest) {
+			req.OCI.Linux.Namespaces = append(req.OCI.Linux.Namespaces, pb.LinuxNamespace{Type: "NEWPID"})
+		}},
+		{func(req *pb.CreateContainerRequest) {
+			req.OCI.Linux.Namespaces = append(req.OCI.Linux.Namespaces, pb.LinuxNamespace{Type: "network", Path: "/proc/self/ns/ipc"})
+		}},
+		// The spec is checked as by CreateContainer.
+		{func(req *pb.CreateContainerRequest) {
+			req.OCI.Linux.Resources = &pb.LinuxResources{Network: &pb.LinuxNetwork{Priorities: []pb.LinuxInterfacePriority{{Name: "eth/0", Priority: 1}}}}
+		}},
+		{func(req *pb.CreateContainerRequest) {
+			req.OCI.Linux.Namespaces = append(req.OCI.Linux.Namespaces, pb.LinuxNamespace{Type: "network"})
+			req.OCI.Linux.Sysctl = map[string]string{"net.core.rmem_max": "65536"}
+		}},
+		{func(req *pb.CreateContainerRequest) {
+			req.OCI.Annotations = map[string]string{shmSizeAnnotation: "-1m"}
+		}},
+		{func(req *pb.CreateContainerRequest) {
+			req.OCI.Mounts = []pb.Mount{{Destination: "/", Type: "tmpfs", Source: "tmpfs"}}
+		}},
+	}
+
+	for i, d := range data {
+		req := createDryRunRequest(containerID, rootfs)
+		d.update(req)
+
+		_, err := a.CreateContainerDryRun(context.Background(), req)
+		assert.Error(err, "test %d", i)
+	}
+
+	// Nothing has been created
+	assert.Empty(a.sandbox.containers)
+	assert.Empty(a.sandbox.storages)
+	_, err = os.Stat(rootfs)
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(ociConfigBasePath, containerID))
+	assert.True(os.IsNotExist(err))
+}
@@ -0,0 +1,109 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"syscall"
+
+	"github.com/opencontainers/runc/libcontainer"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// libcontainerErrorCodes maps the libcontainer error codes to the gRPC codes
+// returned to the caller.
+var libcontainerErrorCodes = map[libcontainer.ErrorCode]codes.Code{
+	libcontainer.IdInUse:             codes.AlreadyExists,
+	libcontainer.InvalidIdFormat:     codes.InvalidArgument,
+	libcontainer.ContainerNotExists:  codes.NotFound,
+	libcontainer.ContainerPaused:     codes.FailedPrecondition,
+	libcontainer.ContainerNotStopped: codes.FailedPrecondition,
+	libcontainer.ContainerNotRunning: codes.FailedPrecondition,
+	libcontainer.ContainerNotPaused:  codes.FailedPrecondition,
+	libcontainer.NoProcessOps:        codes.FailedPrecondition,
+	libcontainer.ConfigInvalid:       codes.InvalidArgument,
+	libcontainer.ConsoleExists:       codes.AlreadyExists,
+	libcontainer.SystemError:         codes.Internal,
+}
+
+// errnoCodes maps the system call errors commonly returned by mount and
+// process operations to gRPC codes.
+var errnoCodes = map[syscall.Errno]codes.Code{
+	syscall.ENOENT:    codes.NotFound,
+	syscall.ESRCH:     codes.NotFound,
+	syscall.ENODEV:    codes.NotFound,
+	syscall.EEXIST:    codes.AlreadyExists,
+	syscall.EINVAL:    codes.InvalidArgument,
+	syscall.ENOTDIR:   codes.InvalidArgument,
+	syscall.EBUSY:     codes.FailedPrecondition,
+	syscall.ENOTEMPTY: codes.FailedPrecondition,
+	syscall.ETIMEDOUT: codes.DeadlineExceeded,
+}
+
+// errorCode returns the gRPC code matching err, or the first error it wraps
+// which can be classified. Errors that cannot be classified are considered
+// internal errors.
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	}
+
+	var lerr libcontainer.Error
+	if errors.As(err, &lerr) {
+		if code, ok := libcontainerErrorCodes[lerr.Code()]; ok {
+			return code
+		}
+	}
+
+	var errno syscall.Errno
+	if errors.As(err, &errno) {
+		if code, ok := errnoCodes[errno]; ok {
+			return code
+		}
+	}
+
+	return codes.Internal
+}
+
+// toGRPCError makes sure err carries a gRPC status code, so that callers can
+// decide whether to retry without parsing the error message. Errors already
+// holding a status are returned untouched, and the message is always kept.
+func toGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := grpcStatus.FromError(err); ok {
+		return err
+	}
+
+	return grpcStatus.Error(errorCode(err), err.Error())
+}
+
+// combineErrors returns a single error reporting all of errs, carrying the
+// gRPC code of the first one, or nil if errs is empty.
+func combineErrors(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = grpcStatus.Convert(err).Message()
+	}
+
+	return grpcStatus.Error(grpcStatus.Code(toGRPCError(errs[0])), strings.Join(msgs, "; "))
+}
@@ -0,0 +1,163 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"testing"
+
+	gpb "github.com/gogo/protobuf/types"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	pkgErrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+type testLibcontainerError struct {
+	code libcontainer.ErrorCode
+}
+
+func (e testLibcontainerError) Error() string {
+	return e.code.String()
+}
+
+func (e testLibcontainerError) Detail(w io.Writer) error {
+	return nil
+}
+
+func (e testLibcontainerError) Code() libcontainer.ErrorCode {
+	return e.code
+}
+
+func TestToGRPCError(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		err          error
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{grpcStatus.Error(codes.Unavailable, "unavailable"), codes.Unavailable},
+		{errors.New("something went wrong"), codes.Internal},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{context.Canceled, codes.Canceled},
+		{&os.PathError{Op: "open", Path: "/foo", Err: syscall.ENOENT}, codes.NotFound},
+		{&os.PathError{Op: "mkdir", Path: "/foo", Err: syscall.EEXIST}, codes.AlreadyExists},
+		{&os.LinkError{Op: "symlink", Old: "a", New: "b", Err: syscall.EEXIST}, codes.AlreadyExists},
+		{os.NewSyscallError("mount", syscall.EBUSY), codes.FailedPrecondition},
+		{syscall.EINVAL, codes.InvalidArgument},
+		{syscall.ESRCH, codes.NotFound},
+		{syscall.ETIMEDOUT, codes.DeadlineExceeded},
+		{syscall.EPERM, codes.Internal},
+		{testLibcontainerError{libcontainer.IdInUse}, codes.AlreadyExists},
+		{testLibcontainerError{libcontainer.ContainerNotExists}, codes.NotFound},
+		{testLibcontainerError{libcontainer.ContainerNotRunning}, codes.FailedPrecondition},
+		{testLibcontainerError{libcontainer.ConfigInvalid}, codes.InvalidArgument},
+		{testLibcontainerError{libcontainer.SystemError}, codes.Internal},
+		{fmt.Errorf("create: %w", &os.PathError{Op: "open", Path: "/foo", Err: syscall.ENOENT}), codes.NotFound},
+		{fmt.Errorf("start: %w", testLibcontainerError{libcontainer.ContainerNotRunning}), codes.FailedPrecondition},
+		{pkgErrors.Wrap(syscall.EBUSY, "unmount"), codes.FailedPrecondition},
+		{fmt.Errorf("wait: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+	}
+
+	for i, d := range data {
+		msg := fmt.Sprintf("test[%d]: %+v", i, d)
+
+		err := toGRPCError(d.err)
+		assert.Error(err, msg)
+
+		st, ok := grpcStatus.FromError(err)
+		assert.True(ok, msg)
+		assert.Equal(d.expectedCode, st.Code(), msg)
+		assert.Equal(grpcStatus.Convert(d.err).Message(), st.Message(), msg)
+	}
+
+	assert.NoError(toGRPCError(nil))
+}
+
+func TestCombineErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(combineErrors(nil))
+
+	err := errors.New("first")
+	assert.Equal(err, combineErrors([]error{err}))
+
+	err = combineErrors([]error{
+		&os.PathError{Op: "unmount", Path: "/foo", Err: syscall.EBUSY},
+		grpcStatus.Error(codes.NotFound, "second"),
+		errors.New("third"),
+	})
+	st, ok := grpcStatus.FromError(err)
+	assert.True(ok)
+	assert.Equal(codes.FailedPrecondition, st.Code())
+	assert.Equal("unmount /foo: device or resource busy; second; third", st.Message())
+}
+
+func TestUnaryInterceptorErrorCode(t *testing.T) {
+	assert := assert.New(t)
+
+	interceptor := makeUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/grpc.AgentService/Test",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return emptyResp, &os.PathError{Op: "stat", Path: "/foo", Err: syscall.ENOENT}
+	}
+
+	_, err := interceptor(context.Background(), &gpb.Empty{}, info, handler)
+	assert.Error(err)
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+	assert.Contains(err.Error(), "/foo")
+}
+
+func TestRPCErrorCodes(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &sandbox{
+		containers: make(map[string]*container),
+		running:    true,
+	}
+	a := &agentGRPC{sandbox: s}
+
+	s.containers[testContainerID] = &container{
+		id:        testContainerID,
+		container: &mockContainer{},
+	}
+
+	_, err := a.StartContainer(context.Background(), &pb.StartContainerRequest{ContainerId: "unknown"})
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: testContainerID})
+	assert.Equal(codes.AlreadyExists, grpcStatus.Code(err))
+
+	_, err = a.UpdateContainer(context.Background(), &pb.UpdateContainerRequest{ContainerId: testContainerID})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	_, err = a.ListProcesses(context.Background(), &pb.ListProcessesRequest{ContainerId: testContainerID, Format: "yaml"})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	s.running = false
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "new"})
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	err = mountStorage(pb.Storage{MountPoint: "/foo", Fstype: "ext4"})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	err = mount("/does/not/exist", "/foo", "bind", 0, "")
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+}
@@ -0,0 +1,149 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	eventCreated = "created"
+	eventStarted = "started"
+	eventExited  = "exited"
+	eventOOM     = "oom"
+
+	// Sandbox event, without container ID.
+	eventIdleShutdown = "idle-shutdown"
+
+	// Number of events kept for the consumers which are late or which
+	// resume the stream after a disconnection.
+	eventBufferSize = 1024
+)
+
+// eventBus keeps the last container lifecycle events. Consumers read them
+// from the shared buffer at their own pace, using the cursor of the last
+// event they got, so that a slow consumer never blocks the publishers. The
+// zero value is ready to use.
+type eventBus struct {
+	sync.Mutex
+
+	// oldest first, at most eventBufferSize
+	events []*pb.Event
+	// cursor of the last published event
+	cursor uint64
+	// closed when an event is published
+	notify chan struct{}
+}
+
+func (b *eventBus) notifyChLocked() chan struct{} {
+	if b.notify == nil {
+		b.notify = make(chan struct{})
+	}
+
+	return b.notify
+}
+
+// publish appends an event of type eventType for the container containerID.
+func (b *eventBus) publish(containerID, eventType string, exitCode int) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.cursor++
+
+	e := &pb.Event{
+		Cursor:      b.cursor,
+		ContainerId: containerID,
+		Type:        eventType,
+		ExitCode:    int32(exitCode),
+		Timestamp:   time.Now().UnixNano(),
+	}
+
+	if len(b.events) == eventBufferSize {
+		b.events = append(b.events[1:], e)
+	} else {
+		b.events = append(b.events, e)
+	}
+
+	close(b.notifyChLocked())
+	b.notify = nil
+}
+
+// since returns the buffered events following cursor, and a channel closed
+// once a new event gets published. It fails if some of the events following
+// cursor have already been dropped from the buffer.
+func (b *eventBus) since(cursor uint64) ([]*pb.Event, <-chan struct{}, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	if cursor > b.cursor {
+		return nil, nil, grpcStatus.Errorf(codes.InvalidArgument,
+			"Invalid event cursor %d, last event is %d", cursor, b.cursor)
+	}
+
+	var events []*pb.Event
+	if len(b.events) > 0 {
+		oldest := b.events[0].Cursor
+		if cursor+1 < oldest {
+			return nil, nil, grpcStatus.Errorf(codes.OutOfRange,
+				"Events following cursor %d have been dropped, oldest event is %d", cursor, oldest)
+		}
+
+		if cursor >= oldest {
+			events = b.events[cursor-oldest+1:]
+		} else {
+			events = b.events
+		}
+	}
+
+	// The slice is never modified in place, only appended to or resliced.
+	return events, b.notifyChLocked(), nil
+}
+
+// stream sends through send the events following cursor, then the new ones
+// as they get published, until ctx is done or send fails.
+func (b *eventBus) stream(ctx context.Context, cursor uint64, send func(*pb.Event) error) error {
+	for {
+		events, notify, err := b.since(cursor)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if err := send(e); err != nil {
+				return err
+			}
+			cursor = e.Cursor
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notify:
+		}
+	}
+}
+
+// forwardInitExit returns the channel the reaper has to notify with the exit
+// status of the init process of ctr. The status is published as an exit event
+// and handed over to WaitProcess through the exit code channel of proc.
+func (s *sandbox) forwardInitExit(ctr *container, proc *process) chan<- int {
+	reaperCh := make(chan int, 1)
+
+	go func() {
+		status := <-reaperCh
+		s.events.publish(ctr.id, eventExited, status)
+		proc.exitCodeCh <- status
+	}()
+
+	return reaperCh
+}
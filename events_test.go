@@ -0,0 +1,197 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+type testEvent struct {
+	containerID string
+	eventType   string
+	exitCode    int32
+}
+
+func toTestEvents(events []*pb.Event) []testEvent {
+	var result []testEvent
+	for _, e := range events {
+		result = append(result, testEvent{e.ContainerId, e.Type, e.ExitCode})
+	}
+	return result
+}
+
+// collectEvents streams the events of b following cursor until count of them
+// have been received.
+func collectEvents(t *testing.T, b *eventBus, cursor uint64, count int) []*pb.Event {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var events []*pb.Event
+	err := b.stream(ctx, cursor, func(e *pb.Event) error {
+		events = append(events, e)
+		if len(events) == count {
+			cancel()
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, count)
+
+	return events
+}
+
+func TestEventsLifecycles(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &sandbox{}
+
+	c1 := &container{id: "c1"}
+	c1.initProcess = &process{exitCodeCh: make(chan int, 1)}
+	c2 := &container{id: "c2"}
+	c2.initProcess = &process{exitCodeCh: make(chan int, 1)}
+
+	s.events.publish(c1.id, eventCreated, 0)
+	s.events.publish(c2.id, eventCreated, 0)
+	s.events.publish(c1.id, eventStarted, 0)
+
+	// Consume while the lifecycles go on.
+	done := make(chan []*pb.Event)
+	go func() {
+		done <- collectEvents(t, &s.events, 0, 7)
+	}()
+
+	s.events.publish(c2.id, eventStarted, 0)
+	s.events.publish(c2.id, eventOOM, 0)
+
+	// The reaper notifies the exit of the init processes.
+	s.forwardInitExit(c2, c2.initProcess) <- 137
+	assert.Equal(137, <-c2.initProcess.exitCodeCh)
+	s.forwardInitExit(c1, c1.initProcess) <- 0
+	assert.Equal(0, <-c1.initProcess.exitCodeCh)
+
+	events := <-done
+
+	assert.Equal([]testEvent{
+		{"c1", eventCreated, 0},
+		{"c2", eventCreated, 0},
+		{"c1", eventStarted, 0},
+		{"c2", eventStarted, 0},
+		{"c2", eventOOM, 0},
+		{"c2", eventExited, 137},
+		{"c1", eventExited, 0},
+	}, toTestEvents(events))
+
+	for i, e := range events {
+		assert.Equal(uint64(i+1), e.Cursor)
+		assert.NotZero(e.Timestamp)
+	}
+
+	// Resume after the third event.
+	resumed := collectEvents(t, &s.events, events[2].Cursor, 4)
+	assert.Equal(events[3:], resumed)
+
+	// Nothing to resume from the last event until a new one comes.
+	s.events.publish(c1.id, eventCreated, 0)
+	resumed = collectEvents(t, &s.events, events[6].Cursor, 1)
+	assert.Equal([]testEvent{{"c1", eventCreated, 0}}, toTestEvents(resumed))
+}
+
+func TestEventsCursor(t *testing.T) {
+	assert := assert.New(t)
+
+	b := &eventBus{}
+
+	events, notify, err := b.since(0)
+	assert.NoError(err)
+	assert.Empty(events)
+	assert.NotNil(notify)
+
+	_, _, err = b.since(1)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	b.publish("c1", eventCreated, 0)
+
+	select {
+	case <-notify:
+	default:
+		t.Fatal("publish did not notify the consumers")
+	}
+
+	// Slow consumers lose the events dropped from the buffer.
+	for i := 0; i < eventBufferSize; i++ {
+		b.publish("c1", eventOOM, 0)
+	}
+
+	_, _, err = b.since(0)
+	assert.Equal(codes.OutOfRange, grpcStatus.Code(err))
+
+	events, _, err = b.since(1)
+	assert.NoError(err)
+	assert.Len(events, eventBufferSize)
+	assert.Equal(uint64(2), events[0].Cursor)
+
+	events, _, err = b.since(eventBufferSize + 1)
+	assert.NoError(err)
+	assert.Empty(events)
+}
+
+type testEventsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	count  int
+	events []*pb.Event
+}
+
+func (s *testEventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testEventsStream) Send(e *pb.Event) error {
+	time.Sleep(time.Millisecond)
+
+	s.events = append(s.events, e)
+	if len(s.events) == s.count {
+		s.cancel()
+	}
+
+	return nil
+}
+
+func TestEventsSlowClient(t *testing.T) {
+	assert := assert.New(t)
+
+	// The buffer only holds a single event.
+	reset := setStreamBufferConfig(1, 1, streamBufferPolicyDrop)
+	defer reset()
+
+	a := &agentGRPC{sandbox: &sandbox{}}
+	for i := 0; i < 10; i++ {
+		a.sandbox.events.publish("c1", eventOOM, 0)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream := &testEventsStream{ctx: ctx, cancel: cancel, count: 10}
+	err := a.Events(&pb.EventsRequest{}, stream)
+	assert.NoError(err)
+
+	// No event is dropped for a slow client.
+	assert.Len(stream.events, 10)
+	for i, e := range stream.events {
+		assert.Equal(uint64(i+1), e.Cursor, "event %d", i)
+	}
+}
@@ -0,0 +1,146 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/opencontainers/runc/libcontainer"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// The container processes which libcontainer cannot fully set up are
+// executed through the agent binary, which completes their setup right
+// before executing their command. libcontainer runs the container processes
+// from a sealed copy of the agent binary, which /proc/self/exe refers to in
+// the container.
+const (
+	execProcessPath = "/proc/self/exe"
+	execProcessArg  = "exec-process"
+)
+
+// execProcessArgs returns the arguments executing path through the agent
+// binary with args as its arguments, args[0] being only its argv[0] unless
+// path is empty, and umask as its umask unless nil.
+func execProcessArgs(path string, args []string, umask *uint32) []string {
+	umaskArg := ""
+	if umask != nil {
+		umaskArg = strconv.FormatUint(uint64(*umask), 8)
+	}
+
+	return append([]string{execProcessPath, execProcessArg, umaskArg, path}, args...)
+}
+
+// envPath returns the PATH of env, or defaultPath if it has none.
+func envPath(env []string, defaultPath string) string {
+	path := defaultPath
+	for _, e := range env {
+		if strings.HasPrefix(e, "PATH=") {
+			path = strings.TrimPrefix(e, "PATH=")
+		}
+	}
+
+	return path
+}
+
+// findContainerExecutable returns the path, relative to the container root,
+// of the executable name looked up like exec.LookPath() does from the
+// directory cwd of the container, with path as PATH.
+func findContainerExecutable(root, cwd, name, path string) (string, error) {
+	var candidates []string
+	if strings.Contains(name, "/") {
+		candidates = []string{filepath.Join("/", cwd, name)}
+	} else {
+		for _, dir := range filepath.SplitList(path) {
+			if filepath.IsAbs(dir) {
+				candidates = append(candidates, filepath.Join(dir, name))
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		fullPath, err := securejoin.SecureJoin(root, candidate)
+		if err != nil {
+			continue
+		}
+
+		if fi, err := os.Stat(fullPath); err == nil && fi.Mode().IsRegular() && fi.Mode()&0111 != 0 {
+			return candidate, nil
+		}
+	}
+
+	return "", grpcStatus.Errorf(codes.NotFound, "Executable %q not found in container PATH %q", name, path)
+}
+
+// checkExecProcessCommand checks that the command of the process proc of
+// ctr, when executed through the agent binary, is found in the container
+// root. libcontainer fails to start a process whose command is missing, while
+// execProcess() only fails once the process runs.
+func checkExecProcessCommand(ctr *container, proc *libcontainer.Process) error {
+	args := proc.Args
+	if len(args) < 5 || args[0] != execProcessPath || args[1] != execProcessArg {
+		return nil
+	}
+
+	root, err := getContainerRoot(ctr)
+	if err != nil {
+		return err
+	}
+
+	path := args[3]
+	if path == "" {
+		path = args[4]
+	}
+
+	_, err = findContainerExecutable(root, proc.Cwd, path, envPath(proc.Env, ""))
+	return err
+}
+
+// execProcess is run by the agent binary in place of the container process
+// execProcessArgs built the arguments of, from the remaining arguments. It
+// sets the umask, then looks up and executes the command like libcontainer,
+// and never returns.
+func execProcess(args []string) {
+	if len(args) < 3 {
+		fmt.Fprintf(os.Stderr, "%s: missing command\n", execProcessArg)
+		os.Exit(127)
+	}
+
+	umaskArg, path, argv := args[0], args[1], args[2:]
+
+	if umaskArg != "" {
+		umask, err := strconv.ParseUint(umaskArg, 8, 32)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: invalid umask %q\n", execProcessArg, umaskArg)
+			os.Exit(126)
+		}
+
+		unix.Umask(int(umask))
+	}
+
+	if path == "" {
+		path = argv[0]
+	}
+
+	name, err := exec.LookPath(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", execProcessArg, err)
+		os.Exit(127)
+	}
+
+	err = unix.Exec(name, argv, os.Environ())
+	fmt.Fprintf(os.Stderr, "%s: could not execute %s: %v\n", execProcessArg, name, err)
+	os.Exit(126)
+}
@@ -0,0 +1,162 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os/exec"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestExecProcessArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	umask := uint32(0027)
+
+	args := execProcessArgs("/bin/busybox", []string{"ls", "-l"}, nil)
+	assert.Equal([]string{execProcessPath, execProcessArg, "", "/bin/busybox", "ls", "-l"}, args)
+
+	args = execProcessArgs("", []string{"ls", "-l"}, &umask)
+	assert.Equal([]string{execProcessPath, execProcessArg, "27", "", "ls", "-l"}, args)
+}
+
+func TestExecProcessCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		path             string
+		args             []string
+		umask            *uint32
+		expectedOutput   string
+		expectedExitCode int
+	}
+
+	umask := func(umask uint32) *uint32 {
+		return &umask
+	}
+
+	data := []testData{
+		{"sh", []string{"-login", "-c", "echo $0"}, nil, "-login\n", 0},
+		{"/bin/sh", []string{"applet", "-c", "echo $0"}, nil, "applet\n", 0},
+		{"", []string{"sh", "-c", "umask"}, umask(0077), "0077\n", 0},
+		{"", []string{"sh", "-c", "umask"}, umask(0), "0000\n", 0},
+		{"sh", []string{"-sh", "-c", "echo $0; umask"}, umask(0027), "-sh\n0027\n", 0},
+		{"sh", []string{"sh", "-c", "exit 3"}, nil, "", 3},
+		{"does-not-exist", []string{"foo"}, nil, "", 127},
+		{"/etc", []string{"foo"}, nil, "", 127},
+	}
+
+	// The test binary runs the process like the agent binary.
+	for i, d := range data {
+		args := execProcessArgs(d.path, d.args, d.umask)
+
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Env = []string{"PATH=/bin:/usr/bin"}
+
+		output, err := cmd.Output()
+		assert.Equal(d.expectedOutput, string(output), "test %d (%+v)", i, d)
+
+		exitCode := 0
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			exitCode = exitErr.ExitCode()
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+		assert.Equal(d.expectedExitCode, exitCode, "test %d (%+v)", i, d)
+	}
+
+	// The command is missing.
+	cmd := exec.Command(execProcessPath, execProcessArg, "", "sh")
+	err := cmd.Run()
+	assert.Error(err)
+
+	cmd = exec.Command(execProcessPath, execProcessArg, "999", "", "true")
+	err = cmd.Run()
+	assert.Error(err)
+}
+
+func TestFindContainerExecutable(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		cwd          string
+		name         string
+		path         string
+		expectedPath string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{"/", "sh", "/bin", "/bin/sh", codes.OK},
+		{"/", "sh", "/does/not/exist:/bin", "/bin/sh", codes.OK},
+		{"/", "/bin/sh", "", "/bin/sh", codes.OK},
+		{"/bin", "./sh", "", "/bin/sh", codes.OK},
+		// The relative PATH directories are ignored, like exec.LookPath()
+		// does.
+		{"/", "sh", "bin", "", codes.NotFound},
+		{"/", "sh", "", "", codes.NotFound},
+		{"/", "does-not-exist", "/bin", "", codes.NotFound},
+		{"/", "/etc", "", "", codes.NotFound},
+	}
+
+	for i, d := range data {
+		path, err := findContainerExecutable("/", d.cwd, d.name, d.path)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.Equal(d.expectedPath, path, "test %d (%+v)", i, d)
+	}
+}
+
+func TestCheckExecProcessCommand(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	c, cleanup := createTestContainer(t, "test-exec-process-command")
+	defer cleanup()
+
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"sleep", "100"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	ctr := &container{id: "test-exec-process-command", container: c, initProcess: initProc}
+
+	// The commands are looked up in the container root, the test rootfs
+	// only holding symlinks to the /usr bind mount.
+	type testData struct {
+		process      *pb.Process
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{&pb.Process{Path: "sh", Args: []string{"-sh"}, Env: []string{"PATH=/bin"}, Cwd: "/"}, codes.OK},
+		{&pb.Process{Path: "/usr/bin/sh", Args: []string{"-sh"}, Cwd: "/"}, codes.OK},
+		{&pb.Process{Path: "does-not-exist", Args: []string{"foo"}, Env: []string{"PATH=/bin"}, Cwd: "/"}, codes.NotFound},
+		{&pb.Process{Path: "sh", Args: []string{"-sh"}, Cwd: "/"}, codes.NotFound},
+		// The processes executed by libcontainer are left to it.
+		{&pb.Process{Args: []string{"does-not-exist"}, Env: []string{"PATH=/bin"}, Cwd: "/"}, codes.OK},
+	}
+
+	for i, d := range data {
+		proc, err := buildProcess(d.process, "exec", false)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		err = checkExecProcessCommand(ctr, &proc.process)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+	}
+}
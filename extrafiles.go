@@ -0,0 +1,169 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"net"
+	"os"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	// Number of the first extra file of a process, following its stdio.
+	firstExtraFd = 3
+
+	// Highest fd number an extra file can be passed at.
+	maxExtraFd = 255
+)
+
+// listenExtraFile returns the file of a socket listening on address, which
+// is like "unix:/run/app.sock", from the network namespace of ctr. The path of
+// a unix socket is resolved inside the container root.
+func listenExtraFile(ctr *container, address string) (*os.File, error) {
+	fields := strings.SplitN(address, ":", 2)
+	if len(fields) != 2 {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid listen address %q", address)
+	}
+
+	network := fields[0]
+	switch network {
+	case "tcp", "tcp4", "tcp6", "unix", "unixpacket":
+	default:
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid network %q of listen address %q", network, address)
+	}
+
+	addr := fields[1]
+	if strings.HasPrefix(network, "unix") && !strings.HasPrefix(addr, "@") {
+		root, err := getContainerRoot(ctr)
+		if err != nil {
+			return nil, err
+		}
+
+		if addr, err = securejoin.SecureJoin(root, addr); err != nil {
+			return nil, err
+		}
+	}
+
+	nsPath, err := getContainerNsPath(ctr, nsTypeNet)
+	if err != nil {
+		return nil, err
+	}
+
+	var f *os.File
+
+	err = runInNamespace(nsPath, nsTypeNet, func() error {
+		l, err := net.Listen(network, addr)
+		if err != nil {
+			return err
+		}
+
+		// The socket must outlive the listener, which is closed once
+		// its file has been duplicated.
+		switch l := l.(type) {
+		case *net.TCPListener:
+			f, err = l.File()
+		case *net.UnixListener:
+			l.SetUnlinkOnClose(false)
+			f, err = l.File()
+		}
+
+		l.Close()
+
+		return err
+	})
+
+	return f, err
+}
+
+// openExtraFile opens the file described by file for a process of ctr, its
+// path being resolved inside the container root, without clearing its
+// close-on-exec flag, the file being duplicated in the process.
+func openExtraFile(ctr *container, file *pb.ExtraFile) (*os.File, error) {
+	if (file.Path == "") == (file.Listen == "") {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Extra file %d must have either a path or a listen address", file.Fd)
+	}
+
+	if file.Listen != "" {
+		return listenExtraFile(ctr, file.Listen)
+	}
+
+	root, err := getContainerRoot(ctr)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := securejoin.SecureJoin(root, file.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.OpenFile(path, int(file.Flags), 0)
+}
+
+// openExtraFiles opens the extra files of an exec process of ctr, returning
+// them ordered by their fd number in the process, starting from firstExtraFd.
+// The gaps between the fd numbers are filled with /dev/null.
+func openExtraFiles(ctr *container, files []*pb.ExtraFile) ([]*os.File, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	byFd := make(map[uint32]*pb.ExtraFile)
+	lastFd := uint32(0)
+
+	for _, file := range files {
+		if file.Fd < firstExtraFd || file.Fd > maxExtraFd {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Extra file fd %d out of range [%d, %d]", file.Fd, firstExtraFd, maxExtraFd)
+		}
+
+		if _, ok := byFd[file.Fd]; ok {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Extra file fd %d specified more than once", file.Fd)
+		}
+
+		byFd[file.Fd] = file
+
+		if file.Fd > lastFd {
+			lastFd = file.Fd
+		}
+	}
+
+	var extraFiles []*os.File
+
+	for fd := uint32(firstExtraFd); fd <= lastFd; fd++ {
+		var f *os.File
+		var err error
+
+		if file, ok := byFd[fd]; ok {
+			f, err = openExtraFile(ctr, file)
+		} else {
+			f, err = os.Open(os.DevNull)
+		}
+
+		if err != nil {
+			if _, ok := grpcStatus.FromError(err); !ok {
+				err = grpcStatus.Errorf(codes.Internal, "Could not open extra file %d: %v", fd, err)
+			}
+			closeFiles(extraFiles)
+			return nil, err
+		}
+
+		extraFiles = append(extraFiles, f)
+	}
+
+	return extraFiles, nil
+}
+
+func closeFiles(files []*os.File) {
+	for _, f := range files {
+		f.Close()
+	}
+}
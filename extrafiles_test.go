@@ -0,0 +1,171 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestOpenExtraFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "extra-files")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	// The paths are resolved inside the container root.
+	savedGetContainerRoot := getContainerRoot
+	getContainerRoot = func(ctr *container) (string, error) {
+		return tmpDir, nil
+	}
+	defer func() {
+		getContainerRoot = savedGetContainerRoot
+	}()
+
+	ctr := &container{id: testContainerID}
+
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "file"), []byte("data"), 0644)
+	assert.NoError(err)
+	err = os.Symlink("/file", filepath.Join(tmpDir, "link"))
+	assert.NoError(err)
+
+	path := "/file"
+	opened := filepath.Join(tmpDir, "file")
+	socket := "/socket"
+
+	type testData struct {
+		files        []*pb.ExtraFile
+		expectedCode codes.Code
+		// names of the opened files
+		expectedNames []string
+	}
+
+	data := []testData{
+		{nil, codes.OK, nil},
+		{[]*pb.ExtraFile{{Fd: 3, Path: path}}, codes.OK, []string{opened}},
+		{[]*pb.ExtraFile{{Fd: 3, Path: "/link"}}, codes.OK, []string{opened}},
+		// The gaps are filled with /dev/null.
+		{[]*pb.ExtraFile{{Fd: 5, Path: path}, {Fd: 3, Path: path}}, codes.OK, []string{opened, os.DevNull, opened}},
+		{[]*pb.ExtraFile{{Fd: 2, Path: path}}, codes.InvalidArgument, nil},
+		{[]*pb.ExtraFile{{Fd: maxExtraFd + 1, Path: path}}, codes.InvalidArgument, nil},
+		{[]*pb.ExtraFile{{Fd: 3, Path: path}, {Fd: 3, Path: path}}, codes.InvalidArgument, nil},
+		{[]*pb.ExtraFile{{Fd: 3}}, codes.InvalidArgument, nil},
+		{[]*pb.ExtraFile{{Fd: 3, Path: path, Listen: "unix:" + socket}}, codes.InvalidArgument, nil},
+		{[]*pb.ExtraFile{{Fd: 3, Listen: socket}}, codes.InvalidArgument, nil},
+		{[]*pb.ExtraFile{{Fd: 3, Listen: "udp:127.0.0.1:0"}}, codes.InvalidArgument, nil},
+		{[]*pb.ExtraFile{{Fd: 3, Path: path}, {Fd: 4, Path: path + ".missing"}}, codes.Internal, nil},
+	}
+
+	for i, d := range data {
+		files, err := openExtraFiles(ctr, d.files)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		if d.expectedCode != codes.OK {
+			assert.Nil(files, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.Len(files, len(d.expectedNames), "test %d (%+v)", i, d)
+		for j, f := range files {
+			if d.expectedNames[j] != "" {
+				assert.Equal(d.expectedNames[j], f.Name(), "test %d (%+v)", i, d)
+			}
+		}
+
+		closeFiles(files)
+	}
+
+	// The sockets are created from the network namespace of the container.
+	skipUnlessRoot(t)
+
+	nsPath := getCurrentThreadNSPath(nsTypeNet)
+
+	savedGetContainerNsPath := getContainerNsPath
+	getContainerNsPath = func(ctr *container, nType nsType) (string, error) {
+		return nsPath, nil
+	}
+	defer func() {
+		getContainerNsPath = savedGetContainerNsPath
+	}()
+
+	files, err := openExtraFiles(ctr, []*pb.ExtraFile{{Fd: 4, Listen: "unix:" + socket}})
+	assert.NoError(err)
+	assert.Len(files, 2)
+	assert.Equal(os.DevNull, files[0].Name())
+	closeFiles(files)
+
+	// The socket is left for the process to accept connections on.
+	info, err := os.Stat(filepath.Join(tmpDir, socket))
+	assert.NoError(err)
+	assert.Equal(os.ModeSocket, info.Mode()&os.ModeType)
+}
+
+func TestExtraFilesExec(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	c, cleanup := createTestContainer(t, "test-extra-files")
+	defer cleanup()
+
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"sleep", "100"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	ctr := &container{id: "test-extra-files", container: c, initProcess: initProc}
+
+	// The files are found in the container /dev tmpfs.
+	root, err := getContainerRoot(ctr)
+	assert.NoError(err)
+
+	for _, name := range []string{"file", "other"} {
+		err = ioutil.WriteFile(filepath.Join(root, "dev", name), []byte(name+"\n"), 0644)
+		assert.NoError(err)
+	}
+
+	proc, err := buildProcess(&pb.Process{
+		Args: []string{"sh", "-c", "cat <&5; cat <&3; cat <&4; [ -S /dev/app.sock ] && echo socket; if [ -e /proc/self/fd/7 ]; then echo leaked; fi"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "exec", false)
+	assert.NoError(err)
+
+	proc.process.ExtraFiles, err = openExtraFiles(ctr, []*pb.ExtraFile{
+		{Fd: 3, Path: "/dev/file"},
+		{Fd: 5, Path: "/dev/other"},
+		{Fd: 6, Listen: "unix:/dev/app.sock"},
+	})
+	assert.NoError(err)
+	extraFiles := proc.process.ExtraFiles
+
+	// Only the extra files are inherited, fd 4 being /dev/null.
+	assert.Equal("other\nfile\nsocket\n", runTestProcess(t, c, proc))
+
+	// The socket is created in the container root.
+	_, err = os.Stat("/dev/app.sock")
+	assert.True(os.IsNotExist(err))
+
+	// The agent copies are closed once the process has started.
+	for _, f := range extraFiles {
+		assert.Equal(^uintptr(0), f.Fd())
+	}
+}
@@ -0,0 +1,211 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	// _IOWR('X', 119, int) and _IOWR('X', 120, int) from linux/fs.h
+	ioctlFIFREEZE = 0xc0045877
+	ioctlFITHAW   = 0xc0045878
+
+	defaultFreezeTimeout = 60 * time.Second
+)
+
+// Filesystems implementing the freeze_fs super block operation.
+var freezableFSTypes = map[string]bool{
+	"btrfs": true,
+	"ext2":  true,
+	"ext3":  true,
+	"ext4":  true,
+	"f2fs":  true,
+	"jfs":   true,
+	"xfs":   true,
+}
+
+// set function in variable to overwrite for testing.
+var fsFreezeIoctl = fsFreezeIoctlImpl
+var getFreezableMountPoints = getFreezableMountPointsImpl
+
+func fsFreezeIoctlImpl(mountPoint string, freeze bool) error {
+	f, err := os.Open(mountPoint)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	req := uintptr(ioctlFITHAW)
+	if freeze {
+		req = ioctlFIFREEZE
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), req, 0); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+// getFreezableMountPointsImpl returns the mount points of the filesystems
+// supporting freeze, children mounts first.
+func getFreezableMountPointsImpl() ([]string, error) {
+	mounts, err := mountinfo.GetMounts()
+	if err != nil {
+		return nil, err
+	}
+
+	return freezableMountPoints(mounts), nil
+}
+
+// freezableMountPoints returns a mount point for each filesystem of mounts
+// supporting freeze, children mounts first. A filesystem is frozen as a
+// whole, and freezing it again through another of its mount points, such as
+// a bind mount, fails.
+func freezableMountPoints(mounts []*mountinfo.Info) []string {
+	seen := make(map[string]bool)
+	var mountPoints []string
+
+	for i := len(mounts) - 1; i >= 0; i-- {
+		m := mounts[i]
+		device := fmt.Sprintf("%d:%d", m.Major, m.Minor)
+		if !freezableFSTypes[m.Fstype] || seen[device] {
+			continue
+		}
+
+		seen[device] = true
+		mountPoints = append(mountPoints, m.Mountpoint)
+	}
+
+	return mountPoints
+}
+
+// fsFreezer keeps track of the frozen filesystems so that every freeze is
+// matched by a thaw, either requested or triggered by a timeout.
+type fsFreezer struct {
+	sync.Mutex
+
+	frozen     []string
+	timer      *time.Timer
+	generation uint64
+}
+
+func (f *fsFreezer) freeze(mountPoints []string, timeout time.Duration) ([]string, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if len(f.frozen) > 0 {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Filesystems %v already frozen", f.frozen)
+	}
+
+	if len(mountPoints) == 0 {
+		var err error
+		if mountPoints, err = getFreezableMountPoints(); err != nil {
+			return nil, grpcStatus.Errorf(codes.Internal, "Could not list filesystems: %v", err)
+		}
+	}
+
+	for _, m := range mountPoints {
+		if err := fsFreezeIoctl(m, true); err != nil {
+			// Do not leave the guest with some filesystems frozen.
+			if _, thawErr := f.thawLocked(); thawErr != nil {
+				return nil, combineErrors([]error{
+					grpcStatus.Errorf(codes.Internal, "Could not freeze filesystem %q: %v", m, err),
+					thawErr,
+				})
+			}
+			return nil, grpcStatus.Errorf(codes.Internal, "Could not freeze filesystem %q: %v", m, err)
+		}
+
+		f.frozen = append(f.frozen, m)
+	}
+
+	if timeout == 0 {
+		timeout = defaultFreezeTimeout
+	}
+
+	f.generation++
+	generation := f.generation
+
+	f.timer = time.AfterFunc(timeout, func() {
+		f.Lock()
+		defer f.Unlock()
+
+		// The filesystems might have been thawed and frozen again
+		// while the timer was firing.
+		if f.generation != generation || len(f.frozen) == 0 {
+			return
+		}
+
+		agentLog.WithField("mount-points", f.frozen).Warn("Thawing filesystems after freeze timeout")
+		if _, err := f.thawLocked(); err != nil {
+			agentLog.WithError(err).WithField("mount-points", f.frozen).Error("Filesystems left frozen after freeze timeout")
+		}
+	})
+
+	agentLog.WithFields(logrus.Fields{
+		"mount-points": f.frozen,
+		"timeout":      timeout,
+	}).Info("froze filesystems")
+
+	return append([]string{}, f.frozen...), nil
+}
+
+func (f *fsFreezer) thaw() ([]string, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if len(f.frozen) == 0 {
+		return nil, grpcStatus.Error(codes.FailedPrecondition, "No filesystem frozen")
+	}
+
+	thawed, err := f.thawLocked()
+
+	agentLog.WithField("mount-points", thawed).Info("thawed filesystems")
+
+	return thawed, err
+}
+
+// thawLocked thaws the frozen filesystems in the reverse order they have
+// been frozen, returning the thawed ones. The filesystems which could not be
+// thawed are kept track of for a later thaw, and reported by the error. It
+// must be called with the lock held.
+func (f *fsFreezer) thawLocked() ([]string, error) {
+	if f.timer != nil {
+		f.timer.Stop()
+		f.timer = nil
+	}
+
+	var thawed, failed []string
+	var errs []error
+
+	for i := len(f.frozen) - 1; i >= 0; i-- {
+		m := f.frozen[i]
+		if err := fsFreezeIoctl(m, false); err != nil {
+			agentLog.WithError(err).WithField("mount-point", m).Error("Could not thaw filesystem")
+			failed = append([]string{m}, failed...)
+			errs = append(errs, grpcStatus.Errorf(codes.Internal, "Could not thaw filesystem %q: %v", m, err))
+			continue
+		}
+
+		thawed = append(thawed, m)
+	}
+
+	f.frozen = failed
+
+	return thawed, combineErrors(errs)
+}
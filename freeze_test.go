@@ -0,0 +1,249 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+type fakeFreezeIoctl struct {
+	sync.Mutex
+	calls  []string
+	failOn string
+}
+
+func (f *fakeFreezeIoctl) ioctl(mountPoint string, freeze bool) error {
+	f.Lock()
+	defer f.Unlock()
+
+	op := "thaw"
+	if freeze {
+		op = "freeze"
+	}
+
+	call := op + ":" + mountPoint
+	if call == f.failOn {
+		return fmt.Errorf("%s failure", call)
+	}
+
+	f.calls = append(f.calls, call)
+	return nil
+}
+
+func (f *fakeFreezeIoctl) getCalls() []string {
+	f.Lock()
+	defer f.Unlock()
+
+	return append([]string{}, f.calls...)
+}
+
+func setupFakeFreezeIoctl() (*fakeFreezeIoctl, func()) {
+	fake := &fakeFreezeIoctl{}
+
+	savedFsFreezeIoctl := fsFreezeIoctl
+	savedGetFreezableMountPoints := getFreezableMountPoints
+
+	fsFreezeIoctl = fake.ioctl
+	getFreezableMountPoints = func() ([]string, error) {
+		return []string{"/b", "/a"}, nil
+	}
+
+	return fake, func() {
+		fsFreezeIoctl = savedFsFreezeIoctl
+		getFreezableMountPoints = savedGetFreezableMountPoints
+	}
+}
+
+func TestFreezeThawFS(t *testing.T) {
+	assert := assert.New(t)
+
+	fake, restore := setupFakeFreezeIoctl()
+	defer restore()
+
+	a := &agentGRPC{
+		sandbox: &sandbox{},
+	}
+
+	// Nothing to thaw
+	_, err := a.ThawFS(context.TODO(), &pb.ThawFSRequest{})
+	assert.Error(err)
+
+	resp, err := a.FreezeFS(context.TODO(), &pb.FreezeFSRequest{})
+	assert.NoError(err)
+	assert.Equal([]string{"/b", "/a"}, resp.MountPoints)
+
+	// A second freeze must be matched by a thaw first
+	_, err = a.FreezeFS(context.TODO(), &pb.FreezeFSRequest{MountPoints: []string{"/c"}})
+	assert.Error(err)
+
+	thawResp, err := a.ThawFS(context.TODO(), &pb.ThawFSRequest{})
+	assert.NoError(err)
+	assert.Equal([]string{"/a", "/b"}, thawResp.MountPoints)
+
+	_, err = a.ThawFS(context.TODO(), &pb.ThawFSRequest{})
+	assert.Error(err)
+
+	resp, err = a.FreezeFS(context.TODO(), &pb.FreezeFSRequest{MountPoints: []string{"/c"}})
+	assert.NoError(err)
+	assert.Equal([]string{"/c"}, resp.MountPoints)
+
+	thawResp, err = a.ThawFS(context.TODO(), &pb.ThawFSRequest{})
+	assert.NoError(err)
+	assert.Equal([]string{"/c"}, thawResp.MountPoints)
+
+	assert.Equal([]string{"freeze:/b", "freeze:/a", "thaw:/a", "thaw:/b", "freeze:/c", "thaw:/c"}, fake.getCalls())
+}
+
+func TestFreezeFSFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	fake, restore := setupFakeFreezeIoctl()
+	defer restore()
+
+	fake.failOn = "freeze:/a"
+
+	f := &fsFreezer{}
+
+	_, err := f.freeze([]string{"/c", "/b", "/a"}, 0)
+	assert.Error(err)
+
+	// The filesystems frozen before the failure got thawed
+	assert.Equal([]string{"freeze:/c", "freeze:/b", "thaw:/b", "thaw:/c"}, fake.getCalls())
+	assert.Empty(f.frozen)
+	assert.Nil(f.timer)
+
+	getFreezableMountPoints = func() ([]string, error) {
+		return nil, fmt.Errorf("mount table failure")
+	}
+
+	_, err = f.freeze(nil, 0)
+	assert.Error(err)
+}
+
+func TestThawFSFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	fake, restore := setupFakeFreezeIoctl()
+	defer restore()
+
+	fake.failOn = "thaw:/b"
+
+	f := &fsFreezer{}
+
+	_, err := f.freeze([]string{"/a", "/b", "/c"}, 0)
+	assert.NoError(err)
+
+	thawed, err := f.thaw()
+	assert.Equal(codes.Internal, grpcStatus.Code(err))
+	assert.Contains(err.Error(), `"/b"`)
+	assert.Equal([]string{"/c", "/a"}, thawed)
+
+	// The filesystem left frozen is still tracked.
+	assert.Equal([]string{"/b"}, f.frozen)
+	_, err = f.freeze([]string{"/c"}, 0)
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	fake.failOn = ""
+	thawed, err = f.thaw()
+	assert.NoError(err)
+	assert.Equal([]string{"/b"}, thawed)
+	assert.Empty(f.frozen)
+
+	// So is it when the timeout fails to thaw it.
+	fake.failOn = "thaw:/a"
+	_, err = f.freeze([]string{"/a", "/b"}, 10*time.Millisecond)
+	assert.NoError(err)
+
+	for i := 0; i < 100 && len(fake.getCalls()) < 9; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	f.Lock()
+	assert.Equal([]string{"/a"}, f.frozen)
+	f.Unlock()
+
+	fake.failOn = ""
+	thawed, err = f.thaw()
+	assert.NoError(err)
+	assert.Equal([]string{"/a"}, thawed)
+
+	assert.Equal([]string{"freeze:/a", "freeze:/b", "freeze:/c", "thaw:/c", "thaw:/a", "thaw:/b",
+		"freeze:/a", "freeze:/b", "thaw:/b", "thaw:/a"}, fake.getCalls())
+}
+
+func TestFreezeFSTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	fake, restore := setupFakeFreezeIoctl()
+	defer restore()
+
+	f := &fsFreezer{}
+
+	_, err := f.freeze([]string{"/a", "/b"}, 10*time.Millisecond)
+	assert.NoError(err)
+
+	for i := 0; i < 100 && len(fake.getCalls()) < 4; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.Equal([]string{"freeze:/a", "freeze:/b", "thaw:/b", "thaw:/a"}, fake.getCalls())
+
+	// The timeout thawed the filesystems already
+	_, err = f.thaw()
+	assert.Error(err)
+
+	// A timer must not fire once the filesystems have been thawed
+	_, err = f.freeze([]string{"/c"}, 10*time.Millisecond)
+	assert.NoError(err)
+	_, err = f.thaw()
+	assert.NoError(err)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal([]string{"freeze:/a", "freeze:/b", "thaw:/b", "thaw:/a", "freeze:/c", "thaw:/c"}, fake.getCalls())
+}
+
+func TestGetFreezableMountPoints(t *testing.T) {
+	assert := assert.New(t)
+
+	mountPoints, err := getFreezableMountPointsImpl()
+	assert.NoError(err)
+
+	seen := make(map[string]bool)
+	for _, m := range mountPoints {
+		assert.False(seen[m], "duplicate mount point %q", m)
+		seen[m] = true
+	}
+}
+
+func TestFreezableMountPoints(t *testing.T) {
+	assert := assert.New(t)
+
+	mounts := []*mountinfo.Info{
+		{Mountpoint: "/", Fstype: "ext4", Major: 253, Minor: 1},
+		{Mountpoint: "/proc", Fstype: "proc", Major: 0, Minor: 4},
+		{Mountpoint: "/data", Fstype: "xfs", Major: 8, Minor: 16},
+		// Bind mounts of the filesystems above.
+		{Mountpoint: "/run/a", Fstype: "ext4", Major: 253, Minor: 1},
+		{Mountpoint: "/run/b", Fstype: "xfs", Major: 8, Minor: 16},
+		{Mountpoint: "/run/c", Fstype: "ext4", Major: 253, Minor: 1},
+		{Mountpoint: "/data/d", Fstype: "ext4", Major: 8, Minor: 32},
+	}
+
+	// Each filesystem is frozen once, children mounts first.
+	assert.Equal([]string{"/data/d", "/run/c", "/run/b"}, freezableMountPoints(mounts))
+	assert.Empty(freezableMountPoints(nil))
+}
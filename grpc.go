@@ -12,6 +12,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -46,10 +47,10 @@ type agentGRPC struct {
 const (
 	cpuRegexpPattern = "cpu[0-9]*"
 	memRegexpPattern = "memory[0-9]*"
-	libcontainerPath = "/run/libcontainer"
 )
 
 var (
+	libcontainerPath            = "/run/libcontainer"
 	sysfsCPUOnlinePath          = "/sys/devices/system/cpu"
 	sysfsMemOnlinePath          = "/sys/devices/system/memory"
 	sysfsMemoryBlockSizePath    = "/sys/devices/system/memory/block_size_bytes"
@@ -225,7 +226,7 @@ func updateCpusetPath(cgroupPath string, newCpuset string, cookies cookie) error
 
 func (a *agentGRPC) onlineCPUMem(req *pb.OnlineCPUMemRequest) error {
 	if req.NbCpus == 0 && req.CpuOnly {
-		return handleError(req.Wait, fmt.Errorf("requested number of CPUs '%d' must be greater than 0", req.NbCpus))
+		return handleError(req.Wait, grpcStatus.Errorf(codes.InvalidArgument, "requested number of CPUs '%d' must be greater than 0", req.NbCpus))
 	}
 
 	// we are going to update the containers of the sandbox, we have to lock it
@@ -297,6 +298,10 @@ func setConsoleCarriageReturn(fd int) error {
 }
 
 func buildProcess(agentProcess *pb.Process, procID string, init bool) (*process, error) {
+	if agentProcess.Path != "" && len(agentProcess.Args) == 0 {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "Args must hold argv[0] when Path is set")
+	}
+
 	user := agentProcess.User.Username
 	if user == "" {
 		// We can specify the user and the group separated by ":"
@@ -308,11 +313,28 @@ func buildProcess(agentProcess *pb.Process, procID string, init bool) (*process,
 		additionalGids = append(additionalGids, fmt.Sprintf("%d", gid))
 	}
 
+	var umask *uint32
+	if init {
+		u, err := initUmask(agentProcess)
+		if err != nil {
+			return nil, err
+		}
+
+		if u != defaultContainerUmask {
+			umask = &u
+		}
+	}
+
+	args := agentProcess.Args
+	if len(args) > 0 && (agentProcess.Path != "" || umask != nil) {
+		args = execProcessArgs(agentProcess.Path, agentProcess.Args, umask)
+	}
+
 	proc := &process{
 		id: procID,
 		process: libcontainer.Process{
 			Cwd:              agentProcess.Cwd,
-			Args:             agentProcess.Args,
+			Args:             args,
 			Env:              agentProcess.Env,
 			User:             user,
 			AdditionalGroups: additionalGids,
@@ -321,6 +343,17 @@ func buildProcess(agentProcess *pb.Process, procID string, init bool) (*process,
 	}
 
 	if agentProcess.Terminal {
+		// The console is resized by libcontainer as soon as it is
+		// allocated, before the process is executed.
+		if size := agentProcess.ConsoleSize; size != nil {
+			if size.Height > math.MaxUint16 || size.Width > math.MaxUint16 {
+				return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid console size %dx%d", size.Width, size.Height)
+			}
+
+			proc.process.ConsoleHeight = uint16(size.Height)
+			proc.process.ConsoleWidth = uint16(size.Width)
+		}
+
 		parentSock, childSock, err := utils.NewSockPair("console")
 		if err != nil {
 			return nil, err
@@ -419,8 +452,14 @@ func (a *agentGRPC) execProcess(ctr *container, proc *process, createContainer b
 		return grpcStatus.Errorf(codes.Internal, "Could not run process: %v", err)
 	}
 
+	return a.setProcessExitCodeCh(ctr, proc)
+}
+
+// setProcessExitCodeCh registers the exit code channel of a process of ctr
+// which has just been started, with the reaper lock held.
+func (a *agentGRPC) setProcessExitCodeCh(ctr *container, proc *process) error {
 	// Get process PID
-	pid, err := proc.process.Pid()
+	pid, err := proc.pid()
 	if err != nil {
 		return err
 	}
@@ -430,7 +469,11 @@ func (a *agentGRPC) execProcess(ctr *container, proc *process, createContainer b
 	// Create process channel to allow WaitProcess to wait on it.
 	// This channel is buffered so that reaper.reap() will not
 	// block until WaitProcess listen onto this channel.
-	a.sandbox.subreaper.setExitCodeCh(pid, proc.exitCodeCh)
+	exitCodeCh := chan<- int(proc.exitCodeCh)
+	if proc == ctr.initProcess {
+		exitCodeCh = a.sandbox.forwardInitExit(ctr, proc)
+	}
+	a.sandbox.subreaper.setExitCodeCh(pid, exitCodeCh)
 
 	return nil
 }
@@ -448,6 +491,34 @@ func (a *agentGRPC) postExecProcess(ctr *container, proc *process) error {
 
 	defer proc.closePostStartFDs()
 
+	// The init process is placed into the resctrl group of the container
+	// once created, see setupIntelRdt().
+	if ctr.intelRdtPath != "" && proc != ctr.initProcess {
+		pid, err := proc.pid()
+		if err != nil {
+			return err
+		}
+
+		if err := addIntelRdtTask(ctr.intelRdtPath, pid); err != nil {
+			return err
+		}
+	}
+
+	// Keep the output of the process in its log files.
+	if containerLogDir != "" && proc.consoleSock == nil {
+		stdout, err := teeContainerLog(ctr.id, proc.id, containerLogStdout, proc.stdout)
+		if err != nil {
+			return err
+		}
+		proc.stdout = stdout
+
+		stderr, err := teeContainerLog(ctr.id, proc.id, containerLogStderr, proc.stderr)
+		if err != nil {
+			return err
+		}
+		proc.stderr = stderr
+	}
+
 	// Setup terminal if enabled.
 	if proc.consoleSock != nil {
 		termMaster, err := utils.RecvFd(proc.consoleSock)
@@ -462,7 +533,7 @@ func (a *agentGRPC) postExecProcess(ctr *container, proc *process) error {
 		proc.termMaster = termMaster
 
 		// Get process PID
-		pid, err := proc.process.Pid()
+		pid, err := proc.pid()
 		if err != nil {
 			return err
 		}
@@ -485,23 +556,30 @@ func (a *agentGRPC) postExecProcess(ctr *container, proc *process) error {
 // several containers.
 // If the sandbox has not been setup to share namespaces, then we assume all
 // containers will be started in their own new namespace.
-// The value of a.sandbox.sharedPidNs.path will always override the namespace
-// path set by the spec, since we will always ignore it. Indeed, it makes no
-// sense to rely on the namespace path provided by the host since namespaces
-// are different inside the guest.
+// The runtime clears the namespace paths of the host, so a path set by the
+// spec is a namespace of the guest the container joins, checked by
+// checkNamespacePaths(), and is kept over the shared namespaces.
 func (a *agentGRPC) updateContainerConfigNamespaces(config *configs.Config, ctr *container) {
-	var ipcNs, utsNs bool
+	var ipcNs, utsNs, pidNsJoined bool
 
 	for idx, ns := range config.Namespaces {
 		if ns.Type == configs.NEWIPC {
-			config.Namespaces[idx].Path = a.sandbox.sharedIPCNs.path
+			if ns.Path == "" {
+				config.Namespaces[idx].Path = a.sandbox.sharedIPCNs.path
+			}
 			ipcNs = true
 		}
 
 		if ns.Type == configs.NEWUTS {
-			config.Namespaces[idx].Path = a.sandbox.sharedUTSNs.path
+			if ns.Path == "" {
+				config.Namespaces[idx].Path = a.sandbox.sharedUTSNs.path
+			}
 			utsNs = true
 		}
+
+		if ns.Type == configs.NEWPID && ns.Path != "" {
+			pidNsJoined = true
+		}
 	}
 
 	if !ipcNs {
@@ -520,6 +598,11 @@ func (a *agentGRPC) updateContainerConfigNamespaces(config *configs.Config, ctr
 		config.Namespaces = append(config.Namespaces, newUTSNs)
 	}
 
+	// The container joins the PID namespace given by the spec.
+	if pidNsJoined {
+		return
+	}
+
 	// If container needs to be in the agent PID namespace, do not create a new
 	// PID namespace.
 	if ctr.agentPidNs {
@@ -562,6 +645,11 @@ func (a *agentGRPC) updateContainerConfigPrivileges(spec *specs.Spec, config *co
 
 func (a *agentGRPC) updateContainerConfig(spec *specs.Spec, config *configs.Config, ctr *container) error {
 	a.updateContainerConfigNamespaces(config, ctr)
+
+	if err := setupCgroupMounts(config); err != nil {
+		return err
+	}
+
 	return a.updateContainerConfigPrivileges(spec, config)
 }
 
@@ -570,16 +658,54 @@ func (a *agentGRPC) updateContainerConfig(spec *specs.Spec, config *configs.Conf
 // - Destroy the container created by libcontainer
 // - Delete the container from the agent internal map
 // - Unmount all mounts related to this container
+// - Release the sandbox storages of a container not tracked yet
+// - Remove the OCI spec file and the resolv.conf copy of this container
 func (a *agentGRPC) rollbackFailingContainerCreation(ctr *container) {
 	if ctr.container != nil {
 		ctr.container.Destroy()
 	}
 
+	// The sandbox storages of a tracked container are released by
+	// deleteContainer().
+	if _, err := a.sandbox.getContainer(ctr.id); err != nil {
+		a.sandbox.Lock()
+		for _, path := range ctr.storages {
+			if err := a.sandbox.unsetAndRemoveSandboxStorage(path); err != nil {
+				agentLog.WithError(err).Error("rollback failed unsetAndRemoveSandboxStorage()")
+			}
+		}
+		a.sandbox.Unlock()
+	}
+
 	a.sandbox.deleteContainer(ctr.id)
 
+	if err := removeIntelRdtGroup(ctr.intelRdtGroup); err != nil {
+		agentLog.WithError(err).Error("rollback failed removeIntelRdtGroup()")
+	}
+
 	if err := removeMounts(ctr.mounts); err != nil {
 		agentLog.WithError(err).Error("rollback failed removeMounts()")
 	}
+
+	if err := removeContainerResolvConf(ctr.id); err != nil {
+		agentLog.WithError(err).Error("rollback failed removeContainerResolvConf()")
+	}
+
+	if err := removeContainerLogs(ctr.id); err != nil {
+		agentLog.WithError(err).Error("rollback failed removeContainerLogs()")
+	}
+
+	// The directory of the spec file is the libcontainer state directory of
+	// the sandbox when the container is named after it.
+	if err := os.Remove(filepath.Join(ociConfigBasePath, ctr.id, ociConfigFile)); err != nil && !os.IsNotExist(err) {
+		agentLog.WithError(err).Error("rollback failed to remove the OCI spec file")
+	}
+
+	if ctr.rootfsOverlay != "" {
+		if err := os.RemoveAll(ctr.rootfsOverlay); err != nil {
+			agentLog.WithError(err).Error("rollback failed to remove the overlay rootfs changes")
+		}
+	}
 }
 
 func (a *agentGRPC) finishCreateContainer(ctr *container, req *pb.CreateContainerRequest, config *configs.Config) (resp *gpb.Empty, err error) {
@@ -589,6 +715,17 @@ func (a *agentGRPC) finishCreateContainer(ctr *container, req *pb.CreateContaine
 		return emptyResp, err
 	}
 
+	// Intel RDT is handled by the agent, see setupIntelRdt().
+	config.IntelRdt = nil
+
+	// Keep track of the init process in the container state, see
+	// recoverContainers().
+	config.Labels = append(config.Labels, execIDLabel+"="+req.ExecId)
+
+	if err := writeEtcHostname(config); err != nil {
+		agentLog.WithError(err).WithField("container", req.ContainerId).Warn("Could not write /etc/hostname")
+	}
+
 	ctr.container, err = factory.Create(req.ContainerId, config)
 	if err != nil {
 		return emptyResp, err
@@ -600,24 +737,143 @@ func (a *agentGRPC) finishCreateContainer(ctr *container, req *pb.CreateContaine
 		return emptyResp, err
 	}
 
-	if err = a.execProcess(ctr, ctr.initProcess, true); err != nil {
+	if err = ctr.addUserGroups(ctr.initProcess, req.OCI.Process.User); err != nil {
+		return emptyResp, err
+	}
+
+	err = startInTimeNamespace(ctr, req.OCI, func() error {
+		return startWithPersonality(req.OCI, func() error {
+			if req.Restore != nil {
+				return a.restoreProcess(ctr, ctr.initProcess, req.Restore)
+			}
+			return a.execProcess(ctr, ctr.initProcess, true)
+		})
+	})
+	if err != nil {
+		return emptyResp, err
+	}
+
+	pid, err := ctr.initProcess.pid()
+	if err != nil {
+		return emptyResp, err
+	}
+
+	// The init process waits for StartContainer in the container root,
+	// volumes included, before executing its command.
+	if req.Restore == nil {
+		if err = checkExecProcessCommand(ctr, &ctr.initProcess.process); err != nil {
+			return emptyResp, err
+		}
+	}
+
+	if err = setupContainerDomainname(pid, req.OCI.Domainname); err != nil {
 		return emptyResp, err
 	}
 
+	if req.OCI.Linux != nil {
+		var owned bool
+		if ctr.intelRdtPath, owned, err = setupIntelRdt(ctr.id, pid, req.OCI.Linux.IntelRdt); err != nil {
+			return emptyResp, err
+		}
+		if owned {
+			ctr.intelRdtGroup = ctr.intelRdtPath
+		}
+	}
+
+	if req.OCI.Linux != nil && req.OCI.Linux.Resources != nil {
+		if err = setUnifiedResources(ctr, req.OCI.Linux.Resources.Unified); err != nil {
+			return emptyResp, err
+		}
+
+		if err = setNetworkResources(ctr, req.OCI.Linux.Resources.Network); err != nil {
+			return emptyResp, err
+		}
+	}
+
 	// Make sure add Container to Sandbox, before call updateSharedPidNs
 	a.sandbox.setContainer(ctr.ctx, req.ContainerId, ctr)
 	if err := a.updateSharedPidNs(ctr); err != nil {
 		return emptyResp, err
 	}
 
-	return emptyResp, a.postExecProcess(ctr, ctr.initProcess)
+	if err = a.postExecProcess(ctr, ctr.initProcess); err != nil {
+		return emptyResp, err
+	}
+
+	// A restored container is already running and will not go
+	// through StartContainer.
+	if req.Restore != nil {
+		oomCh, err := ctr.container.NotifyOOM()
+		if err != nil {
+			return emptyResp, err
+		}
+		a.sandbox.runOOMEventMonitor(oomCh, req.ContainerId)
+	}
+
+	a.sandbox.events.publish(ctr.id, eventCreated, 0)
+	if req.Restore != nil {
+		a.sandbox.events.publish(ctr.id, eventStarted, 0)
+		ctr.setReady()
+	}
+
+	return emptyResp, nil
+}
+
+// checkCreateDeadline returns a DeadlineExceeded error once ctx, bounding
+// the creation of the container cid, expired.
+func checkCreateDeadline(ctx context.Context, cid string) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return grpcStatus.Errorf(codes.DeadlineExceeded, "Container %s not created within %s", cid, createTimeout)
+	}
+
+	return nil
 }
 
+// Time given to the processes of a replaced container to exit before being
+// killed.
+var replaceStopTimeout = 10 * time.Second
+
 func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainerRequest) (resp *gpb.Empty, err error) {
 	if err := a.createContainerChecks(req); err != nil {
 		return emptyResp, err
 	}
 
+	// Bound the creation to createTimeout, the container being rolled back
+	// once it expired.
+	createCtx := ctx
+	var createDeadline time.Time
+	if createTimeout > 0 {
+		var cancel context.CancelFunc
+		createDeadline = time.Now().Add(createTimeout)
+		createCtx, cancel = context.WithDeadline(ctx, createDeadline)
+		defer cancel()
+
+		defer func() {
+			if err != nil && createCtx.Err() == context.DeadlineExceeded && grpcStatus.Code(err) != codes.DeadlineExceeded {
+				err = grpcStatus.Errorf(codes.DeadlineExceeded, "Container %s not created within %s: %v", req.ContainerId, createTimeout, err)
+			}
+		}()
+	}
+
+	// Reserve the ID, so that a concurrent creation does not overwrite the
+	// files of the container, nor remove it while being rolled back.
+	release, err := a.sandbox.reserveContainerID(req.ContainerId, req.ReplaceExisting)
+	if err != nil {
+		return emptyResp, err
+	}
+	defer release()
+
+	if req.ReplaceExisting {
+		stopDeadline := time.Now().Add(replaceStopTimeout)
+		if !createDeadline.IsZero() && createDeadline.Before(stopDeadline) {
+			stopDeadline = createDeadline
+		}
+
+		if err = a.sandbox.replaceContainer(req.ContainerId, stopDeadline); err != nil {
+			return emptyResp, err
+		}
+	}
+
 	// re-scan PCI bus
 	// looking for hidden devices
 	if err = rescanPciBus(); err != nil {
@@ -629,7 +885,7 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 	// updates the devices listed in the OCI spec, so that they actually
 	// match real devices inside the VM. This step is necessary since we
 	// cannot predict everything from the caller.
-	if err = addDevices(ctx, req.Devices, req.OCI, a.sandbox); err != nil {
+	if err = addDevices(createCtx, req.Devices, req.OCI, a.sandbox); err != nil {
 		return emptyResp, err
 	}
 
@@ -640,19 +896,35 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 	// After all those storages have been processed, no matter the order
 	// here, the agent will rely on libcontainer (using the oci.Mounts
 	// list) to bind mount all of them inside the container.
-	mountList, err := addStorages(ctx, req.Storages, a.sandbox)
+	mountList, err := addStorages(createCtx, req.Storages, a.sandbox)
 	if err != nil {
 		return emptyResp, err
 	}
 
 	ctr := &container{
-		id:              req.ContainerId,
-		processes:       make(map[string]*process),
-		mounts:          mountList,
-		useSandboxPidNs: req.SandboxPidns,
-		agentPidNs:      req.AgentPidns,
-		ctx:             ctx,
+		id:                req.ContainerId,
+		processes:         make(map[string]*process),
+		mounts:            mountList,
+		useSandboxPidNs:   req.SandboxPidns,
+		agentPidNs:        req.AgentPidns,
+		ctx:               ctx,
+		startAfter:        req.StartAfter,
+		startAfterTimeout: time.Duration(req.StartAfterTimeout) * time.Second,
+		rootfsOverlay:     rootfsOverlayPath(req),
+	}
+
+	// Keep track of the sandbox storages the container uses, which are not
+	// part of its mounts, to release them once it is removed.
+	a.sandbox.RLock()
+	for _, storage := range req.Storages {
+		if storage == nil {
+			continue
+		}
+		if _, ok := a.sandbox.storages[storage.MountPoint]; ok {
+			ctr.storages = append(ctr.storages, storage.MountPoint)
+		}
 	}
+	a.sandbox.RUnlock()
 
 	// In case the container creation failed, make sure we cleanup
 	// properly by rolling back the actions previously performed.
@@ -662,15 +934,44 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 		}
 	}()
 
+	// The rootfs is set up once the storages providing its source are
+	// mounted, and unmounted before them.
+	rootfsMounts, err := setupRootfsMount(req)
+	if err != nil {
+		return emptyResp, err
+	}
+	ctr.mounts = append(rootfsMounts, ctr.mounts...)
+
+	if err = checkCreateDeadline(createCtx, req.ContainerId); err != nil {
+		return emptyResp, err
+	}
+
 	// Add the nvdimm root partition to the device cgroup to prevent access
 	updateDeviceCgroupForGuestRootfs(req.OCI)
 
+	// libcontainer has no support for time namespaces, see startInTimeNamespace().
+	if ctr.timeNs, err = extractTimeNamespace(req.OCI); err != nil {
+		return emptyResp, err
+	}
+
+	// Apply the resource hints of the annotations to the unset fields.
+	if err = applyAnnotationHints(req.OCI); err != nil {
+		return emptyResp, err
+	}
+
+	// Pass the proxy configuration of the sandbox to the container.
+	a.sandbox.setupProxyEnv(req.OCI.Process)
+
 	// Convert the spec to an actual OCI specification structure.
 	ociSpec, err := pb.GRPCtoOCI(req.OCI)
 	if err != nil {
 		return emptyResp, err
 	}
 
+	if err := checkContainerSpec(req, ociSpec); err != nil {
+		return emptyResp, err
+	}
+
 	if err := a.handleCPUSet(ociSpec); err != nil {
 		return emptyResp, err
 	}
@@ -679,10 +980,26 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 		return emptyResp, err
 	}
 
+	if err := setupContainerResolvConf(ociSpec, req.ContainerId); err != nil {
+		return emptyResp, err
+	}
+
+	if err := setupContainerShm(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
+	if err := setupRootfsPropagation(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
 	if a.sandbox.guestHooksPresent {
 		// Add any custom OCI hooks to the spec
 		a.sandbox.addGuestHooks(ociSpec)
+	}
 
+	setupHooksEnv(ociSpec, a.sandbox.id, req.ContainerId)
+
+	if a.sandbox.guestHooksPresent {
 		// write the OCI spec to a file so that hooks can read it
 		err = writeSpecToFile(ociSpec, req.ContainerId)
 		if err != nil {
@@ -712,15 +1029,29 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 	// apply rlimits
 	config.Rlimits = posixRlimitsToRlimits(ociSpec.Process.Rlimits)
 
+	if err = setupAmbientCapabilities(config.Capabilities); err != nil {
+		return emptyResp, err
+	}
+
+	setupHooksLimits(config, createDeadline)
+
 	// Update libcontainer configuration for specific cases not handled
 	// by the specconv converter.
 	if err = a.updateContainerConfig(ociSpec, config, ctr); err != nil {
 		return emptyResp, err
 	}
 
+	if err = checkCreateDeadline(createCtx, req.ContainerId); err != nil {
+		return emptyResp, err
+	}
+
 	return a.finishCreateContainer(ctr, req, config)
 }
 
+func (a *agentGRPC) CreateContainerDryRun(ctx context.Context, req *pb.CreateContainerRequest) (*pb.CreateContainerDryRunResponse, error) {
+	return a.createContainerDryRun(req)
+}
+
 // Path overridden in unit tests
 var procSysDir = "/proc/sys"
 
@@ -735,27 +1066,123 @@ func isNetworkSysctl(sysctl string) bool {
 	return strings.HasPrefix(sysctl, "net.")
 }
 
+// Network sysctls which are not namespaced: their value is shared by all the
+// network namespaces, or can only be written from the initial one.
+var hostNetworkSysctls = []string{
+	"net.core.",
+	"net.ipv4.tcp_mem",
+	"net.ipv4.udp_mem",
+	"net.netfilter.nf_conntrack_max",
+	"net.nf_conntrack_max",
+}
+
+// Sysctls matching hostNetworkSysctls which are namespaced nonetheless.
+var namespacedNetworkSysctls = []string{
+	"net.core.somaxconn",
+	"net.core.xfrm_",
+}
+
+// isHostNetworkSysctl returns true if the network sysctl cannot be set for a
+// single network namespace.
+func isHostNetworkSysctl(sysctl string) bool {
+	for _, prefix := range namespacedNetworkSysctls {
+		if strings.HasPrefix(sysctl, prefix) {
+			return false
+		}
+	}
+
+	for _, prefix := range hostNetworkSysctls {
+		if strings.HasPrefix(sysctl, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containerNetNamespace returns whether the container described by ociSpec
+// has its own network namespace, and the path of this namespace if it is not
+// created along with the container.
+func containerNetNamespace(ociSpec *specs.Spec) (string, bool) {
+	for _, ns := range ociSpec.Linux.Namespaces {
+		if ns.Type == specs.NetworkNamespace {
+			return ns.Path, true
+		}
+	}
+
+	return "", false
+}
+
 // libcontainer checks if the container is running in a separate network namespace
 // before applying the network related sysctls. If it sees that the network namespace of the container
 // is the same as the "host", it errors out. Since we do no create a new net namespace inside the guest,
 // libcontainer would error out while verifying network sysctls. To overcome this, we dont pass
 // network sysctls to libcontainer, we instead have the agent directly apply them. All other namespaced
 // sysctls are applied by libcontainer.
+//
+// When the container joins a network namespace of its own, the network sysctls are
+// written from this namespace, the agent one being left untouched. When its network
+// namespace is created along with the container, they are left to libcontainer.
+// In both cases, the sysctls which are not namespaced are rejected by
+// checkNetworkSysctls().
 func (a *agentGRPC) applyNetworkSysctls(ociSpec *specs.Spec) error {
 	sysctls := ociSpec.Linux.Sysctl
+	nsPath, dedicated := containerNetNamespace(ociSpec)
+
+	netSysctls := make(map[string]string)
 	for key, value := range sysctls {
-		if isNetworkSysctl(key) {
+		if !isNetworkSysctl(key) {
+			continue
+		}
+
+		if !dedicated || nsPath != "" {
+			netSysctls[key] = value
+		}
+	}
+
+	writeSysctls := func() error {
+		for key, value := range netSysctls {
 			if err := writeSystemProperty(key, value); err != nil {
 				return err
 			}
-			delete(sysctls, key)
 		}
+		return nil
+	}
+
+	var err error
+	if nsPath != "" {
+		err = runInNamespace(nsPath, nsTypeNet, writeSysctls)
+	} else {
+		err = writeSysctls()
+	}
+	if err != nil {
+		return err
+	}
+
+	for key := range netSysctls {
+		delete(sysctls, key)
 	}
 
 	ociSpec.Linux.Sysctl = sysctls
 	return nil
 }
 
+// checkNetworkSysctls rejects the network sysctls of ociSpec which are not
+// namespaced when the container has its own network namespace.
+func checkNetworkSysctls(ociSpec *specs.Spec) error {
+	if _, dedicated := containerNetNamespace(ociSpec); !dedicated {
+		return nil
+	}
+
+	for key := range ociSpec.Linux.Sysctl {
+		if isNetworkSysctl(key) && isHostNetworkSysctl(key) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "sysctl %s cannot be set in the network namespace of the container", key)
+		}
+	}
+
+	return nil
+}
+
 func (a *agentGRPC) handleCPUSet(ociSpec *specs.Spec) error {
 	if ociSpec.Linux.Resources.CPU != nil && ociSpec.Linux.Resources.CPU.Cpus != "" {
 		availableCpuset, err := getAvailableCpusetList(ociSpec.Linux.Resources.CPU.Cpus)
@@ -813,7 +1240,7 @@ func (a *agentGRPC) createContainerChecks(req *pb.CreateContainerRequest) (err e
 		return grpcStatus.Error(codes.FailedPrecondition, "Sandbox not started, impossible to run a new container")
 	}
 
-	if _, err = a.sandbox.getContainer(req.ContainerId); err == nil {
+	if _, err = a.sandbox.getContainer(req.ContainerId); err == nil && !req.ReplaceExisting {
 		return grpcStatus.Errorf(codes.AlreadyExists, "Container %s already exists, impossible to create", req.ContainerId)
 	}
 
@@ -821,13 +1248,70 @@ func (a *agentGRPC) createContainerChecks(req *pb.CreateContainerRequest) (err e
 		return grpcStatus.Errorf(codes.FailedPrecondition, "Unexpected PID namespace received for container %s, should have been cleared out", req.ContainerId)
 	}
 
+	if err = checkNamespacePaths(req.OCI); err != nil {
+		return err
+	}
+
+	if err = a.sandbox.checkStartAfter(req.ContainerId, req.StartAfter); err != nil {
+		return err
+	}
+
+	if _, _, err = personalityArg(req.OCI); err != nil {
+		return err
+	}
+
+	if _, err = containerUmask(req.OCI); err != nil {
+		return err
+	}
+
+	if err = checkRootfsMount(req); err != nil {
+		return err
+	}
+
+	// Fail before setting anything up if the state of the container, or
+	// the spec for the hooks, cannot be written.
+	if libcontainerPathErr != nil {
+		return libcontainerPathErr
+	}
+
+	if a.sandbox.guestHooksPresent && ociConfigBaseErr != nil {
+		return ociConfigBaseErr
+	}
+
 	return nil
 }
 
+// checkContainerSpec checks the parts of the spec of a CreateContainer
+// request which are validated while it is set up, so that the dry run rejects
+// them as well, before anything is set up from it.
+func checkContainerSpec(req *pb.CreateContainerRequest, ociSpec *specs.Spec) error {
+	if req.OCI.Linux != nil && req.OCI.Linux.Resources != nil {
+		if err := checkUnifiedResources(req.OCI.Linux.Resources.Unified); err != nil {
+			return err
+		}
+
+		if err := checkNetworkResources(req.OCI.Linux.Resources.Network); err != nil {
+			return err
+		}
+	}
+
+	if err := checkNetworkSysctls(ociSpec); err != nil {
+		return err
+	}
+
+	if err := checkContainerShm(ociSpec); err != nil {
+		return err
+	}
+
+	return checkMountDestinations(ociSpec)
+}
+
+// pidNsExists returns whether grpcSpec asks for a new PID namespace. The PID
+// namespaces joined by path, checked by checkNamespacePaths(), are allowed.
 func (a *agentGRPC) pidNsExists(grpcSpec *pb.Spec) bool {
 	if grpcSpec.Linux != nil {
 		for _, n := range grpcSpec.Linux.Namespaces {
-			if n.Type == string(configs.NEWPID) {
+			if n.Type == string(configs.NEWPID) && n.Path == "" {
 				return true
 			}
 		}
@@ -842,7 +1326,7 @@ func (a *agentGRPC) updateSharedPidNs(ctr *container) error {
 	// first container created as the infra container in that case
 	// and use its pid namespace in case pid namespace needs to be shared.
 	if !a.sandbox.sandboxPidNs && len(a.sandbox.containers) == 1 {
-		pid, err := ctr.initProcess.process.Pid()
+		pid, err := ctr.initProcess.pid()
 		if err != nil {
 			return err
 		}
@@ -868,10 +1352,20 @@ func (a *agentGRPC) StartContainer(ctx context.Context, req *pb.StartContainerRe
 		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Container %s status %s, should be %s", req.ContainerId, status.String(), libcontainer.Created.String())
 	}
 
+	if err := checkReadinessProbe(req.ReadinessProbe); err != nil {
+		return emptyResp, err
+	}
+
+	if err := a.sandbox.waitForStartAfter(ctx, ctr); err != nil {
+		return emptyResp, err
+	}
+
 	if err := ctr.container.Exec(); err != nil {
 		return emptyResp, err
 	}
 
+	a.sandbox.events.publish(ctr.id, eventStarted, 0)
+
 	// Add the container to the OOM event monitor
 	oomCh, err := ctr.container.NotifyOOM()
 	if err != nil {
@@ -879,6 +1373,14 @@ func (a *agentGRPC) StartContainer(ctx context.Context, req *pb.StartContainerRe
 	}
 	a.sandbox.runOOMEventMonitor(oomCh, req.ContainerId)
 
+	// The container keeps running if the probe fails, it is up to the
+	// caller to decide what to do with it.
+	if err := waitForReadiness(ctx, ctr, req.ReadinessProbe); err != nil {
+		return emptyResp, err
+	}
+
+	ctr.setReady()
+
 	return emptyResp, nil
 }
 
@@ -897,12 +1399,32 @@ func (a *agentGRPC) ExecProcess(ctx context.Context, req *pb.ExecProcessRequest)
 		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Cannot exec in stopped container %s", req.ContainerId)
 	}
 
+	// The time namespace of the init process cannot be joined by the
+	// multithreaded agent, the process would run on the guest clocks.
+	if ctr.timeNs {
+		return emptyResp, grpcStatus.Errorf(codes.FailedPrecondition, "Cannot exec in container %s with a time namespace", req.ContainerId)
+	}
+
 	proc, err := buildProcess(req.Process, req.ExecId, false)
 	if err != nil {
 		return emptyResp, err
 	}
 
+	if err := ctr.addUserGroups(proc, req.Process.User); err != nil {
+		return emptyResp, err
+	}
+
+	if err := checkExecProcessCommand(ctr, &proc.process); err != nil {
+		return emptyResp, err
+	}
+
+	proc.process.ExtraFiles, err = openExtraFiles(ctr, req.ExtraFiles)
+	if err != nil {
+		return emptyResp, err
+	}
+
 	if err := a.execProcess(ctr, proc, false); err != nil {
+		closeFiles(proc.process.ExtraFiles)
 		return emptyResp, err
 	}
 
@@ -924,7 +1446,10 @@ func (a *agentGRPC) SignalProcess(ctx context.Context, req *pb.SignalProcessRequ
 		return emptyResp, err
 	}
 
-	signal := syscall.Signal(req.Signal)
+	signal, err := resolveSignal(req.Signal, req.SignalName)
+	if err != nil {
+		return emptyResp, err
+	}
 
 	if status == libcontainer.Stopped {
 		agentLog.WithFields(logrus.Fields{
@@ -942,7 +1467,7 @@ func (a *agentGRPC) SignalProcess(ctx context.Context, req *pb.SignalProcessRequ
 	if req.ExecId == "" || status == libcontainer.Paused {
 		return emptyResp, ctr.container.Signal(signal, true)
 	} else if ctr.initProcess.id == req.ExecId {
-		pid, err := ctr.initProcess.process.Pid()
+		pid, err := ctr.initProcess.pid()
 		if err != nil {
 			return emptyResp, err
 		}
@@ -1060,7 +1585,7 @@ func (a *agentGRPC) ListProcesses(ctx context.Context, req *pb.ListProcessesRequ
 		resp.ProcessList, err = json.Marshal(pids)
 		return resp, err
 	default:
-		return resp, fmt.Errorf("invalid format option")
+		return resp, grpcStatus.Errorf(codes.InvalidArgument, "invalid format option %q", req.Format)
 	}
 
 	psArgs := req.Args
@@ -1121,7 +1646,7 @@ func (a *agentGRPC) ListProcesses(ctx context.Context, req *pb.ListProcessesRequ
 
 func (a *agentGRPC) UpdateContainer(ctx context.Context, req *pb.UpdateContainerRequest) (*gpb.Empty, error) {
 	if req.Resources == nil {
-		return emptyResp, fmt.Errorf("Resources in the request are nil")
+		return emptyResp, grpcStatus.Error(codes.InvalidArgument, "Resources in the request are nil")
 	}
 
 	c, err := a.sandbox.getContainer(req.ContainerId)
@@ -1198,7 +1723,19 @@ func (a *agentGRPC) UpdateContainer(ctx context.Context, req *pb.UpdateContainer
 	}
 	cgroupsCopy.Resources = &resources
 	config.Cgroups = &cgroupsCopy
-	return emptyResp, c.container.Set(config)
+	if err = c.container.Set(config); err != nil {
+		return emptyResp, err
+	}
+
+	if err = setUnifiedResources(c, req.Resources.Unified); err != nil {
+		return emptyResp, err
+	}
+
+	if err = setNetworkResources(c, req.Resources.Network); err != nil {
+		return emptyResp, err
+	}
+
+	return emptyResp, nil
 }
 
 func (a *agentGRPC) StatsContainer(ctx context.Context, req *pb.StatsContainerRequest) (*pb.StatsContainerResponse, error) {
@@ -1212,7 +1749,7 @@ func (a *agentGRPC) StatsContainer(ctx context.Context, req *pb.StatsContainerRe
 		return nil, err
 	}
 
-	cgroupData, err := json.Marshal(stats.CgroupStats)
+	cgroupStats, err := convertCgroupStats(stats)
 	if err != nil {
 		return nil, err
 	}
@@ -1222,19 +1759,14 @@ func (a *agentGRPC) StatsContainer(ctx context.Context, req *pb.StatsContainerRe
 		return nil, err
 	}
 
-	var cgroupStats pb.CgroupStats
 	networkStats := make([]*pb.NetworkStats, 0)
 
-	err = json.Unmarshal(cgroupData, &cgroupStats)
-	if err != nil {
-		return nil, err
-	}
 	err = json.Unmarshal(netData, &networkStats)
 	if err != nil {
 		return nil, err
 	}
 	resp := &pb.StatsContainerResponse{
-		CgroupStats:  &cgroupStats,
+		CgroupStats:  cgroupStats,
 		NetworkStats: networkStats,
 	}
 
@@ -1269,65 +1801,27 @@ func (a *agentGRPC) ResumeContainer(ctx context.Context, req *pb.ResumeContainer
 func (a *agentGRPC) RemoveContainer(ctx context.Context, req *pb.RemoveContainerRequest) (*gpb.Empty, error) {
 	ctr, err := a.sandbox.getContainer(req.ContainerId)
 	if err != nil {
-		return emptyResp, err
+		// Already removed.
+		return emptyResp, nil
 	}
 
 	timeout := int(req.Timeout)
 
-	a.sandbox.Lock()
-	defer a.sandbox.Unlock()
-
 	if timeout == 0 {
-		if err := ctr.removeContainer(); err != nil {
-			return emptyResp, err
-		}
-
-		// Find the sandbox storage used by this container
-		for _, path := range ctr.mounts {
-			if _, ok := a.sandbox.storages[path]; ok {
-				if err := a.sandbox.unsetAndRemoveSandboxStorage(path); err != nil {
-					return emptyResp, err
-				}
-			}
-		}
-	} else {
-		done := make(chan error)
-		go func() {
-			if err := ctr.removeContainer(); err != nil {
-				done <- err
-				close(done)
-				return
-			}
-
-			//Find the sandbox storage used by this container
-			for _, path := range ctr.mounts {
-				if _, ok := a.sandbox.storages[path]; ok {
-					if err := a.sandbox.unsetAndRemoveSandboxStorage(path); err != nil {
-						done <- err
-						close(done)
-						return
-					}
-				}
-			}
-			close(done)
-		}()
-
-		select {
-		case err := <-done:
-			if err != nil {
-				return emptyResp, err
-			}
-		case <-time.After(time.Duration(req.Timeout) * time.Second):
-			return emptyResp, grpcStatus.Errorf(codes.DeadlineExceeded, "Timeout reached after %ds", timeout)
-		}
+		return emptyResp, a.sandbox.removeContainer(ctr)
 	}
 
-	configJsonDir := filepath.Join("/run/libcontainer/", req.ContainerId)
-	//Best effort.. Ignore any errors in the deletion of the directory
-	_ = os.RemoveAll(configJsonDir)
-	delete(a.sandbox.containers, ctr.id)
+	done := make(chan error, 1)
+	go func() {
+		done <- a.sandbox.removeContainer(ctr)
+	}()
 
-	return emptyResp, nil
+	select {
+	case err := <-done:
+		return emptyResp, err
+	case <-time.After(time.Duration(req.Timeout) * time.Second):
+		return emptyResp, grpcStatus.Errorf(codes.DeadlineExceeded, "Timeout reached after %ds", timeout)
+	}
 }
 
 func (a *agentGRPC) WriteStdin(ctx context.Context, req *pb.WriteStreamRequest) (*pb.WriteStreamResponse, error) {
@@ -1434,11 +1928,11 @@ func (a *agentGRPC) TtyWinResize(ctx context.Context, req *pb.TtyWinResizeReques
 
 func loadKernelModule(module *pb.KernelModule) error {
 	if module == nil {
-		return fmt.Errorf("Kernel module is nil")
+		return grpcStatus.Error(codes.InvalidArgument, "Kernel module is nil")
 	}
 
 	if module.Name == "" {
-		return fmt.Errorf("Kernel module name is empty")
+		return grpcStatus.Error(codes.InvalidArgument, "Kernel module name is empty")
 	}
 
 	log := agentLog.WithFields(logrus.Fields{
@@ -1462,7 +1956,11 @@ func loadKernelModule(module *pb.KernelModule) error {
 }
 
 func (a *agentGRPC) CreateSandbox(ctx context.Context, req *pb.CreateSandboxRequest) (*gpb.Empty, error) {
-	if a.sandbox.running {
+	// Wait for a teardown not completed in time, see GracefulShutdown().
+	a.sandbox.teardownLock.Lock()
+	defer a.sandbox.teardownLock.Unlock()
+
+	if a.sandbox.isRunning() {
 		return emptyResp, grpcStatus.Error(codes.AlreadyExists, "Sandbox already started, impossible to start again")
 	}
 
@@ -1517,29 +2015,32 @@ func (a *agentGRPC) CreateSandbox(ctx context.Context, req *pb.CreateSandboxRequ
 }
 
 func (a *agentGRPC) DestroySandbox(ctx context.Context, req *pb.DestroySandboxRequest) (*gpb.Empty, error) {
-	if !a.sandbox.running {
+	// Not torn down along with GracefulShutdown() or the idle watcher.
+	a.sandbox.teardownLock.Lock()
+	defer a.sandbox.teardownLock.Unlock()
+
+	if !a.sandbox.isRunning() {
 		agentLog.Info("Sandbox not started, this is a no-op")
 		return emptyResp, nil
 	}
 
-	a.sandbox.Lock()
+	a.sandbox.RLock()
+	ctrs := make([]*container, 0, len(a.sandbox.containers))
+	for _, c := range a.sandbox.containers {
+		ctrs = append(ctrs, c)
+	}
+	a.sandbox.RUnlock()
 
-	for key, c := range a.sandbox.containers {
-		if err := c.removeContainer(); err != nil {
-			return emptyResp, err
+	var errs []error
+	for _, c := range ctrs {
+		if err := a.sandbox.removeContainer(c); err != nil {
+			errs = append(errs, err)
 		}
+	}
 
-		// Find the sandbox storage used by this container
-		for _, path := range c.mounts {
-			if _, ok := a.sandbox.storages[path]; ok {
-				if err := a.sandbox.unsetAndRemoveSandboxStorage(path); err != nil {
-					return emptyResp, err
-				}
-			}
-		}
-		delete(a.sandbox.containers, key)
+	if len(errs) > 0 {
+		return emptyResp, combineErrors(errs)
 	}
-	a.sandbox.Unlock()
 
 	if err := a.sandbox.removeNetwork(); err != nil {
 		return emptyResp, err
@@ -1558,11 +2059,10 @@ func (a *agentGRPC) DestroySandbox(ctx context.Context, req *pb.DestroySandboxRe
 	}
 
 	if tracing && !startTracingCalled {
-		// Close stopServer channel to signal the main agent code to stop
-		// the server when all gRPC calls will be completed.
-		close(a.sandbox.stopServer)
+		a.sandbox.closeStopServer()
 	}
 
+	a.sandbox.Lock()
 	a.sandbox.hostname = ""
 	a.sandbox.id = ""
 	a.sandbox.containers = make(map[string]*container)
@@ -1570,6 +2070,7 @@ func (a *agentGRPC) DestroySandbox(ctx context.Context, req *pb.DestroySandboxRe
 	a.sandbox.network = network{}
 	a.sandbox.mounts = []string{}
 	a.sandbox.storages = make(map[string]*sandboxStorage)
+	a.sandbox.Unlock()
 
 	// Synchronize the caches on the system. This is needed to ensure
 	// there is no pending transactions left before the VM is shut down.
@@ -1578,24 +2079,42 @@ func (a *agentGRPC) DestroySandbox(ctx context.Context, req *pb.DestroySandboxRe
 	return emptyResp, nil
 }
 
-func (a *agentGRPC) UpdateInterface(ctx context.Context, req *pb.UpdateInterfaceRequest) (*types.Interface, error) {
-	return a.sandbox.updateInterface(nil, req.Interface)
+func (a *agentGRPC) UpdateInterface(ctx context.Context, req *pb.UpdateInterfaceRequest) (iface *types.Interface, err error) {
+	err = runInNetNamespace(req.NetnsPath, func() error {
+		iface, err = a.sandbox.updateInterface(nil, req.Interface)
+		return err
+	})
+	return iface, err
 }
 
-func (a *agentGRPC) UpdateRoutes(ctx context.Context, req *pb.UpdateRoutesRequest) (*pb.Routes, error) {
-	return a.sandbox.updateRoutes(nil, req.Routes)
+func (a *agentGRPC) UpdateRoutes(ctx context.Context, req *pb.UpdateRoutesRequest) (routes *pb.Routes, err error) {
+	err = runInNetNamespace(req.NetnsPath, func() error {
+		routes, err = a.sandbox.updateRoutes(nil, req.Routes)
+		return err
+	})
+	return routes, err
 }
 
-func (a *agentGRPC) ListInterfaces(ctx context.Context, req *pb.ListInterfacesRequest) (*pb.Interfaces, error) {
-	return a.sandbox.listInterfaces(nil)
+func (a *agentGRPC) ListInterfaces(ctx context.Context, req *pb.ListInterfacesRequest) (ifaces *pb.Interfaces, err error) {
+	err = runInNetNamespace(req.NetnsPath, func() error {
+		ifaces, err = a.sandbox.listInterfaces(nil)
+		return err
+	})
+	return ifaces, err
 }
 
-func (a *agentGRPC) ListRoutes(ctx context.Context, req *pb.ListRoutesRequest) (*pb.Routes, error) {
-	return a.sandbox.listRoutes(nil)
+func (a *agentGRPC) ListRoutes(ctx context.Context, req *pb.ListRoutesRequest) (routes *pb.Routes, err error) {
+	err = runInNetNamespace(req.NetnsPath, func() error {
+		routes, err = a.sandbox.listRoutes(nil)
+		return err
+	})
+	return routes, err
 }
 
 func (a *agentGRPC) AddARPNeighbors(ctx context.Context, req *pb.AddARPNeighborsRequest) (*gpb.Empty, error) {
-	return emptyResp, a.sandbox.addARPNeighbors(nil, req.Neighbors)
+	return emptyResp, runInNetNamespace(req.NetnsPath, func() error {
+		return a.sandbox.addARPNeighbors(nil, req.Neighbors)
+	})
 }
 
 func (a *agentGRPC) OnlineCPUMem(ctx context.Context, req *pb.OnlineCPUMemRequest) (*gpb.Empty, error) {
@@ -1722,7 +2241,7 @@ func (a *agentGRPC) CopyFile(ctx context.Context, req *pb.CopyFileRequest) (*gpb
 	// container's rootfs is mounted at /run, in order to avoid overwrite guest's rootfs files, only
 	// is possible to copy files to /run
 	if !strings.HasPrefix(path, containersRootfsPath) {
-		return emptyResp, fmt.Errorf("Only is possible to copy files into the %s directory", containersRootfsPath)
+		return emptyResp, grpcStatus.Errorf(codes.InvalidArgument, "Only is possible to copy files into the %s directory", containersRootfsPath)
 	}
 
 	if err := os.MkdirAll(filepath.Dir(path), os.FileMode(req.DirMode)); err != nil {
@@ -1831,6 +2350,289 @@ func (a *agentGRPC) GetOOMEvent(ctx context.Context, req *pb.GetOOMEventRequest)
 	return &pb.OOMEvent{ContainerId: containerID}, nil
 }
 
+func (a *agentGRPC) DropCaches(ctx context.Context, req *pb.DropCachesRequest) (*pb.DropCachesResponse, error) {
+	freed, err := dropCaches(req.Mode, req.CompactMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DropCachesResponse{FreedBytes: freed}, nil
+}
+
+func (a *agentGRPC) CheckpointContainer(ctx context.Context, req *pb.CheckpointContainerRequest) (*pb.CheckpointContainerResponse, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkpointContainer(ctr, req); err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckpointContainerResponse{ImagePath: req.ImagePath}, nil
+}
+
+func (a *agentGRPC) FreezeFS(ctx context.Context, req *pb.FreezeFSRequest) (*pb.FreezeFSResponse, error) {
+	frozen, err := a.sandbox.fsFreezer.freeze(req.MountPoints, time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FreezeFSResponse{MountPoints: frozen}, nil
+}
+
+func (a *agentGRPC) ThawFS(ctx context.Context, req *pb.ThawFSRequest) (*pb.ThawFSResponse, error) {
+	thawed, err := a.sandbox.fsFreezer.thaw()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ThawFSResponse{MountPoints: thawed}, nil
+}
+
+func (a *agentGRPC) ResizeVolume(ctx context.Context, req *pb.ResizeVolumeRequest) (*pb.ResizeVolumeResponse, error) {
+	size, err := a.sandbox.resizeVolume(req.MountPoint, req.DeviceSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResizeVolumeResponse{DeviceSize: size}, nil
+}
+
+func (a *agentGRPC) ListDir(ctx context.Context, req *pb.ListDirRequest) (*pb.ListDirResponse, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return listDir(ctr, req)
+}
+
+func (a *agentGRPC) GetMemoryInfo(ctx context.Context, req *pb.GetMemoryInfoRequest) (*pb.MemoryInfo, error) {
+	return a.sandbox.getMemoryInfo()
+}
+
+func (a *agentGRPC) GetLogs(ctx context.Context, req *pb.GetLogsRequest) (*pb.GetLogsResponse, error) {
+	lines, err := getLogs(req.Level, req.MaxLines)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetLogsResponse{Lines: lines}, nil
+}
+
+func (a *agentGRPC) RunOnce(ctx context.Context, req *pb.RunOnceRequest) (*pb.RunOnceResponse, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return runOnce(ctx, a.sandbox.subreaper, ctr, req)
+}
+
+func (a *agentGRPC) Events(req *pb.EventsRequest, stream pb.AgentService_EventsServer) error {
+	buf := newStreamBuffer(func(m sizedMessage) error {
+		return stream.Send(m.(*pb.Event))
+	})
+	// Dropping events would break the replay from the cursor of the last
+	// event received, the events being replayed from the event bus anyway.
+	buf.drop = false
+
+	err := a.sandbox.events.stream(stream.Context(), req.Cursor, func(e *pb.Event) error {
+		return buf.push(e)
+	})
+
+	if closeErr := buf.close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+func (a *agentGRPC) WriteFiles(ctx context.Context, req *pb.WriteFilesRequest) (*gpb.Empty, error) {
+	return emptyResp, writeFiles(req.Files)
+}
+
+func (a *agentGRPC) GetMetrics(ctx context.Context, req *pb.GetMetricsRequest) (*pb.Metrics, error) {
+	return &pb.Metrics{Metrics: gatherMetrics()}, nil
+}
+
+func (a *agentGRPC) ReadLog(req *pb.ReadLogRequest, stream pb.AgentService_ReadLogServer) error {
+	path, err := containerLogPath(req.ContainerId, req.ExecId, req.Stream)
+	if err != nil {
+		return err
+	}
+
+	buf := newStreamBuffer(func(m sizedMessage) error {
+		return stream.Send(m.(*pb.ReadStreamResponse))
+	})
+
+	err = readContainerLog(stream.Context(), path, req.Follow, func(data []byte) error {
+		return buf.push(&pb.ReadStreamResponse{Data: data})
+	})
+
+	if closeErr := buf.close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+func (a *agentGRPC) UpdateResolvConf(ctx context.Context, req *pb.UpdateResolvConfRequest) (*gpb.Empty, error) {
+	a.sandbox.Lock()
+	defer a.sandbox.Unlock()
+
+	if !a.sandbox.running {
+		return emptyResp, grpcStatus.Error(codes.FailedPrecondition, "Sandbox not started")
+	}
+
+	return emptyResp, a.sandbox.updateDNS(req.Dns)
+}
+
+func (a *agentGRPC) Handshake(ctx context.Context, req *pb.HandshakeRequest) (*pb.HandshakeResponse, error) {
+	return a.handshake(req)
+}
+
+func (a *agentGRPC) GetCgroupPath(ctx context.Context, req *pb.GetCgroupPathRequest) (*pb.GetCgroupPathResponse, error) {
+	ctr, err := a.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	path, controllerPaths, err := containerCgroupPath(ctr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCgroupPathResponse{
+		Path:            path,
+		ControllerPaths: controllerPaths,
+	}, nil
+}
+
+func (a *agentGRPC) SetGuestProxy(ctx context.Context, req *pb.SetGuestProxyRequest) (*gpb.Empty, error) {
+	proxy, err := newGuestProxy(req)
+	if err != nil {
+		return emptyResp, err
+	}
+
+	a.sandbox.Lock()
+	defer a.sandbox.Unlock()
+
+	if !a.sandbox.running {
+		return emptyResp, grpcStatus.Error(codes.FailedPrecondition, "Sandbox not started")
+	}
+
+	a.sandbox.proxy = proxy
+
+	return emptyResp, nil
+}
+
+func (a *agentGRPC) ReadStdio(req *pb.ReadStdioRequest, stream pb.AgentService_ReadStdioServer) error {
+	buf := newStreamBuffer(func(m sizedMessage) error {
+		return stream.Send(m.(*pb.StdioFrame))
+	})
+	// Dropping frames would corrupt the streams.
+	buf.drop = false
+
+	err := a.sandbox.streamStdio(req.ContainerId, req.ExecId, func(f *pb.StdioFrame) error {
+		return buf.push(f)
+	})
+
+	if closeErr := buf.close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+func (a *agentGRPC) GracefulShutdown(ctx context.Context, req *pb.GracefulShutdownRequest) (*pb.GracefulShutdownResponse, error) {
+	timeout := defaultShutdownTimeout
+	if req.Timeout > 0 {
+		timeout = time.Duration(req.Timeout) * time.Second
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	// The teardown holds the teardown lock until it completes, even once
+	// the call timed out, so that no other teardown or sandbox creation
+	// runs along with it, and only then stops the server.
+	done := make(chan []error, 1)
+	go func() {
+		a.sandbox.teardownLock.Lock()
+		defer a.sandbox.teardownLock.Unlock()
+
+		if !a.sandbox.isRunning() {
+			agentLog.Info("Sandbox not started, only synchronizing the filesystems")
+			syncFilesystems()
+			done <- nil
+			return
+		}
+
+		errs := a.sandbox.shutdown(deadline.Add(-timeout / 2))
+
+		if tracing && !startTracingCalled {
+			a.sandbox.closeStopServer()
+		}
+
+		done <- errs
+	}()
+
+	select {
+	case errs := <-done:
+		resp := &pb.GracefulShutdownResponse{}
+		for _, err := range errs {
+			agentLog.WithError(err).Error("Graceful shutdown step failed")
+			resp.Failures = append(resp.Failures, err.Error())
+		}
+
+		return resp, nil
+	case <-time.After(time.Until(deadline)):
+		return &pb.GracefulShutdownResponse{}, grpcStatus.Errorf(codes.DeadlineExceeded, "Shutdown not completed after %v", timeout)
+	}
+}
+
+func (a *agentGRPC) GetInitStatus(ctx context.Context, req *pb.GetInitStatusRequest) (*pb.InitStatus, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return getInitStatus(ctr)
+}
+
+func (a *agentGRPC) GetContainerMetrics(ctx context.Context, req *pb.GetContainerMetricsRequest) (*pb.ContainerMetrics, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return getContainerMetrics(ctr)
+}
+
+func (a *agentGRPC) SyncContainer(ctx context.Context, req *pb.SyncContainerRequest) (*pb.SyncContainerResponse, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts, err := syncContainer(ctr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SyncContainerResponse{Mounts: mounts}, nil
+}
+
+func (a *agentGRPC) ValidateStorage(ctx context.Context, req *pb.ValidateStorageRequest) (*pb.ValidateStorageResponse, error) {
+	results, err := validateStorages(req.Storages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ValidateStorageResponse{Results: results}, nil
+}
+
 // createExtendedPipe creates a pipe.
 // Optionally extends the pipe if containerPipeSize is positive.
 func createExtendedPipe() (*os.File, *os.File, error) {
@@ -27,11 +27,16 @@ import (
 	"github.com/opencontainers/runc/libcontainer"
 	"github.com/opencontainers/runc/libcontainer/cgroups"
 	"github.com/opencontainers/runc/libcontainer/configs"
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
 	"github.com/opencontainers/runc/libcontainer/seccomp"
+	"github.com/opencontainers/runc/libcontainer/specconv"
+	"github.com/opencontainers/runc/libcontainer/utils"
 	runctypes "github.com/opencontainers/runc/types"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 var testSharedPidNs = "testSharedPidNs"
@@ -205,6 +210,37 @@ func TestUpdateContainerConfigNamespacesAgentPidConfig(t *testing.T) {
 
 }
 
+func TestUpdateContainerConfigNamespacesJoinedPaths(t *testing.T) {
+	joined := []configs.Namespace{
+		{
+			Type: configs.NEWIPC,
+			Path: "/proc/1234/ns/ipc",
+		},
+		{
+			Type: configs.NEWUTS,
+			Path: "/proc/1234/ns/uts",
+		},
+		{
+			Type: configs.NEWPID,
+			Path: "/proc/1234/ns/pid",
+		},
+		{
+			Type: configs.NEWNET,
+			Path: "/proc/1234/ns/net",
+		},
+	}
+
+	newConfig := func() configs.Config {
+		return configs.Config{
+			Namespaces: append([]configs.Namespace{}, joined...),
+		}
+	}
+
+	testUpdateContainerConfigNamespacesSharedPid(t, testSharedPidNs, testSharedUTSNs, testSharedIPCNs, newConfig(), newConfig())
+	testUpdateContainerConfigNamespacesNonSharedPid(t, testSharedPidNs, testSharedUTSNs, testSharedIPCNs, newConfig(), newConfig())
+	testUpdateContainerConfigNamespacesAgentPid(t, testSharedPidNs, testSharedUTSNs, testSharedIPCNs, newConfig(), newConfig())
+}
+
 func testUpdateContainerConfigPrivileges(t *testing.T, spec *specs.Spec, config, expected configs.Config) {
 	a := &agentGRPC{}
 
@@ -499,6 +535,123 @@ func TestApplyNetworkSysctls(t *testing.T) {
 	assert.Equal(spec.Linux.Sysctl["kernel.shmmax"], "512")
 }
 
+func TestIsHostNetworkSysctl(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		sysctl   string
+		expected bool
+	}
+
+	data := []testData{
+		{"net.ipv4.ip_forward", false},
+		{"net.core.somaxconn", false},
+		{"net.core.xfrm_acq_expires", false},
+		{"net.core.rmem_max", true},
+		{"net.ipv4.tcp_mem", true},
+		{"net.netfilter.nf_conntrack_max", true},
+	}
+
+	for i, d := range data {
+		assert.Equal(d.expected, isHostNetworkSysctl(d.sysctl), "test %d (%+v)", i, d)
+	}
+}
+
+func TestApplyNetworkSysctlsNetNamespace(t *testing.T) {
+	assert := assert.New(t)
+	a := &agentGRPC{}
+
+	// Host scoped sysctls are rejected for a dedicated network namespace.
+	for _, nsPath := range []string{"", "/proc/self/ns/net"} {
+		spec := &specs.Spec{
+			Linux: &specs.Linux{
+				Namespaces: []specs.LinuxNamespace{{Type: specs.NetworkNamespace, Path: nsPath}},
+				Sysctl:     map[string]string{"net.core.rmem_max": "65536"},
+			},
+		}
+		err := checkNetworkSysctls(spec)
+		assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "namespace %q", nsPath)
+	}
+
+	// They are set in the agent network namespace otherwise.
+	err := checkNetworkSysctls(&specs.Spec{
+		Linux: &specs.Linux{Sysctl: map[string]string{"net.core.rmem_max": "65536"}},
+	})
+	assert.NoError(err)
+
+	// The sysctls of a network namespace created with the container are
+	// left to libcontainer.
+	spec := &specs.Spec{
+		Linux: &specs.Linux{
+			Namespaces: []specs.LinuxNamespace{{Type: specs.NetworkNamespace}},
+			Sysctl:     map[string]string{"net.ipv4.ip_forward": "1", "kernel.shmmax": "512"},
+		},
+	}
+	err = a.applyNetworkSysctls(spec)
+	assert.NoError(err)
+	assert.Equal(map[string]string{"net.ipv4.ip_forward": "1", "kernel.shmmax": "512"}, spec.Linux.Sysctl)
+
+	skipUnlessRoot(t)
+
+	tmpDir, err := ioutil.TempDir("", "netns")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedNsDir, savedProcSysDir := persistentNsDir, procSysDir
+	defer func() {
+		persistentNsDir, procSysDir = savedNsDir, savedProcSysDir
+	}()
+	persistentNsDir = tmpDir
+	procSysDir = "/proc/sys"
+
+	ns, err := setupPersistentNs(nsTypeNet)
+	assert.NoError(err)
+	defer unix.Unmount(ns.path, unix.MNT_DETACH)
+
+	const forwardPath = "/proc/sys/net/ipv4/ip_forward"
+	agentForward, err := ioutil.ReadFile(forwardPath)
+	assert.NoError(err)
+
+	forward := "1"
+	if strings.TrimSpace(string(agentForward)) == "1" {
+		forward = "0"
+	}
+
+	spec = &specs.Spec{
+		Linux: &specs.Linux{
+			Namespaces: []specs.LinuxNamespace{{Type: specs.NetworkNamespace, Path: ns.path}},
+			Sysctl:     map[string]string{"net.ipv4.ip_forward": forward, "kernel.shmmax": "512"},
+		},
+	}
+	err = a.applyNetworkSysctls(spec)
+	assert.NoError(err)
+	assert.Equal(map[string]string{"kernel.shmmax": "512"}, spec.Linux.Sysctl)
+
+	// The sysctl is only set in the network namespace of the container.
+	var nsForward []byte
+	err = runInNamespace(ns.path, nsTypeNet, func() error {
+		var err error
+		nsForward, err = ioutil.ReadFile(forwardPath)
+		return err
+	})
+	assert.NoError(err)
+	assert.Equal(forward, strings.TrimSpace(string(nsForward)))
+
+	content, err := ioutil.ReadFile(forwardPath)
+	assert.NoError(err)
+	assert.Equal(agentForward, content)
+
+	// The agent network namespace is restored when a sysctl cannot be written.
+	spec.Linux.Sysctl = map[string]string{"net.ipv4.nonexistent": "1"}
+	err = a.applyNetworkSysctls(spec)
+	assert.Error(err)
+	assert.Equal(map[string]string{"net.ipv4.nonexistent": "1"}, spec.Linux.Sysctl)
+
+	content, err = ioutil.ReadFile(forwardPath)
+	assert.NoError(err)
+	assert.Equal(agentForward, content)
+}
+
 func TestUpdateContainer(t *testing.T) {
 	containerID := "1"
 	assert := assert.New(t)
@@ -1340,13 +1493,26 @@ func TestPidNsExists(t *testing.T) {
 					Namespaces: []pb.LinuxNamespace{
 						{
 							Type: "NEWPID",
-							Path: "foo",
 						},
 					},
 				},
 			},
 			true,
 		},
+		// A PID namespace joined by path is allowed.
+		{
+			&pb.Spec{
+				Linux: &pb.Linux{
+					Namespaces: []pb.LinuxNamespace{
+						{
+							Type: "NEWPID",
+							Path: "foo",
+						},
+					},
+				},
+			},
+			false,
+		},
 	}
 
 	for i, d := range data {
@@ -1408,7 +1574,6 @@ func TestCreateContainerChecks(t *testing.T) {
 						Namespaces: []pb.LinuxNamespace{
 							{
 								Type: "NEWPID",
-								Path: "foo",
 							},
 						},
 					},
@@ -1467,6 +1632,165 @@ func TestCreateContainer(t *testing.T) {
 	assert.Error(err)
 }
 
+func TestCreateContainerTimeout(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "create-timeout")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedTimeout, savedOCIConfigBasePath := createTimeout, ociConfigBasePath
+	createTimeout = 200 * time.Millisecond
+	ociConfigBasePath = filepath.Join(tmpDir, "libcontainer")
+	defer func() {
+		createTimeout, ociConfigBasePath = savedTimeout, savedOCIConfigBasePath
+	}()
+
+	// A storage driver mounting the sandbox storage after the deadline.
+	storageHandlerList["slow"] = func(ctx context.Context, storage pb.Storage, s *sandbox) (string, error) {
+		time.Sleep(2 * createTimeout)
+		return ephemeralStorageHandler(ctx, storage, s)
+	}
+	defer delete(storageHandlerList, "slow")
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			storages:   make(map[string]*sandboxStorage),
+			running:    true,
+		},
+	}
+
+	// A 9p storage is mounted before the slow one.
+	mountPoint9p := filepath.Join(tmpDir, "9p")
+	err = os.Mkdir(mountPoint9p, 0755)
+	assert.NoError(err)
+
+	mountPoint := filepath.Join(tmpDir, "storage")
+	req := &pb.CreateContainerRequest{
+		ContainerId: "ctr",
+		OCI:         &pb.Spec{Process: &pb.Process{}},
+		Storages: []*pb.Storage{
+			{Driver: driver9pType, Source: "tmpfs", Fstype: "tmpfs", MountPoint: mountPoint9p},
+			{Driver: "slow", Source: "tmpfs", Fstype: "tmpfs", MountPoint: mountPoint},
+		},
+	}
+
+	start := time.Now()
+	_, err = a.CreateContainer(context.Background(), req)
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.True(time.Since(start) < 2*time.Second, "create took %s", time.Since(start))
+
+	// Nothing is left behind.
+	assert.Empty(a.sandbox.containers)
+	assert.Empty(a.sandbox.storages)
+	_, err = os.Stat(mountPoint)
+	assert.True(os.IsNotExist(err), "%v", err)
+	mounted, err := mountinfo.Mounted(mountPoint9p)
+	assert.NoError(err)
+	assert.False(mounted)
+	_, err = os.Stat(filepath.Join(ociConfigBasePath, req.ContainerId))
+	assert.True(os.IsNotExist(err), "%v", err)
+}
+
+func TestCreateContainerDuplicate(t *testing.T) {
+	assert := assert.New(t)
+
+	// A storage driver recording the state of the existing container when
+	// the new one is created, then failing the creation.
+	var replaced *mockContainer
+	var destroyedFirst bool
+	storageHandlerList["replace"] = func(ctx context.Context, storage pb.Storage, s *sandbox) (string, error) {
+		_, err := s.getContainer(testContainerID)
+		destroyedFirst = replaced.destroyed && err != nil
+		return "", fmt.Errorf("storage failure")
+	}
+	defer delete(storageHandlerList, "replace")
+
+	type testData struct {
+		replace      bool
+		expectedCode codes.Code
+		// whether the existing container is replaced
+		expectReplaced bool
+	}
+
+	data := []testData{
+		{false, codes.AlreadyExists, false},
+		{true, codes.Unknown, true},
+	}
+
+	for i, d := range data {
+		replaced = &mockContainer{
+			status:      libcontainer.Running,
+			stopSignals: []os.Signal{syscall.SIGTERM},
+		}
+		destroyedFirst = false
+
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{
+					testContainerID: {id: testContainerID, container: replaced},
+				},
+				storages: make(map[string]*sandboxStorage),
+				running:  true,
+			},
+		}
+
+		_, err := a.CreateContainer(context.Background(), &pb.CreateContainerRequest{
+			ContainerId:     testContainerID,
+			OCI:             &pb.Spec{Process: &pb.Process{}},
+			Storages:        []*pb.Storage{{Driver: "replace", MountPoint: "/foo"}},
+			ReplaceExisting: d.replace,
+		})
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		// The existing container is stopped and removed before the new
+		// one is created.
+		assert.Equal(d.expectReplaced, destroyedFirst, "test %d (%+v)", i, d)
+		assert.Equal(d.expectReplaced, replaced.destroyed, "test %d (%+v)", i, d)
+		if d.expectReplaced {
+			assert.Equal([]os.Signal{syscall.SIGTERM}, replaced.signals, "test %d (%+v)", i, d)
+			assert.Empty(a.sandbox.containers, "test %d (%+v)", i, d)
+		} else {
+			assert.Empty(replaced.signals, "test %d (%+v)", i, d)
+			assert.Len(a.sandbox.containers, 1, "test %d (%+v)", i, d)
+		}
+
+		assert.Empty(a.sandbox.creating, "test %d (%+v)", i, d)
+	}
+}
+
+func TestReserveContainerID(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &sandbox{
+		containers: map[string]*container{
+			testContainerID: {id: testContainerID},
+		},
+	}
+
+	_, err := s.reserveContainerID(testContainerID, false)
+	assert.Equal(codes.AlreadyExists, grpcStatus.Code(err))
+
+	release, err := s.reserveContainerID(testContainerID, true)
+	assert.NoError(err)
+
+	// The ID cannot be reserved twice, even for a replacement.
+	for _, replace := range []bool{false, true} {
+		_, err = s.reserveContainerID(testContainerID, replace)
+		assert.Equal(codes.AlreadyExists, grpcStatus.Code(err))
+	}
+
+	release()
+
+	release, err = s.reserveContainerID("new", false)
+	assert.NoError(err)
+	release()
+	assert.Empty(s.creating)
+}
+
 func TestRemoveContainer(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1481,8 +1805,123 @@ func TestRemoveContainer(t *testing.T) {
 		},
 	}
 
+	// Removing an unknown container does nothing.
 	_, err := a.RemoveContainer(context.Background(), req)
+	assert.NoError(err)
+}
+
+func TestRemoveContainerResources(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "remove-container")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedOCIConfigBasePath := ociConfigBasePath
+	ociConfigBasePath = filepath.Join(tmpDir, "oci")
+	defer func() {
+		ociConfigBasePath = savedOCIConfigBasePath
+	}()
+
+	cid := "test-remove-container"
+
+	c, cleanup := createTestContainer(t, cid)
+	defer cleanup()
+
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"sleep", "100"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	state, err := c.State()
+	assert.NoError(err)
+	assert.NotEmpty(state.CgroupPaths)
+
+	// A mount of the container, and a sandbox storage it uses.
+	var paths []string
+	for _, name := range []string{"mount", "storage"} {
+		path := filepath.Join(tmpDir, name)
+		err = os.Mkdir(path, 0755)
+		assert.NoError(err)
+		err = syscall.Mount("tmpfs", path, "tmpfs", 0, "")
+		assert.NoError(err)
+		paths = append(paths, path)
+	}
+	mount, storage := paths[0], paths[1]
+
+	err = writeSpecToFile(&specs.Spec{}, cid)
+	assert.NoError(err)
+
+	logDir, cleanupLogs := setupContainerLogs(t, 100, 1, false)
+	defer cleanupLogs()
+
+	logPath, err := containerLogPath(cid, "init", containerLogStdout)
+	assert.NoError(err)
+	log, err := openRotatingLog(logPath)
+	assert.NoError(err)
+	log.Close()
+
+	s := &sandbox{
+		ctx:        context.Background(),
+		containers: make(map[string]*container),
+		storages:   map[string]*sandboxStorage{storage: {refCount: 1}},
+		running:    true,
+	}
+	a := &agentGRPC{sandbox: s}
+
+	ctr := &container{
+		id:          cid,
+		container:   c,
+		initProcess: initProc,
+		processes:   map[string]*process{"init": initProc},
+		mounts:      []string{mount},
+		storages:    []string{storage},
+		ctx:         context.Background(),
+	}
+	s.containers[cid] = ctr
+
+	req := &pb.RemoveContainerRequest{ContainerId: cid, Timeout: 10}
+	_, err = a.RemoveContainer(context.Background(), req)
+	assert.NoError(err)
+
+	// The init process has been killed.
+	_, err = initProc.process.Wait()
 	assert.Error(err)
+
+	for _, path := range state.CgroupPaths {
+		_, err = os.Stat(path)
+		assert.True(os.IsNotExist(err), path)
+	}
+
+	for _, path := range paths {
+		mounted, err := mountinfo.Mounted(path)
+		assert.NoError(err)
+		assert.False(mounted, path)
+	}
+	_, err = os.Stat(storage)
+	assert.True(os.IsNotExist(err))
+
+	for _, path := range []string{filepath.Join(ociConfigBasePath, cid), filepath.Join(logDir, cid)} {
+		_, err = os.Stat(path)
+		assert.True(os.IsNotExist(err), path)
+	}
+
+	assert.Empty(s.containers)
+	assert.Empty(s.storages)
+
+	// Removing it again does nothing.
+	_, err = a.RemoveContainer(context.Background(), req)
+	assert.NoError(err)
+	assert.NoError(s.removeContainer(ctr))
 }
 
 func TestCreateSandbox(t *testing.T) {
@@ -1518,6 +1957,88 @@ func TestDestroySandbox(t *testing.T) {
 	assert.Equal(result, emptyResp)
 }
 
+func TestDestroySandboxContainers(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "destroy-sandbox")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedOCIConfigBasePath, savedContainerDNSDir := ociConfigBasePath, containerDNSDir
+	ociConfigBasePath = filepath.Join(tmpDir, "oci")
+	containerDNSDir = filepath.Join(tmpDir, "dns")
+	defer func() {
+		ociConfigBasePath, containerDNSDir = savedOCIConfigBasePath, savedContainerDNSDir
+	}()
+
+	cid := "test-destroy-sandbox"
+
+	c, cleanup := createTestContainer(t, cid)
+	defer cleanup()
+
+	// A sandbox storage the container uses, and the mount points of the
+	// shared namespaces.
+	var paths []string
+	for _, name := range []string{"storage", "ipc", "uts"} {
+		path := filepath.Join(tmpDir, name)
+		err = os.Mkdir(path, 0755)
+		assert.NoError(err)
+		err = syscall.Mount("tmpfs", path, "tmpfs", 0, "")
+		assert.NoError(err)
+		paths = append(paths, path)
+	}
+	storage := paths[0]
+
+	err = writeSpecToFile(&specs.Spec{}, cid)
+	assert.NoError(err)
+
+	err = os.MkdirAll(filepath.Dir(containerDNSFile(cid)), 0755)
+	assert.NoError(err)
+	err = writeDNSFile(containerDNSFile(cid), []string{"nameserver 8.8.8.8"})
+	assert.NoError(err)
+
+	s := &sandbox{
+		ctx:         context.Background(),
+		containers:  make(map[string]*container),
+		storages:    map[string]*sandboxStorage{storage: {refCount: 1}},
+		sharedIPCNs: namespace{path: paths[1]},
+		sharedUTSNs: namespace{path: paths[2]},
+		stopServer:  make(chan struct{}),
+		running:     true,
+	}
+	a := &agentGRPC{sandbox: s}
+
+	s.containers[cid] = &container{
+		id:        cid,
+		container: c,
+		processes: make(map[string]*process),
+		storages:  []string{storage},
+		ctx:       context.Background(),
+	}
+
+	_, err = a.DestroySandbox(context.Background(), &pb.DestroySandboxRequest{})
+	assert.NoError(err)
+
+	for _, path := range paths {
+		mounted, err := mountinfo.Mounted(path)
+		assert.NoError(err)
+		assert.False(mounted, path)
+	}
+	_, err = os.Stat(storage)
+	assert.True(os.IsNotExist(err))
+
+	for _, path := range []string{filepath.Join(ociConfigBasePath, cid), filepath.Dir(containerDNSFile(cid))} {
+		_, err = os.Stat(path)
+		assert.True(os.IsNotExist(err), path)
+	}
+
+	assert.Empty(s.containers)
+	assert.Empty(s.storages)
+	assert.False(s.running)
+}
+
 func TestStartContainer(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1636,6 +2157,33 @@ func TestSignalProcess(t *testing.T) {
 		},
 	}
 
+	// Signals designated by their name
+	for _, req := range []struct {
+		req         *pb.SignalProcessRequest
+		expectError bool
+	}{
+		{&pb.SignalProcessRequest{ContainerId: "foo", SignalName: "TERM"}, false},
+		{&pb.SignalProcessRequest{ContainerId: "foo", SignalName: "SIGKILL", Signal: uint32(syscall.SIGKILL)}, false},
+		{&pb.SignalProcessRequest{ContainerId: "foo", SignalName: "SIGKILL", Signal: uint32(syscall.SIGTERM)}, true},
+		{&pb.SignalProcessRequest{ContainerId: "foo", SignalName: "SIGFOO"}, true},
+	} {
+		data = append(data, testData{
+			&sandbox{
+				containers: map[string]*container{
+					"foo": {
+						id: "foo",
+						container: &mockContainer{
+							processes: []int{1},
+						},
+					},
+				},
+				running: true,
+			},
+			req.req,
+			req.expectError,
+		})
+	}
+
 	for i, d := range data {
 		msg := fmt.Sprintf("test[%d]: %+v\n", i, d)
 
@@ -1921,3 +2469,184 @@ func getPipeMaxSize() (uint32, error) {
 	u, err := strconv.ParseUint(s, 10, 32)
 	return uint32(u), err
 }
+
+// createTestContainer creates a libcontainer container, whose rootfs only
+// holds the /usr directory of the host, returned along with a function
+// destroying it. The test binary acts as the container init.
+func createTestContainer(t *testing.T, id string) (libcontainer.Container, func()) {
+	return createTestContainerWithConfig(t, id, nil)
+}
+
+// createTestContainerWithConfig is createTestContainer, update being called
+// with the configuration of the container, if not nil, before its creation.
+func createTestContainerWithConfig(t *testing.T, id string, update func(config *configs.Config)) (libcontainer.Container, func()) {
+	dir, err := ioutil.TempDir("", "test-container")
+	assert.NoError(t, err)
+
+	rootfs := filepath.Join(dir, "rootfs")
+	err = os.Mkdir(rootfs, 0755)
+	assert.NoError(t, err)
+
+	for _, d := range []string{"bin", "lib", "lib64", "sbin"} {
+		err = os.Symlink(filepath.Join("usr", d), filepath.Join(rootfs, d))
+		assert.NoError(t, err)
+	}
+
+	config := &configs.Config{
+		Rootfs: rootfs,
+		Namespaces: configs.Namespaces{
+			{Type: configs.NEWNS},
+			{Type: configs.NEWPID},
+		},
+		Mounts: []*configs.Mount{
+			{Source: "/usr", Destination: "/usr", Device: "bind", Flags: unix.MS_BIND | unix.MS_REC | unix.MS_RDONLY},
+			{Source: "tmpfs", Destination: "/dev", Device: "tmpfs", Flags: unix.MS_NOSUID},
+			{Source: "devpts", Destination: "/dev/pts", Device: "devpts", Flags: unix.MS_NOSUID | unix.MS_NOEXEC, Data: "newinstance,ptmxmode=0666,mode=0620"},
+			{Source: "proc", Destination: "/proc", Device: "proc"},
+			{Source: "sysfs", Destination: "/sys", Device: "sysfs", Flags: unix.MS_RDONLY | unix.MS_NOSUID | unix.MS_NODEV | unix.MS_NOEXEC},
+		},
+		Devices: specconv.AllowedDevices,
+		Cgroups: &configs.Cgroup{
+			Name:      id,
+			Resources: &configs.Resources{},
+		},
+	}
+
+	if update != nil {
+		update(config)
+	}
+
+	factory, err := libcontainer.New(filepath.Join(dir, "state"), libcontainer.Cgroupfs)
+	assert.NoError(t, err)
+
+	c, err := factory.Create(id, config)
+	assert.NoError(t, err)
+
+	return c, func() {
+		c.Destroy()
+		os.RemoveAll(dir)
+	}
+}
+
+// runTestProcess runs proc in c and returns its output.
+func runTestProcess(t *testing.T, c libcontainer.Container, proc *process) string {
+	err := c.Run(&proc.process)
+	proc.closePostStartFDs()
+	assert.NoError(t, err)
+
+	output, err := ioutil.ReadAll(proc.stdout)
+	assert.NoError(t, err)
+
+	_, err = proc.process.Wait()
+	assert.NoError(t, err)
+
+	proc.closePostExitFDs()
+
+	return string(output)
+}
+
+func TestBuildProcessPath(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	c, cleanup := createTestContainer(t, "test-process-path")
+	defer cleanup()
+
+	env := []string{"PATH=/bin"}
+
+	// The init process sees the requested argv[0].
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"-sh", "-c", "echo $0; exec sleep 100"},
+		Path: "sh",
+		Env:  env,
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	buf := make([]byte, 64)
+	n, err := initProc.stdout.Read(buf)
+	assert.NoError(err)
+	assert.Equal("-sh\n", string(buf[:n]))
+
+	type testData struct {
+		path           string
+		args           []string
+		expectedOutput string
+	}
+
+	data := []testData{
+		{"", []string{"sh", "-c", "echo $0"}, "sh\n"},
+		{"/bin/sh", []string{"applet", "-c", "echo $0"}, "applet\n"},
+		{"sh", []string{"-login", "-c", "echo $0"}, "-login\n"},
+	}
+
+	// So do the processes run in the container.
+	for i, d := range data {
+		proc, err := buildProcess(&pb.Process{
+			Args: d.args,
+			Path: d.path,
+			Env:  env,
+			Cwd:  "/",
+		}, fmt.Sprintf("exec-%d", i), false)
+		assert.NoError(err)
+
+		assert.Equal(d.expectedOutput, runTestProcess(t, c, proc), "test %d (%+v)", i, d)
+	}
+
+	_, err = buildProcess(&pb.Process{Path: "sh"}, "exec", false)
+	assert.Error(err)
+
+	err = c.Signal(syscall.SIGKILL, true)
+	assert.NoError(err)
+	initProc.process.Wait()
+}
+
+func TestBuildProcessConsoleSize(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	c, cleanup := createTestContainer(t, "test-console-size")
+	defer cleanup()
+
+	// The size of the console is known as soon as the process starts.
+	proc, err := buildProcess(&pb.Process{
+		Args:        []string{"stty", "size"},
+		Env:         []string{"PATH=/bin"},
+		Cwd:         "/",
+		Terminal:    true,
+		ConsoleSize: &pb.Box{Height: 40, Width: 120},
+	}, "init", true)
+	assert.NoError(err)
+	assert.Equal(uint16(40), proc.process.ConsoleHeight)
+	assert.Equal(uint16(120), proc.process.ConsoleWidth)
+
+	err = c.Run(&proc.process)
+	assert.NoError(err)
+
+	termMaster, err := utils.RecvFd(proc.consoleSock)
+	assert.NoError(err)
+	defer termMaster.Close()
+
+	proc.closePostStartFDs()
+	defer proc.closePostExitFDs()
+
+	// Reading the master fails once the process exits.
+	output, _ := ioutil.ReadAll(termMaster)
+	assert.Equal("40 120", strings.TrimSpace(string(output)))
+
+	_, err = proc.process.Wait()
+	assert.NoError(err)
+
+	_, err = buildProcess(&pb.Process{
+		Terminal:    true,
+		ConsoleSize: &pb.Box{Height: 40, Width: 1 << 16},
+	}, "exec", false)
+	assert.Error(err)
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Optional features reported by the handshake when enabled.
+const (
+	featureSeccomp       = "seccomp"
+	featureContainerLogs = "container-logs"
+	featureLogBuffer     = "log-buffer"
+	featureResolvConf    = "resolv-conf-"
+)
+
+// parseProtocolVersion parses a "major.minor.patch" protocol version.
+func parseProtocolVersion(v string) ([3]uint64, error) {
+	var version [3]uint64
+
+	fields := strings.Split(v, ".")
+	if len(fields) != len(version) {
+		return version, fmt.Errorf("invalid protocol version %q", v)
+	}
+
+	for i, f := range fields {
+		n, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return version, fmt.Errorf("invalid protocol version %q", v)
+		}
+		version[i] = n
+	}
+
+	return version, nil
+}
+
+// compareProtocolVersions returns -1, 0 or 1 depending on a being older,
+// equal or newer than b.
+func compareProtocolVersions(a, b [3]uint64) int {
+	for i := range a {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// checkProtocolVersion rejects the client protocol version v if it is older
+// than pb.MinAPIVersion. Newer versions are accepted as long as they share
+// the major version of pb.APIVersion.
+func checkProtocolVersion(v string) error {
+	client, err := parseProtocolVersion(v)
+	if err != nil {
+		return grpcStatus.Error(codes.InvalidArgument, err.Error())
+	}
+
+	min, err := parseProtocolVersion(pb.MinAPIVersion)
+	if err != nil {
+		return grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	current, err := parseProtocolVersion(pb.APIVersion)
+	if err != nil {
+		return grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	if compareProtocolVersions(client, min) < 0 {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "protocol version %s is too old, at least %s is required", v, pb.MinAPIVersion)
+	}
+
+	if client[0] != current[0] {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "protocol version %s is not compatible with %s", v, pb.APIVersion)
+	}
+
+	return nil
+}
+
+// handshake returns the description of the agent sent to a client speaking
+// the protocol version given by req.
+func (a *agentGRPC) handshake(req *pb.HandshakeRequest) (*pb.HandshakeResponse, error) {
+	if err := checkProtocolVersion(req.ProtocolVersion); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.HandshakeResponse{
+		AgentVersion:       a.version,
+		ProtocolVersion:    pb.APIVersion,
+		MinProtocolVersion: pb.MinAPIVersion,
+		CgroupVersion:      1,
+		Tracing:            tracing,
+	}
+
+	for driver := range storageHandlerList {
+		resp.StorageDrivers = append(resp.StorageDrivers, driver)
+	}
+	sort.Strings(resp.StorageDrivers)
+
+	for driver := range deviceHandlerList {
+		resp.DeviceDrivers = append(resp.DeviceDrivers, driver)
+	}
+	sort.Strings(resp.DeviceDrivers)
+
+	if unifiedCgroupHierarchy {
+		resp.CgroupVersion = 2
+	}
+
+	if a.haveSeccomp() {
+		resp.Features = append(resp.Features, featureSeccomp)
+	}
+	if containerLogDir != "" {
+		resp.Features = append(resp.Features, featureContainerLogs)
+	}
+	if logBufferSize > 0 {
+		resp.Features = append(resp.Features, featureLogBuffer)
+	}
+	if resolvConfMode != "" {
+		resp.Features = append(resp.Features, featureResolvConf+resolvConfMode)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestCheckProtocolVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		version      string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{pb.APIVersion, codes.OK},
+		{pb.MinAPIVersion, codes.OK},
+		{"0.99.0", codes.OK},
+		{"0.0.0", codes.FailedPrecondition},
+		{"1.0.0", codes.FailedPrecondition},
+		{"", codes.InvalidArgument},
+		{"0.1", codes.InvalidArgument},
+		{"0.1.x", codes.InvalidArgument},
+		{"v0.0.1", codes.InvalidArgument},
+	}
+
+	for i, d := range data {
+		err := checkProtocolVersion(d.version)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	assert := assert.New(t)
+
+	savedTracing, savedUnified, savedLogDir, savedMode := tracing, unifiedCgroupHierarchy, containerLogDir, resolvConfMode
+	defer func() {
+		tracing, unifiedCgroupHierarchy, containerLogDir, resolvConfMode = savedTracing, savedUnified, savedLogDir, savedMode
+	}()
+
+	tracing = true
+	unifiedCgroupHierarchy = true
+	containerLogDir = "/run/kata-containers/logs"
+	resolvConfMode = resolvConfModeShared
+
+	a := &agentGRPC{version: "1.2.3"}
+
+	resp, err := a.Handshake(context.Background(), &pb.HandshakeRequest{ProtocolVersion: pb.APIVersion})
+	assert.NoError(err)
+
+	assert.Equal("1.2.3", resp.AgentVersion)
+	assert.Equal(pb.APIVersion, resp.ProtocolVersion)
+	assert.Equal(pb.MinAPIVersion, resp.MinProtocolVersion)
+	assert.Equal(uint32(2), resp.CgroupVersion)
+	assert.True(resp.Tracing)
+
+	assert.Len(resp.StorageDrivers, len(storageHandlerList))
+	assert.True(sort.StringsAreSorted(resp.StorageDrivers))
+	assert.Contains(resp.StorageDrivers, driverBlkType)
+	assert.Len(resp.DeviceDrivers, len(deviceHandlerList))
+	assert.True(sort.StringsAreSorted(resp.DeviceDrivers))
+
+	assert.Contains(resp.Features, featureContainerLogs)
+	assert.Contains(resp.Features, featureResolvConf+resolvConfModeShared)
+
+	tracing = false
+	unifiedCgroupHierarchy = false
+	containerLogDir = ""
+	resolvConfMode = ""
+
+	resp, err = a.Handshake(context.Background(), &pb.HandshakeRequest{ProtocolVersion: pb.APIVersion})
+	assert.NoError(err)
+	assert.Equal(uint32(1), resp.CgroupVersion)
+	assert.False(resp.Tracing)
+	assert.NotContains(resp.Features, featureContainerLogs)
+	assert.NotContains(resp.Features, featureResolvConf+resolvConfModeShared)
+
+	// A client speaking an incompatible protocol is rejected.
+	resp, err = a.Handshake(context.Background(), &pb.HandshakeRequest{ProtocolVersion: "0.0.0"})
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+	assert.Nil(resp)
+}
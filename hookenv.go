@@ -0,0 +1,72 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// Variables describing the container, passed to each OCI hook.
+const (
+	hookEnvSandboxID   = "KATA_SANDBOX_ID"
+	hookEnvContainerID = "KATA_CONTAINER_ID"
+	hookEnvBundle      = "KATA_BUNDLE"
+)
+
+// Variables of the agent environment passed to the OCI hooks by default.
+var defaultHookEnvAllowlist = []string{"PATH", "LANG", "TZ"}
+
+// hookEnv returns the environment of the OCI hooks of the container
+// containerID: the allowed variables of the agent environment followed by
+// the container metadata.
+func hookEnv(sandboxID, containerID string, spec *specs.Spec) []string {
+	allowed := make(map[string]bool)
+	for _, name := range hookEnvAllowlist {
+		allowed[name] = true
+	}
+
+	var env []string
+	for _, v := range os.Environ() {
+		name := strings.SplitN(v, "=", 2)[0]
+		if allowed[name] {
+			env = append(env, v)
+		}
+	}
+
+	env = append(env,
+		hookEnvSandboxID+"="+sandboxID,
+		hookEnvContainerID+"="+containerID)
+
+	if spec.Root != nil && spec.Root.Path != "" {
+		env = append(env, hookEnvBundle+"="+filepath.Dir(spec.Root.Path))
+	}
+
+	return env
+}
+
+// setupHooksEnv sets the environment of the OCI hooks of spec, which would
+// otherwise inherit the whole agent environment. The environment specified
+// for a hook by the spec is kept, taking precedence.
+func setupHooksEnv(spec *specs.Spec, sandboxID, containerID string) {
+	if spec.Hooks == nil {
+		return
+	}
+
+	env := hookEnv(sandboxID, containerID, spec)
+
+	for _, hooks := range [][]specs.Hook{spec.Hooks.Prestart, spec.Hooks.Poststart, spec.Hooks.Poststop} {
+		for i := range hooks {
+			e := make([]string, 0, len(env)+len(hooks[i].Env))
+			e = append(e, env...)
+			hooks[i].Env = append(e, hooks[i].Env...)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupHooksEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "hook-env")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedAllowlist := hookEnvAllowlist
+	defer func() {
+		hookEnvAllowlist = savedAllowlist
+	}()
+
+	os.Setenv("KATA_TEST_SECRET", "token")
+	os.Setenv("KATA_TEST_ALLOWED", "allowed")
+	defer os.Unsetenv("KATA_TEST_SECRET")
+	defer os.Unsetenv("KATA_TEST_ALLOWED")
+
+	hookEnvAllowlist = append([]string{"KATA_TEST_ALLOWED"}, defaultHookEnvAllowlist...)
+
+	// The hook dumps its environment to the file given as argument.
+	hookPath := filepath.Join(tmpDir, "hook")
+	err = ioutil.WriteFile(hookPath, []byte("#!/bin/sh\nenv > \"$1\"\n"), 0755)
+	assert.NoError(err)
+
+	output := filepath.Join(tmpDir, "env")
+	state := &specs.State{ID: "ctr", Bundle: tmpDir}
+
+	spec := &specs.Spec{
+		Root: &specs.Root{Path: filepath.Join(tmpDir, "rootfs")},
+		Hooks: &specs.Hooks{
+			Prestart: []specs.Hook{
+				{Path: hookPath, Args: []string{"hook", output}},
+				{Path: hookPath, Args: []string{"hook", output}, Env: []string{"FOO=bar", "KATA_TEST_ALLOWED=overridden"}},
+			},
+			Poststop: []specs.Hook{
+				{Path: hookPath, Args: []string{"hook", output}},
+			},
+		},
+	}
+
+	setupHooksEnv(spec, "sandbox", "ctr")
+
+	type testData struct {
+		hook     specs.Hook
+		present  []string
+		excluded []string
+	}
+
+	data := []testData{
+		{spec.Hooks.Prestart[0],
+			[]string{"KATA_TEST_ALLOWED=allowed", "PATH=" + os.Getenv("PATH"), "KATA_SANDBOX_ID=sandbox", "KATA_CONTAINER_ID=ctr", "KATA_BUNDLE=" + tmpDir},
+			[]string{"KATA_TEST_SECRET=token", "FOO=bar"}},
+		{spec.Hooks.Prestart[1],
+			[]string{"KATA_TEST_ALLOWED=overridden", "FOO=bar", "KATA_CONTAINER_ID=ctr"},
+			[]string{"KATA_TEST_SECRET=token", "KATA_TEST_ALLOWED=allowed"}},
+		{spec.Hooks.Poststop[0],
+			[]string{"KATA_TEST_ALLOWED=allowed", "KATA_CONTAINER_ID=ctr"},
+			[]string{"KATA_TEST_SECRET=token"}},
+	}
+
+	for i, d := range data {
+		cmd := configs.Command{Path: d.hook.Path, Args: d.hook.Args, Env: d.hook.Env}
+		err = cmd.Run(state)
+		assert.NoError(err, "test %d", i)
+
+		content, err := ioutil.ReadFile(output)
+		assert.NoError(err)
+		env := strings.Split(strings.TrimSpace(string(content)), "\n")
+
+		for _, v := range d.present {
+			assert.Contains(env, v, "test %d", i)
+		}
+		for _, v := range d.excluded {
+			assert.NotContains(env, v, "test %d", i)
+		}
+	}
+
+	// Nothing is passed from the agent environment with an empty allowlist.
+	hookEnvAllowlist = nil
+	env := hookEnv("sandbox", "ctr", &specs.Spec{})
+	assert.Equal([]string{"KATA_SANDBOX_ID=sandbox", "KATA_CONTAINER_ID=ctr"}, env)
+
+	// No hooks, nothing to set up.
+	spec = &specs.Spec{}
+	setupHooksEnv(spec, "sandbox", "ctr")
+	assert.Nil(spec.Hooks)
+}
@@ -0,0 +1,249 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// How the hooks of a phase are run.
+const (
+	// The hooks are run one after the other, in the order of the spec.
+	hooksPolicyOrdered = "ordered"
+	// The hooks are run one after the other, sorted by name.
+	hooksPolicySorted = "sorted"
+	// The hooks are all run at once.
+	hooksPolicyConcurrent = "concurrent"
+)
+
+// runHooks runs the hooks of a phase as hooksPolicy selects. No more than
+// hooksMax hooks are run, and the hooks still running once hooksTimeout
+// elapsed since the first one started, or at deadline if not zero, are
+// killed, the remaining ones being skipped.
+func runHooks(phase string, hooks []configs.Hook, state *specs.State, deadline time.Time) error {
+	start := time.Now()
+
+	end, limit := deadline, "creation deadline"
+	if hooksTimeout > 0 && (end.IsZero() || start.Add(hooksTimeout).Before(end)) {
+		end, limit = start.Add(hooksTimeout), fmt.Sprintf("time budget of %s", hooksTimeout)
+	}
+
+	switch hooksPolicy {
+	case hooksPolicyConcurrent:
+		return runHooksConcurrently(phase, hooks, state, end, limit)
+	case hooksPolicySorted:
+		hooks = sortHooks(hooks)
+	}
+
+	return runHooksSequentially(phase, hooks, state, end, limit)
+}
+
+// runHooksSequentially runs hooks in order, stopping at the first failure.
+func runHooksSequentially(phase string, hooks []configs.Hook, state *specs.State, end time.Time, limit string) error {
+	for i, hook := range hooks {
+		if hooksMax > 0 && uint32(i) >= hooksMax {
+			return grpcStatus.Errorf(codes.ResourceExhausted,
+				"%s hooks: %d of %d hooks run, limit of %d hooks reached", phase, i, len(hooks), hooksMax)
+		}
+
+		if !end.IsZero() {
+			remaining := time.Until(end)
+			if remaining <= 0 {
+				return grpcStatus.Errorf(codes.DeadlineExceeded,
+					"%s hooks: %d of %d hooks run, %s exceeded", phase, i, len(hooks), limit)
+			}
+
+			hook = boundHookTimeout(hook, remaining)
+		}
+
+		if err := hook.Run(state); err != nil {
+			if !end.IsZero() && !time.Now().Before(end) {
+				return grpcStatus.Errorf(codes.DeadlineExceeded,
+					"%s hooks: %d of %d hooks run, %s exceeded: %v", phase, i+1, len(hooks), limit, err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runHooksConcurrently runs the first hooksMax hooks at once, waiting for
+// all of them and reporting all their failures.
+func runHooksConcurrently(phase string, hooks []configs.Hook, state *specs.State, end time.Time, limit string) error {
+	run := hooks
+	if hooksMax > 0 && uint32(len(hooks)) > hooksMax {
+		run = hooks[:hooksMax]
+	}
+
+	var remaining time.Duration
+	if !end.IsZero() {
+		if remaining = time.Until(end); remaining <= 0 {
+			return grpcStatus.Errorf(codes.DeadlineExceeded,
+				"%s hooks: 0 of %d hooks run, %s exceeded", phase, len(hooks), limit)
+		}
+	}
+
+	hookErrs := make([]error, len(run))
+	var wg sync.WaitGroup
+
+	for i, hook := range run {
+		if !end.IsZero() {
+			hook = boundHookTimeout(hook, remaining)
+		}
+
+		wg.Add(1)
+		go func(i int, hook configs.Hook) {
+			defer wg.Done()
+			hookErrs[i] = hook.Run(state)
+		}(i, hook)
+	}
+
+	wg.Wait()
+
+	var errs []error
+	if len(run) < len(hooks) {
+		errs = append(errs, grpcStatus.Errorf(codes.ResourceExhausted,
+			"%s hooks: %d of %d hooks run, limit of %d hooks reached", phase, len(run), len(hooks), hooksMax))
+	}
+
+	for _, err := range hookErrs {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := combineErrors(errs)
+	if err != nil && !end.IsZero() && !time.Now().Before(end) {
+		return grpcStatus.Errorf(codes.DeadlineExceeded,
+			"%s hooks: %d of %d hooks run, %s exceeded: %v", phase, len(run), len(hooks), limit, err)
+	}
+
+	return err
+}
+
+// boundHookTimeout bounds the timeout of a command hook to remaining.
+func boundHookTimeout(hook configs.Hook, remaining time.Duration) configs.Hook {
+	if h, ok := hook.(configs.CommandHook); ok && (h.Timeout == nil || *h.Timeout > remaining) {
+		h.Timeout = &remaining
+		return commandHook{h.Command}
+	}
+
+	return hook
+}
+
+// sortHooks returns hooks sorted by the name of the command they run, the
+// other hooks coming first.
+func sortHooks(hooks []configs.Hook) []configs.Hook {
+	name := func(hook configs.Hook) string {
+		if h, ok := hook.(configs.CommandHook); ok {
+			return filepath.Base(h.Path)
+		}
+		return ""
+	}
+
+	sorted := make([]configs.Hook, len(hooks))
+	copy(sorted, hooks)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return name(sorted[i]) < name(sorted[j])
+	})
+
+	return sorted
+}
+
+// setupHooksLimits replaces the prestart and poststart hooks of config with a
+// single hook running them through runHooks, if any limit is set or they are
+// not run in order. The prestart hooks, run while the container is created,
+// are bounded by the creation deadline too, if not zero. Such a hook is not
+// saved in the state of the container, so the poststop hooks, which may be
+// run by a restarted agent recovering the container, are only sorted.
+func setupHooksLimits(config *configs.Config, deadline time.Time) {
+	if config.Hooks == nil || (hooksMax == 0 && hooksTimeout == 0 && deadline.IsZero() && hooksPolicy == hooksPolicyOrdered) {
+		return
+	}
+
+	limit := func(phase string, hooks []configs.Hook, deadline time.Time) []configs.Hook {
+		if len(hooks) == 0 {
+			return hooks
+		}
+
+		return []configs.Hook{configs.NewFunctionHook(func(state *specs.State) error {
+			return runHooks(phase, hooks, state, deadline)
+		})}
+	}
+
+	config.Hooks.Prestart = limit("prestart", config.Hooks.Prestart, deadline)
+	config.Hooks.Poststart = limit("poststart", config.Hooks.Poststart, time.Time{})
+
+	if hooksPolicy == hooksPolicySorted {
+		config.Hooks.Poststop = sortHooks(config.Hooks.Poststop)
+	}
+}
+
+// commandHook runs a command hook like configs.CommandHook, which can wait
+// forever for the command it killed once its timeout expired, as it waits
+// for it twice.
+type commandHook struct {
+	configs.Command
+}
+
+func (c commandHook) Run(state *specs.State) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Cmd{
+		Path:   c.Path,
+		Args:   c.Args,
+		Env:    c.Env,
+		Stdin:  bytes.NewReader(b),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- cmd.Wait()
+	}()
+
+	var timeoutCh <-chan time.Time
+	if c.Timeout != nil {
+		timer := time.NewTimer(*c.Timeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("error running hook: %v, stdout: %s, stderr: %s", err, stdout.String(), stderr.String())
+		}
+		return nil
+	case <-timeoutCh:
+		cmd.Process.Kill()
+		<-errCh
+		return fmt.Errorf("hook ran past specified timeout of %.1fs", c.Timeout.Seconds())
+	}
+}
@@ -0,0 +1,440 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func setHooksLimits(max uint32, timeout time.Duration) func() {
+	savedMax, savedTimeout := hooksMax, hooksTimeout
+	hooksMax, hooksTimeout = max, timeout
+
+	return func() {
+		hooksMax, hooksTimeout = savedMax, savedTimeout
+	}
+}
+
+func setHooksPolicy(policy string) func() {
+	savedPolicy := hooksPolicy
+	hooksPolicy = policy
+
+	return func() {
+		hooksPolicy = savedPolicy
+	}
+}
+
+func TestRunHooksMax(t *testing.T) {
+	assert := assert.New(t)
+
+	var run int
+	var hooks []configs.Hook
+	for i := 0; i < 100; i++ {
+		hooks = append(hooks, configs.NewFunctionHook(func(*specs.State) error {
+			run++
+			return nil
+		}))
+	}
+
+	type testData struct {
+		max          uint32
+		expectedRun  int
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{0, 100, codes.OK},
+		{100, 100, codes.OK},
+		{200, 100, codes.OK},
+		{10, 10, codes.ResourceExhausted},
+		{1, 1, codes.ResourceExhausted},
+	}
+
+	for i, d := range data {
+		reset := setHooksLimits(d.max, 0)
+		run = 0
+
+		err := runHooks("prestart", hooks, &specs.State{}, time.Time{})
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.Equal(d.expectedRun, run, "test %d (%+v)", i, d)
+		if err != nil {
+			assert.Contains(err.Error(), "prestart hooks", "test %d (%+v)", i, d)
+			assert.Contains(err.Error(), "of 100 hooks run", "test %d (%+v)", i, d)
+		}
+
+		reset()
+	}
+}
+
+func TestRunHooksTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	reset := setHooksLimits(0, 500*time.Millisecond)
+	defer reset()
+
+	// Each hook takes 200ms, the third one is killed once the budget is
+	// exceeded and the others are not run.
+	var hooks []configs.Hook
+	for i := 0; i < 10; i++ {
+		hooks = append(hooks, configs.NewCommandHook(configs.Command{
+			Path: "/bin/sh",
+			Args: []string{"sh", "-c", "sleep 0.2"},
+		}))
+	}
+
+	start := time.Now()
+	err := runHooks("poststart", hooks, &specs.State{}, time.Time{})
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.Contains(err.Error(), "poststart hooks: 3 of 10 hooks run")
+	assert.True(time.Since(start) < 2*time.Second, "hooks took %s", time.Since(start))
+
+	// The hooks within the budget are all run.
+	err = runHooks("poststart", hooks[:2], &specs.State{}, time.Time{})
+	assert.NoError(err)
+
+	// A failing hook is reported as is.
+	hooks = []configs.Hook{configs.NewCommandHook(configs.Command{Path: "/bin/false", Args: []string{"false"}})}
+	err = runHooks("poststart", hooks, &specs.State{}, time.Time{})
+	assert.Error(err)
+	assert.Equal(codes.Unknown, grpcStatus.Code(err))
+}
+
+func TestRunHooksDeadline(t *testing.T) {
+	assert := assert.New(t)
+
+	var hooks []configs.Hook
+	for i := 0; i < 10; i++ {
+		hooks = append(hooks, configs.NewCommandHook(configs.Command{
+			Path: "/bin/sh",
+			Args: []string{"sh", "-c", "sleep 0.2"},
+		}))
+	}
+
+	start := time.Now()
+	err := runHooks("prestart", hooks, &specs.State{}, start.Add(300*time.Millisecond))
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.Contains(err.Error(), "prestart hooks: 2 of 10 hooks run, creation deadline exceeded")
+	assert.True(time.Since(start) < 2*time.Second, "hooks took %s", time.Since(start))
+
+	// The hooks budget applies when shorter than the deadline.
+	reset := setHooksLimits(0, 300*time.Millisecond)
+	defer reset()
+
+	err = runHooks("prestart", hooks, &specs.State{}, time.Now().Add(time.Hour))
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.Contains(err.Error(), "time budget of 300ms exceeded")
+
+	// An expired deadline runs no hook.
+	err = runHooks("prestart", hooks, &specs.State{}, time.Now().Add(-time.Second))
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.Contains(err.Error(), "0 of 10 hooks run")
+}
+
+func TestRunHooksSorted(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "hooks")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	output := filepath.Join(tmpDir, "output")
+
+	// Each hook appends its name to the output, the "fail" ones failing.
+	newHook := func(name string) configs.Hook {
+		path := filepath.Join(tmpDir, name)
+		script := fmt.Sprintf("#!/bin/sh\necho %s >> %s\n", name, output)
+		if strings.HasSuffix(name, "fail") {
+			script += "exit 1\n"
+		}
+
+		err := ioutil.WriteFile(path, []byte(script), 0755)
+		assert.NoError(err)
+
+		return configs.NewCommandHook(configs.Command{Path: path, Args: []string{name}})
+	}
+
+	type testData struct {
+		policy        string
+		hooks         []string
+		expectError   bool
+		expectedOrder string
+	}
+
+	data := []testData{
+		{hooksPolicyOrdered, []string{"20-b", "10-a", "30-c"}, false, "20-b 10-a 30-c"},
+		{hooksPolicySorted, []string{"20-b", "10-a", "30-c"}, false, "10-a 20-b 30-c"},
+		{hooksPolicySorted, []string{"30-c", "20-b", "10-a", "05-z"}, false, "05-z 10-a 20-b 30-c"},
+		// The first failure stops the phase.
+		{hooksPolicySorted, []string{"30-c", "20-fail", "10-a"}, true, "10-a 20-fail"},
+		{hooksPolicyOrdered, []string{"30-c", "20-fail", "10-a"}, true, "30-c 20-fail"},
+	}
+
+	for i, d := range data {
+		reset := setHooksPolicy(d.policy)
+
+		var hooks []configs.Hook
+		for _, name := range d.hooks {
+			hooks = append(hooks, newHook(name))
+		}
+
+		os.Remove(output)
+
+		err := runHooks("prestart", hooks, &specs.State{}, time.Time{})
+		assert.Equal(d.expectError, err != nil, "test %d (%+v): %v", i, d, err)
+
+		content, err := ioutil.ReadFile(output)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedOrder, strings.Join(strings.Fields(string(content)), " "), "test %d (%+v)", i, d)
+
+		reset()
+	}
+
+	// The hooks are left in the order of the spec.
+	hooks := []configs.Hook{newHook("b"), newHook("a")}
+	sorted := sortHooks(hooks)
+	assert.Equal("b", hooks[0].(configs.CommandHook).Args[0])
+	assert.Equal("a", sorted[0].(configs.CommandHook).Args[0])
+}
+
+func TestRunHooksConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	reset := setHooksPolicy(hooksPolicyConcurrent)
+	defer reset()
+
+	// Every hook waits for all the others to have started, which can only
+	// happen when they run in parallel.
+	const count = 5
+	var arrived sync.WaitGroup
+	arrived.Add(count)
+	allArrived := make(chan struct{})
+	go func() {
+		arrived.Wait()
+		close(allArrived)
+	}()
+
+	var hooks []configs.Hook
+	for i := 0; i < count; i++ {
+		hooks = append(hooks, configs.NewFunctionHook(func(*specs.State) error {
+			arrived.Done()
+			select {
+			case <-allArrived:
+				return nil
+			case <-time.After(5 * time.Second):
+				return errors.New("hooks not run concurrently")
+			}
+		}))
+	}
+
+	err := runHooks("poststart", hooks, &specs.State{}, time.Time{})
+	assert.NoError(err)
+
+	// Command hooks taking 300ms each complete in about 300ms.
+	hooks = nil
+	for i := 0; i < count; i++ {
+		hooks = append(hooks, configs.NewCommandHook(configs.Command{
+			Path: "/bin/sh",
+			Args: []string{"sh", "-c", "sleep 0.3"},
+		}))
+	}
+
+	start := time.Now()
+	err = runHooks("poststart", hooks, &specs.State{}, time.Time{})
+	assert.NoError(err)
+	assert.True(time.Since(start) < 1200*time.Millisecond, "hooks took %s", time.Since(start))
+}
+
+func TestRunHooksConcurrentFailures(t *testing.T) {
+	assert := assert.New(t)
+
+	reset := setHooksPolicy(hooksPolicyConcurrent)
+	defer reset()
+
+	var run int32
+	newHook := func(fail bool, msg string) configs.Hook {
+		return configs.NewFunctionHook(func(*specs.State) error {
+			atomic.AddInt32(&run, 1)
+			if fail {
+				return errors.New(msg)
+			}
+			return nil
+		})
+	}
+
+	// All the hooks are run and all the failures reported.
+	hooks := []configs.Hook{
+		newHook(true, "first hook failed"),
+		newHook(false, ""),
+		newHook(true, "third hook failed"),
+		newHook(true, "fourth hook failed"),
+	}
+
+	err := runHooks("poststop", hooks, &specs.State{}, time.Time{})
+	assert.Error(err)
+	assert.Equal(int32(4), atomic.LoadInt32(&run))
+	for _, msg := range []string{"first hook failed", "third hook failed", "fourth hook failed"} {
+		assert.Contains(err.Error(), msg)
+	}
+
+	// Only the first hooksMax hooks are run.
+	resetLimits := setHooksLimits(2, 0)
+
+	atomic.StoreInt32(&run, 0)
+	err = runHooks("poststop", hooks[1:], &specs.State{}, time.Time{})
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+	assert.Contains(err.Error(), "poststop hooks: 2 of 3 hooks run")
+	assert.Contains(err.Error(), "third hook failed")
+	assert.Equal(int32(2), atomic.LoadInt32(&run))
+
+	resetLimits()
+
+	// The hooks still running at the end of the budget are all killed.
+	resetLimits = setHooksLimits(0, 300*time.Millisecond)
+	defer resetLimits()
+
+	hooks = nil
+	for i := 0; i < 3; i++ {
+		hooks = append(hooks, configs.NewCommandHook(configs.Command{
+			Path: "/bin/sleep",
+			Args: []string{"sleep", "5"},
+		}))
+	}
+
+	start := time.Now()
+	err = runHooks("poststop", hooks, &specs.State{}, time.Time{})
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.Contains(err.Error(), "poststop hooks: 3 of 3 hooks run, time budget of 300ms exceeded")
+	assert.True(time.Since(start) < 2*time.Second, "hooks took %s", time.Since(start))
+
+	err = runHooks("prestart", hooks, &specs.State{}, time.Now().Add(-time.Second))
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.Contains(err.Error(), "0 of 3 hooks run")
+}
+
+func TestSetupHooksLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	var run int
+	hook := configs.NewFunctionHook(func(*specs.State) error {
+		run++
+		return nil
+	})
+
+	newConfig := func() *configs.Config {
+		return &configs.Config{Hooks: &configs.Hooks{
+			Prestart: []configs.Hook{hook, hook, hook},
+			Poststop: []configs.Hook{hook},
+		}}
+	}
+
+	// Nothing changes without limits.
+	config := newConfig()
+	setupHooksLimits(config, time.Time{})
+	assert.Len(config.Hooks.Prestart, 3)
+	assert.Len(config.Hooks.Poststop, 1)
+
+	config = &configs.Config{}
+	setupHooksLimits(config, time.Time{})
+	assert.Nil(config.Hooks)
+
+	reset := setHooksLimits(2, 0)
+	defer reset()
+
+	config = newConfig()
+	setupHooksLimits(config, time.Time{})
+	assert.Len(config.Hooks.Prestart, 1)
+	assert.Empty(config.Hooks.Poststart)
+	assert.Len(config.Hooks.Poststop, 1)
+
+	err := config.Hooks.Prestart[0].Run(&specs.State{})
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+	assert.Equal(2, run)
+
+	run = 0
+	err = config.Hooks.Poststop[0].Run(&specs.State{})
+	assert.NoError(err)
+	assert.Equal(1, run)
+
+	// Only the prestart hooks are bounded by the creation deadline.
+	reset()
+
+	config = newConfig()
+	setupHooksLimits(config, time.Now().Add(-time.Second))
+	assert.Len(config.Hooks.Prestart, 1)
+	assert.Len(config.Hooks.Poststop, 1)
+
+	run = 0
+	err = config.Hooks.Prestart[0].Run(&specs.State{})
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.Equal(0, run)
+
+	err = config.Hooks.Poststop[0].Run(&specs.State{})
+	assert.NoError(err)
+	assert.Equal(1, run)
+
+	// The hooks are run through runHooks when not run in order.
+	resetPolicy := setHooksPolicy(hooksPolicySorted)
+	defer resetPolicy()
+
+	config = newConfig()
+	setupHooksLimits(config, time.Time{})
+	assert.Len(config.Hooks.Prestart, 1)
+	assert.Len(config.Hooks.Poststop, 1)
+
+	run = 0
+	err = config.Hooks.Prestart[0].Run(&specs.State{})
+	assert.NoError(err)
+	assert.Equal(3, run)
+}
+
+func TestSetupHooksLimitsPoststop(t *testing.T) {
+	assert := assert.New(t)
+
+	reset := setHooksLimits(1, time.Minute)
+	defer reset()
+
+	resetPolicy := setHooksPolicy(hooksPolicySorted)
+	defer resetPolicy()
+
+	hook := func(path string) configs.Hook {
+		return configs.NewCommandHook(configs.Command{Path: path})
+	}
+
+	config := &configs.Config{Hooks: &configs.Hooks{
+		Prestart: []configs.Hook{hook("/hooks/20-b"), hook("/hooks/10-a")},
+		Poststop: []configs.Hook{hook("/hooks/20-b"), hook("/hooks/10-a")},
+	}}
+	setupHooksLimits(config, time.Now().Add(time.Minute))
+
+	// The poststop hooks are saved in the state of the container, unlike
+	// the hook running the prestart hooks.
+	data, err := json.Marshal(config.Hooks)
+	assert.NoError(err)
+
+	var hooks configs.Hooks
+	err = json.Unmarshal(data, &hooks)
+	assert.NoError(err)
+
+	assert.Empty(hooks.Prestart)
+	assert.Equal([]configs.Hook{hook("/hooks/10-a"), hook("/hooks/20-b")}, hooks.Poststop)
+}
@@ -0,0 +1,216 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Time left to the host to cancel the teardown of an idle sandbox, once the
+// idle shutdown event has been published.
+var idleShutdownGrace = 30 * time.Second
+
+// Interval at which the idleness of the sandbox is checked.
+var idleCheckInterval = time.Second
+
+// idleWatcher tracks the unary gRPC calls, in order to tear the sandbox down
+// once it has had no containers and no calls in progress for a while.
+type idleWatcher struct {
+	sync.Mutex
+
+	timeout time.Duration
+	// isIdle tells whether the sandbox has no containers. It is called
+	// with the watcher locked.
+	isIdle func() bool
+
+	// calls in progress
+	active int
+	// start or end of the last call
+	lastActivity time.Time
+	// set while the sandbox is torn down, the calls being rejected
+	closing bool
+}
+
+func newIdleWatcher(timeout time.Duration, isIdle func() bool) *idleWatcher {
+	return &idleWatcher{
+		timeout:      timeout,
+		isIdle:       isIdle,
+		lastActivity: time.Now(),
+	}
+}
+
+// enter records the start of a call, rejected while the sandbox is torn
+// down.
+func (w *idleWatcher) enter() error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closing {
+		return grpcStatus.Error(codes.Unavailable, "Sandbox is being shut down after being idle")
+	}
+
+	w.active++
+	w.lastActivity = time.Now()
+
+	return nil
+}
+
+// exit records the end of a call.
+func (w *idleWatcher) exit() {
+	w.Lock()
+	defer w.Unlock()
+
+	w.active--
+	w.lastActivity = time.Now()
+}
+
+// interceptor wraps next, tracking the calls it handles. The streams are not
+// tracked, so that a host waiting for events does not keep the sandbox
+// alive.
+func (w *idleWatcher) interceptor(next grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := w.enter(); err != nil {
+			return nil, err
+		}
+		defer w.exit()
+
+		return next(ctx, req, info, handler)
+	}
+}
+
+// idleSince returns since when the sandbox is idle, or false if it is not.
+func (w *idleWatcher) idleSince() (time.Time, bool) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.active > 0 || !w.isIdle() {
+		return time.Time{}, false
+	}
+
+	return w.lastActivity, true
+}
+
+// wait returns false if stop is closed before d has elapsed.
+func (w *idleWatcher) wait(d time.Duration, stop <-chan struct{}) bool {
+	if d > idleCheckInterval {
+		d = idleCheckInterval
+	}
+
+	select {
+	case <-stop:
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// countdown calls notify, then waits for idleShutdownGrace, and returns
+// whether the sandbox has stayed idle meanwhile. The calls are rejected from
+// then on, until the closing flag is cleared.
+func (w *idleWatcher) countdown(stop <-chan struct{}, notify func()) bool {
+	notified := time.Now()
+	notify()
+
+	deadline := notified.Add(idleShutdownGrace)
+
+	for {
+		since, idle := w.idleSince()
+		if !idle || since.After(notified) {
+			agentLog.Info("Idle sandbox shutdown cancelled")
+			return false
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+
+		if !w.wait(remaining, stop) {
+			return false
+		}
+	}
+
+	// Nothing may have started since the last check, a container being
+	// created through a call.
+	w.Lock()
+	defer w.Unlock()
+
+	if w.active > 0 || !w.isIdle() || w.lastActivity.After(notified) {
+		agentLog.Info("Idle sandbox shutdown cancelled")
+		return false
+	}
+
+	w.closing = true
+
+	return true
+}
+
+// run calls notify once the sandbox has been idle for the watcher timeout,
+// then shutdown if it is still idle idleShutdownGrace later, until stop is
+// closed.
+func (w *idleWatcher) run(stop <-chan struct{}, notify, shutdown func()) {
+	for {
+		wait := w.timeout
+
+		if since, idle := w.idleSince(); idle {
+			wait = time.Until(since.Add(w.timeout))
+
+			if wait <= 0 {
+				if w.countdown(stop, notify) {
+					agentLog.WithField("idle-timeout", w.timeout).Info("Shutting down idle sandbox")
+					shutdown()
+
+					w.Lock()
+					w.closing = false
+					w.lastActivity = time.Now()
+					w.Unlock()
+				}
+
+				continue
+			}
+		}
+
+		if !w.wait(wait, stop) {
+			return
+		}
+	}
+}
+
+// isIdle returns whether the sandbox is started and has no containers.
+func (s *sandbox) isIdle() bool {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.running && len(s.containers) == 0
+}
+
+// watchIdle tears the sandbox down with GracefulShutdown once it has been
+// idle for idleTimeout, publishing an idle shutdown event first.
+func (s *sandbox) watchIdle(a *agentGRPC, w *idleWatcher) {
+	notify := func() {
+		agentLog.WithField("grace", idleShutdownGrace).Info("Sandbox idle, shutting it down unless a call is received")
+		s.events.publish("", eventIdleShutdown, 0)
+	}
+
+	shutdown := func() {
+		resp, err := a.GracefulShutdown(context.Background(), &pb.GracefulShutdownRequest{})
+		if err != nil {
+			agentLog.WithError(err).Error("Idle sandbox shutdown failed")
+		} else if len(resp.Failures) > 0 {
+			agentLog.WithField("failures", resp.Failures).Error("Idle sandbox shutdown incomplete")
+		}
+	}
+
+	w.run(s.stopServer, notify, shutdown)
+}
@@ -0,0 +1,147 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const testIdleTimeout = 50 * time.Millisecond
+
+func setupIdleTest() func() {
+	savedGrace, savedInterval := idleShutdownGrace, idleCheckInterval
+	idleShutdownGrace, idleCheckInterval = testIdleTimeout, time.Millisecond
+
+	return func() {
+		idleShutdownGrace, idleCheckInterval = savedGrace, savedInterval
+	}
+}
+
+func TestIdleWatcher(t *testing.T) {
+	assert := assert.New(t)
+
+	defer setupIdleTest()()
+
+	// The sandbox state is only changed with the watcher locked, as done
+	// by the calls.
+	idle := true
+	w := newIdleWatcher(testIdleTimeout, func() bool {
+		return idle
+	})
+	setIdle := func(value bool) {
+		w.Lock()
+		idle = value
+		w.Unlock()
+	}
+
+	notified := make(chan struct{}, 10)
+	shutdowns := make(chan error, 10)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		w.run(stop, func() {
+			notified <- struct{}{}
+		}, func() {
+			// The calls are rejected during the shutdown.
+			shutdowns <- w.enter()
+		})
+		close(done)
+	}()
+
+	waitNotified := func() bool {
+		select {
+		case <-notified:
+			return true
+		case <-time.After(time.Second):
+			return false
+		}
+	}
+
+	// The countdown is reset by every call.
+	for i := 0; i < 10; i++ {
+		assert.NoError(w.enter())
+		w.exit()
+		time.Sleep(testIdleTimeout / 5)
+	}
+	assert.Empty(notified)
+
+	// A long call keeps the sandbox busy.
+	assert.NoError(w.enter())
+	time.Sleep(2 * testIdleTimeout)
+	assert.Empty(notified)
+	w.exit()
+
+	// A call received after the notification cancels the shutdown.
+	assert.True(waitNotified())
+	assert.NoError(w.enter())
+	w.exit()
+
+	// So does a container created meanwhile.
+	assert.True(waitNotified())
+	setIdle(false)
+	time.Sleep(2 * idleShutdownGrace)
+	assert.Empty(notified)
+	assert.Empty(shutdowns)
+
+	// The sandbox is shut down after being idle for the whole grace
+	// period.
+	setIdle(true)
+	assert.True(waitNotified())
+
+	select {
+	case err := <-shutdowns:
+		assert.Equal(codes.Unavailable, grpcStatus.Code(err))
+	case <-time.After(time.Second):
+		assert.Fail("idle sandbox not shut down")
+	}
+
+	// The calls are accepted again once the shutdown is over.
+	setIdle(false)
+	assert.NoError(w.enter())
+	w.exit()
+
+	close(stop)
+	<-done
+}
+
+func TestIdleWatcherInterceptor(t *testing.T) {
+	assert := assert.New(t)
+
+	w := newIdleWatcher(testIdleTimeout, func() bool {
+		return true
+	})
+
+	next := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		_, idle := w.idleSince()
+		assert.False(idle)
+		return handler(ctx, req)
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "resp", nil
+	}
+
+	resp, err := w.interceptor(next)(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(err)
+	assert.Equal("resp", resp)
+
+	since, idle := w.idleSince()
+	assert.True(idle)
+	assert.WithinDuration(time.Now(), since, time.Second)
+
+	w.closing = true
+	_, err = w.interceptor(next)(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+	assert.Equal(codes.Unavailable, grpcStatus.Code(err))
+}
@@ -0,0 +1,115 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Directory of the process information, changed by tests.
+var procDir = "/proc"
+
+// parseProcStatus parses the content of a /proc/<pid>/status file.
+func parseProcStatus(pid int, path string) (*pb.InitStatus, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	status := &pb.InitStatus{
+		Pid:    uint32(pid),
+		Fields: make(map[string]string),
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// Threads:	1
+		fields := strings.SplitN(line, ":", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		key, value := fields[0], strings.TrimSpace(fields[1])
+		status.Fields[key] = value
+
+		var v uint64
+
+		switch key {
+		case "State":
+			status.State = value
+		case "Threads":
+			v, err = strconv.ParseUint(value, 10, 32)
+			status.Threads = uint32(v)
+		case "VmRSS":
+			// VmRSS:	    1024 kB
+			v, err = strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(value, "kB")), 10, 64)
+			status.VmRss = v * 1024
+		case "Cpus_allowed_list":
+			status.CpusAllowedList = value
+		case "Seccomp":
+			v, err = strconv.ParseUint(value, 10, 32)
+			status.Seccomp = uint32(v)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in line %q of %s: %v", line, path, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// getInitStatus returns the status of the init process of ctr, which must
+// still be running.
+func getInitStatus(ctr *container) (*pb.InitStatus, error) {
+	notFound := grpcStatus.Errorf(codes.NotFound, "Init process of container %s has exited", ctr.id)
+
+	if ctr.initProcess == nil {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Container %s has no init process", ctr.id)
+	}
+
+	// The PID of an init process which has exited may have been reused.
+	status, err := ctr.container.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	if status == libcontainer.Stopped {
+		return nil, notFound
+	}
+
+	pid, err := ctr.initProcess.pid()
+	if err != nil {
+		return nil, notFound
+	}
+
+	initStatus, err := parseProcStatus(pid, filepath.Join(procDir, strconv.Itoa(pid), "status"))
+	if os.IsNotExist(err) {
+		return nil, notFound
+	} else if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not get status of init process of container %s: %v", ctr.id, err)
+	}
+
+	return initStatus, nil
+}
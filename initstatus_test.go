@@ -0,0 +1,149 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const testProcStatus = `Name:	sleep
+Umask:	0022
+State:	S (sleeping)
+Tgid:	1234
+Pid:	1234
+Threads:	3
+VmRSS:	    2048 kB
+SigBlk:	0000000000000000
+Seccomp:	2
+Cpus_allowed:	f
+Cpus_allowed_list:	0-3
+`
+
+func TestParseProcStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "proc-status")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	type testData struct {
+		content        string
+		expectError    bool
+		expectedStatus *pb.InitStatus
+	}
+
+	data := []testData{
+		{testProcStatus, false, &pb.InitStatus{
+			Pid:             1234,
+			State:           "S (sleeping)",
+			Threads:         3,
+			VmRss:           2048 * 1024,
+			CpusAllowedList: "0-3",
+			Seccomp:         2,
+		}},
+		// A kernel thread has no memory fields.
+		{"State:	I (idle)\nThreads:	1\n", false, &pb.InitStatus{Pid: 1234, State: "I (idle)", Threads: 1}},
+		{"Threads:	many\n", true, nil},
+		{"VmRSS:	-1 kB\n", true, nil},
+	}
+
+	path := filepath.Join(tmpDir, "status")
+
+	for i, d := range data {
+		err := ioutil.WriteFile(path, []byte(d.content), 0644)
+		assert.NoError(err)
+
+		status, err := parseProcStatus(1234, path)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		// The raw fields are all kept.
+		assert.NotEmpty(status.Fields, "test %d (%+v)", i, d)
+		status.Fields = nil
+		assert.Equal(d.expectedStatus, status, "test %d (%+v)", i, d)
+	}
+
+	status, err := parseProcStatus(1234, path+".missing")
+	assert.True(os.IsNotExist(err))
+	assert.Nil(status)
+}
+
+func TestGetInitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "proc")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedProcDir := procDir
+	procDir = tmpDir
+	defer func() {
+		procDir = savedProcDir
+	}()
+
+	err = os.MkdirAll(filepath.Join(tmpDir, "1234"), 0755)
+	assert.NoError(err)
+	err = ioutil.WriteFile(filepath.Join(tmpDir, "1234", "status"), []byte(testProcStatus), 0644)
+	assert.NoError(err)
+
+	type testData struct {
+		initProcess  *process
+		status       libcontainer.Status
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{&process{recoveredPid: 1234}, libcontainer.Running, codes.OK},
+		{&process{recoveredPid: 1234}, libcontainer.Paused, codes.OK},
+		// The init process has exited, its PID may have been reused.
+		{&process{recoveredPid: 1234}, libcontainer.Stopped, codes.NotFound},
+		{&process{recoveredPid: 5678}, libcontainer.Running, codes.NotFound},
+		{nil, libcontainer.Running, codes.FailedPrecondition},
+	}
+
+	for i, d := range data {
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{
+					"ctr": {
+						id:          "ctr",
+						initProcess: d.initProcess,
+						container:   &mockContainer{status: d.status},
+					},
+				},
+			},
+		}
+
+		status, err := a.GetInitStatus(context.Background(), &pb.GetInitStatusRequest{ContainerId: "ctr"})
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		if d.expectedCode != codes.OK {
+			continue
+		}
+
+		assert.Equal(uint32(1234), status.Pid, "test %d (%+v)", i, d)
+		assert.Equal("S (sleeping)", status.State, "test %d (%+v)", i, d)
+		assert.Equal("0000000000000000", status.Fields["SigBlk"], "test %d (%+v)", i, d)
+	}
+
+	a := &agentGRPC{sandbox: &sandbox{containers: make(map[string]*container)}}
+	_, err = a.GetInitStatus(context.Background(), &pb.GetInitStatusRequest{ContainerId: "missing"})
+	assert.Error(err)
+}
@@ -0,0 +1,181 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	listDirDefaultPageSize = 256
+	listDirMaxPageSize     = 4096
+
+	// Number of names read from the directory at once.
+	listDirReadBatch = 1024
+)
+
+// set function in variable to overwrite for testing.
+var getContainerRoot = getContainerRootImpl
+
+// getContainerRootImpl returns a path giving access to the root of the
+// container mount namespace, including the volumes mounted into it.
+func getContainerRootImpl(ctr *container) (string, error) {
+	if ctr.initProcess == nil {
+		return "", grpcStatus.Errorf(codes.FailedPrecondition, "Container %s has no init process", ctr.id)
+	}
+
+	pid, err := ctr.initProcess.pid()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("/proc/%d/root", pid), nil
+}
+
+func dirEntryType(mode os.FileMode) string {
+	switch {
+	case mode.IsDir():
+		return "directory"
+	case mode&os.ModeSymlink != 0:
+		return "symlink"
+	case mode&os.ModeNamedPipe != 0:
+		return "fifo"
+	case mode&os.ModeSocket != 0:
+		return "socket"
+	case mode&os.ModeCharDevice != 0:
+		return "char-device"
+	case mode&os.ModeDevice != 0:
+		return "block-device"
+	default:
+		return "file"
+	}
+}
+
+// readDirPage returns, in order, at most pageSize names from dir coming after
+// the name after, and whether more names follow. The directory is read in
+// batches so that only about two pages of names are held in memory.
+func readDirPage(dir *os.File, after string, pageSize int) ([]string, bool, error) {
+	var names []string
+	more := false
+
+	trim := func() {
+		sort.Strings(names)
+		if len(names) > pageSize {
+			names = names[:pageSize]
+			more = true
+		}
+	}
+
+	for {
+		batch, err := dir.Readdirnames(listDirReadBatch)
+		for _, name := range batch {
+			if name > after {
+				names = append(names, name)
+			}
+		}
+
+		if len(names) > 2*pageSize {
+			trim()
+		}
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	trim()
+
+	return names, more, nil
+}
+
+// listDir lists a page of the directory path of the container ctr.
+func listDir(ctr *container, req *pb.ListDirRequest) (*pb.ListDirResponse, error) {
+	if !filepath.IsAbs(req.Path) {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Path %q must be absolute", req.Path)
+	}
+
+	for _, elem := range strings.Split(req.Path, "/") {
+		if elem == ".." {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Path %q escapes the container root", req.Path)
+		}
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = listDirDefaultPageSize
+	} else if pageSize > listDirMaxPageSize {
+		pageSize = listDirMaxPageSize
+	}
+
+	after, err := base64.RawURLEncoding.DecodeString(req.ContinuationToken)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid continuation token %q", req.ContinuationToken)
+	}
+
+	root, err := getContainerRoot(ctr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Symlinks are resolved inside the container root.
+	dirPath, err := securejoin.SecureJoin(root, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.Open(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+
+	names, more, err := readDirPage(dir, string(after), pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListDirResponse{}
+
+	for _, name := range names {
+		fi, err := os.Lstat(filepath.Join(dirPath, name))
+		if os.IsNotExist(err) {
+			// Removed while listing.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Entries = append(resp.Entries, &pb.DirEntry{
+			Name:     name,
+			Type:     dirEntryType(fi.Mode()),
+			FileSize: uint64(fi.Size()),
+			Mode:     uint32(fi.Mode().Perm()),
+			Mtime:    fi.ModTime().Unix(),
+		})
+	}
+
+	if more && len(names) > 0 {
+		resp.ContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(names[len(names)-1]))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,201 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func setupListDir(t *testing.T) (string, *agentGRPC, func()) {
+	root, err := ioutil.TempDir("", "listdir")
+	assert.NoError(t, err)
+
+	savedGetContainerRoot := getContainerRoot
+	getContainerRoot = func(ctr *container) (string, error) {
+		return root, nil
+	}
+
+	cleanup := func() {
+		getContainerRoot = savedGetContainerRoot
+		os.RemoveAll(root)
+	}
+
+	s := &sandbox{
+		containers: map[string]*container{
+			testContainerID: {id: testContainerID},
+		},
+	}
+
+	return root, &agentGRPC{sandbox: s}, cleanup
+}
+
+func TestListDirPagination(t *testing.T) {
+	assert := assert.New(t)
+
+	root, a, cleanup := setupListDir(t)
+	defer cleanup()
+
+	dir := filepath.Join(root, "data")
+	err := os.Mkdir(dir, testDirMode)
+	assert.NoError(err)
+
+	// More entries than a read batch, to make sure batches are merged
+	// in order.
+	var expected []string
+	for i := 0; i < 2*listDirReadBatch+7; i++ {
+		name := fmt.Sprintf("file-%05d", (i*7919)%(2*listDirReadBatch+7))
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte("x"), testFileMode)
+		assert.NoError(err)
+		expected = append(expected, name)
+	}
+	sort.Strings(expected)
+
+	pageSize := 100
+	var listed []string
+	token := ""
+	calls := 0
+
+	for {
+		resp, err := a.ListDir(context.Background(), &pb.ListDirRequest{
+			ContainerId:       testContainerID,
+			Path:              "/data",
+			PageSize:          uint32(pageSize),
+			ContinuationToken: token,
+		})
+		assert.NoError(err)
+		calls++
+
+		assert.True(len(resp.Entries) <= pageSize)
+		for _, entry := range resp.Entries {
+			assert.Equal("file", entry.Type)
+			assert.Equal(uint64(1), entry.FileSize)
+			listed = append(listed, entry.Name)
+		}
+
+		if resp.ContinuationToken == "" {
+			break
+		}
+		assert.Len(resp.Entries, pageSize)
+		token = resp.ContinuationToken
+	}
+
+	assert.Equal(expected, listed)
+	assert.Equal((len(expected)+pageSize-1)/pageSize, calls)
+}
+
+func TestListDirEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	root, a, cleanup := setupListDir(t)
+	defer cleanup()
+
+	err := os.Mkdir(filepath.Join(root, "dir"), 0750)
+	assert.NoError(err)
+	err = ioutil.WriteFile(filepath.Join(root, "file"), []byte("hello"), 0640)
+	assert.NoError(err)
+	err = os.Symlink("file", filepath.Join(root, "link"))
+	assert.NoError(err)
+
+	resp, err := a.ListDir(context.Background(), &pb.ListDirRequest{
+		ContainerId: testContainerID,
+		Path:        "/",
+	})
+	assert.NoError(err)
+	assert.Empty(resp.ContinuationToken)
+	assert.Len(resp.Entries, 3)
+
+	assert.Equal("dir", resp.Entries[0].Name)
+	assert.Equal("directory", resp.Entries[0].Type)
+	assert.Equal(uint32(0750), resp.Entries[0].Mode)
+	assert.Equal("file", resp.Entries[1].Name)
+	assert.Equal("file", resp.Entries[1].Type)
+	assert.Equal(uint64(5), resp.Entries[1].FileSize)
+	assert.Equal(uint32(0640), resp.Entries[1].Mode)
+	assert.NotZero(resp.Entries[1].Mtime)
+	assert.Equal("link", resp.Entries[2].Name)
+	assert.Equal("symlink", resp.Entries[2].Type)
+
+	// A page exactly as large as the directory has no continuation.
+	resp, err = a.ListDir(context.Background(), &pb.ListDirRequest{
+		ContainerId: testContainerID,
+		Path:        "/",
+		PageSize:    3,
+	})
+	assert.NoError(err)
+	assert.Len(resp.Entries, 3)
+	assert.Empty(resp.ContinuationToken)
+
+	resp, err = a.ListDir(context.Background(), &pb.ListDirRequest{
+		ContainerId: testContainerID,
+		Path:        "/",
+		PageSize:    2,
+	})
+	assert.NoError(err)
+	assert.Len(resp.Entries, 2)
+	assert.NotEmpty(resp.ContinuationToken)
+
+	resp, err = a.ListDir(context.Background(), &pb.ListDirRequest{
+		ContainerId:       testContainerID,
+		Path:              "/",
+		PageSize:          2,
+		ContinuationToken: resp.ContinuationToken,
+	})
+	assert.NoError(err)
+	assert.Len(resp.Entries, 1)
+	assert.Equal("link", resp.Entries[0].Name)
+	assert.Empty(resp.ContinuationToken)
+}
+
+func TestListDirConfined(t *testing.T) {
+	assert := assert.New(t)
+
+	root, a, cleanup := setupListDir(t)
+	defer cleanup()
+
+	outside, err := ioutil.TempDir("", "listdir-outside")
+	assert.NoError(err)
+	defer os.RemoveAll(outside)
+
+	err = ioutil.WriteFile(filepath.Join(outside, "secret"), nil, testFileMode)
+	assert.NoError(err)
+
+	// An absolute symlink is resolved inside the container root.
+	err = os.Symlink(outside, filepath.Join(root, "escape"))
+	assert.NoError(err)
+
+	_, err = a.ListDir(context.Background(), &pb.ListDirRequest{
+		ContainerId: testContainerID,
+		Path:        "/escape",
+	})
+	assert.Error(err)
+	assert.Equal(codes.NotFound, grpcStatus.Code(toGRPCError(err)))
+
+	invalid := []pb.ListDirRequest{
+		{ContainerId: testContainerID, Path: "/../" + filepath.Base(outside)},
+		{ContainerId: testContainerID, Path: "relative"},
+		{ContainerId: testContainerID, Path: "/", ContinuationToken: "!!"},
+	}
+
+	for i, req := range invalid {
+		_, err = a.ListDir(context.Background(), &req)
+		assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test[%d]: %+v", i, req)
+	}
+
+	_, err = a.ListDir(context.Background(), &pb.ListDirRequest{ContainerId: "unknown", Path: "/"})
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+}
@@ -0,0 +1,146 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Default number of log lines kept in memory.
+const defaultLogBufferSize = 1000
+
+// logBuffer retains the most recent agent logs, so that they can be
+// retrieved through GetLogs even if the console output has been lost.
+var logBuffer = newLogRingBuffer(defaultLogBufferSize)
+
+type logBufferEntry struct {
+	level logrus.Level
+	line  string
+}
+
+// logRingBuffer is a logrus hook keeping the last formatted log lines.
+type logRingBuffer struct {
+	sync.Mutex
+
+	formatter logrus.Formatter
+	entries   []logBufferEntry
+	// index of the oldest entry
+	start int
+	count int
+}
+
+func newLogRingBuffer(size int) *logRingBuffer {
+	return &logRingBuffer{
+		formatter: &logrus.TextFormatter{DisableColors: true, TimestampFormat: time.RFC3339Nano},
+		entries:   make([]logBufferEntry, size),
+	}
+}
+
+// resize changes the capacity of the buffer, keeping the most recent lines.
+func (b *logRingBuffer) resize(size int) {
+	b.Lock()
+	defer b.Unlock()
+
+	entries := b.snapshotLocked()
+	if len(entries) > size {
+		entries = entries[len(entries)-size:]
+	}
+
+	b.entries = make([]logBufferEntry, size)
+	b.start = 0
+	b.count = copy(b.entries, entries)
+}
+
+// Levels implements logrus.Hook.
+func (b *logRingBuffer) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements logrus.Hook.
+func (b *logRingBuffer) Fire(entry *logrus.Entry) error {
+	serialized, err := b.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	size := len(b.entries)
+	if size == 0 {
+		return nil
+	}
+
+	e := logBufferEntry{
+		level: entry.Level,
+		line:  strings.TrimSuffix(string(serialized), "\n"),
+	}
+
+	if b.count < size {
+		b.entries[(b.start+b.count)%size] = e
+		b.count++
+	} else {
+		// Overwrite the oldest entry.
+		b.entries[b.start] = e
+		b.start = (b.start + 1) % size
+	}
+
+	return nil
+}
+
+func (b *logRingBuffer) snapshotLocked() []logBufferEntry {
+	entries := make([]logBufferEntry, 0, b.count)
+	for i := 0; i < b.count; i++ {
+		entries = append(entries, b.entries[(b.start+i)%len(b.entries)])
+	}
+
+	return entries
+}
+
+// lines returns, from the oldest to the most recent, the buffered lines
+// logged with a level at least as severe as level. Only the most recent
+// maxLines lines are returned if maxLines is not 0.
+func (b *logRingBuffer) lines(level logrus.Level, maxLines int) []string {
+	b.Lock()
+	entries := b.snapshotLocked()
+	b.Unlock()
+
+	var lines []string
+	for _, e := range entries {
+		// Lower levels are more severe.
+		if e.level <= level {
+			lines = append(lines, e.line)
+		}
+	}
+
+	if maxLines > 0 && len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+
+	return lines
+}
+
+// getLogs returns the buffered log lines matching the level name, all of
+// them being returned if it is empty.
+func getLogs(levelName string, maxLines uint32) ([]string, error) {
+	level := logrus.TraceLevel
+
+	if levelName != "" {
+		var err error
+		if level, err = logrus.ParseLevel(levelName); err != nil {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid log level %q", levelName)
+		}
+	}
+
+	return logBuffer.lines(level, int(maxLines)), nil
+}
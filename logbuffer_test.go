@@ -0,0 +1,158 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func newTestLogger(b *logRingBuffer) *logrus.Logger {
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+	logger.SetLevel(logrus.DebugLevel)
+	logger.AddHook(b)
+
+	return logger
+}
+
+func TestLogRingBufferKeepsMostRecent(t *testing.T) {
+	assert := assert.New(t)
+
+	size := 10
+	b := newLogRingBuffer(size)
+	logger := newTestLogger(b)
+
+	for i := 0; i < 3*size+5; i++ {
+		logger.Infof("line %d", i)
+	}
+
+	lines := b.lines(logrus.TraceLevel, 0)
+	assert.Len(lines, size)
+	for i, line := range lines {
+		assert.Contains(line, fmt.Sprintf(`msg="line %d"`, 2*size+5+i))
+		assert.NotContains(line, "\n")
+	}
+
+	lines = b.lines(logrus.TraceLevel, 3)
+	assert.Len(lines, 3)
+	assert.Contains(lines[2], fmt.Sprintf(`msg="line %d"`, 3*size+4))
+}
+
+func TestLogRingBufferLevelFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	b := newLogRingBuffer(100)
+	logger := newTestLogger(b)
+
+	logger.Debug("debug 1")
+	logger.Info("info 1")
+	logger.Warn("warn 1")
+	logger.Error("error 1")
+	logger.Info("info 2")
+	logger.Error("error 2")
+
+	lines := b.lines(logrus.WarnLevel, 0)
+	assert.Len(lines, 3)
+	assert.Contains(lines[0], "warn 1")
+	assert.Contains(lines[1], "error 1")
+	assert.Contains(lines[2], "error 2")
+
+	lines = b.lines(logrus.ErrorLevel, 1)
+	assert.Len(lines, 1)
+	assert.Contains(lines[0], "error 2")
+
+	assert.Len(b.lines(logrus.DebugLevel, 0), 6)
+	assert.Empty(b.lines(logrus.FatalLevel, 0))
+}
+
+func TestLogRingBufferResize(t *testing.T) {
+	assert := assert.New(t)
+
+	b := newLogRingBuffer(5)
+	logger := newTestLogger(b)
+
+	for i := 0; i < 7; i++ {
+		logger.Infof("line %d", i)
+	}
+
+	b.resize(3)
+	lines := b.lines(logrus.TraceLevel, 0)
+	assert.Len(lines, 3)
+	assert.Contains(lines[0], "line 4")
+
+	b.resize(10)
+	logger.Info("line 7")
+	lines = b.lines(logrus.TraceLevel, 0)
+	assert.Len(lines, 4)
+	assert.Contains(lines[3], "line 7")
+
+	b.resize(0)
+	logger.Info("dropped")
+	assert.Empty(b.lines(logrus.TraceLevel, 0))
+}
+
+func TestLogRingBufferConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	size := 50
+	b := newLogRingBuffer(size)
+	logger := newTestLogger(b)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				logger.Infof("goroutine %d line %d", n, j)
+				b.lines(logrus.InfoLevel, 0)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Len(b.lines(logrus.TraceLevel, 0), size)
+}
+
+func TestGetLogs(t *testing.T) {
+	assert := assert.New(t)
+
+	savedLogBuffer := logBuffer
+	defer func() {
+		logBuffer = savedLogBuffer
+	}()
+
+	logBuffer = newLogRingBuffer(10)
+	logger := newTestLogger(logBuffer)
+
+	logger.Info("hello")
+	logger.Warn("careful")
+
+	a := &agentGRPC{}
+
+	resp, err := a.GetLogs(context.Background(), &pb.GetLogsRequest{})
+	assert.NoError(err)
+	assert.Len(resp.Lines, 2)
+
+	resp, err = a.GetLogs(context.Background(), &pb.GetLogsRequest{Level: "warning"})
+	assert.NoError(err)
+	assert.Len(resp.Lines, 1)
+	assert.Contains(resp.Lines[0], "careful")
+
+	_, err = a.GetLogs(context.Background(), &pb.GetLogsRequest{Level: "loud"})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
@@ -0,0 +1,288 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	dropCachesSysctl    = "vm.drop_caches"
+	compactMemorySysctl = "vm.compact_memory"
+
+	// Free the page cache.
+	dropCachesPageCache = 1
+	// Free the reclaimable slab objects (dentries and inodes).
+	dropCachesSlab = 2
+	// Free both the page cache and the reclaimable slab objects.
+	dropCachesAll = 3
+
+	// cgroup v1 memory files
+	memoryUsageFile = "memory.usage_in_bytes"
+	memoryLimitFile = "memory.limit_in_bytes"
+	// cgroup v2 memory files
+	memoryCurrentFile = "memory.current"
+	memoryMaxFile     = "memory.max"
+	memoryStatFile    = "memory.stat"
+
+	// Limit reported by cgroup v1 when the memory is not limited, that is
+	// the largest page aligned signed 64 bits value.
+	memoryUnlimitedV1 = 0x7FFFFFFFFFFFF000
+)
+
+// set function in variable to overwrite for testing.
+var getMeminfo = getMeminfoImpl
+
+// getMeminfoImpl parses the kernel memory statistics file and returns all
+// the entries it contains. Values reported in kB are converted to bytes.
+func getMeminfoImpl() (map[string]uint64, error) {
+	f, err := os.Open(meminfo)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info := make(map[string]uint64)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// MemTotal:        2041248 kB
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		key := strings.TrimSuffix(fields[0], ":")
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in line %q: %v", line, err)
+		}
+
+		if len(fields) > 2 && fields[2] == "kB" {
+			value *= 1024
+		}
+
+		info[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// getMemFree returns the amount of free memory in bytes.
+func getMemFree() (uint64, error) {
+	info, err := getMeminfo()
+	if err != nil {
+		return 0, err
+	}
+
+	memFree, ok := info["MemFree"]
+	if !ok {
+		return 0, fmt.Errorf("no MemFree entry in %q", meminfo)
+	}
+
+	return memFree, nil
+}
+
+// dropCaches asks the kernel to drop clean caches according to mode, and
+// optionally to compact memory. It returns the difference between the free
+// memory measured after and before the operation.
+func dropCaches(mode uint32, compact bool) (int64, error) {
+	switch mode {
+	case dropCachesPageCache, dropCachesSlab, dropCachesAll:
+	default:
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid drop caches mode %d, expected %d, %d or %d",
+			mode, dropCachesPageCache, dropCachesSlab, dropCachesAll)
+	}
+
+	before, err := getMemFree()
+	if err != nil {
+		return 0, err
+	}
+
+	// Dirty pages cannot be dropped, make sure they are written first.
+	syscall.Sync()
+
+	if err := writeSystemProperty(dropCachesSysctl, strconv.FormatUint(uint64(mode), 10)); err != nil {
+		return 0, grpcStatus.Errorf(codes.Internal, "Could not drop caches: %v", err)
+	}
+
+	if compact {
+		if err := writeSystemProperty(compactMemorySysctl, "1"); err != nil {
+			return 0, grpcStatus.Errorf(codes.Internal, "Could not compact memory: %v", err)
+		}
+	}
+
+	after, err := getMemFree()
+	if err != nil {
+		return 0, err
+	}
+
+	freed := int64(after) - int64(before)
+
+	agentLog.WithFields(logrus.Fields{
+		"mode":           mode,
+		"compact-memory": compact,
+		"freed-bytes":    freed,
+	}).Info("dropped caches")
+
+	return freed, nil
+}
+
+// getGuestMemoryInfo returns the guest-wide memory statistics.
+func getGuestMemoryInfo() (*pb.GuestMemoryInfo, error) {
+	info, err := getMeminfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GuestMemoryInfo{
+		Total:     info["MemTotal"],
+		Free:      info["MemFree"],
+		Available: info["MemAvailable"],
+		Buffers:   info["Buffers"],
+		Cached:    info["Cached"],
+		SwapTotal: info["SwapTotal"],
+		SwapFree:  info["SwapFree"],
+	}, nil
+}
+
+func readCgroupUint(path string) (uint64, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	// "max" means unlimited on cgroup v2.
+	value := strings.TrimSpace(string(content))
+	if value == "max" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// readCgroupStat parses a "<key> <value>" per line cgroup statistics file.
+func readCgroupStat(path string) (map[string]uint64, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	stat := make(map[string]uint64)
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in line %q of %s: %v", line, path, err)
+		}
+		stat[fields[0]] = value
+	}
+
+	return stat, nil
+}
+
+// getCgroupMemoryInfo reads the memory statistics of the cgroup found at
+// cgroupsPath, relative to the memory hierarchy (v1) or to the unified
+// hierarchy (v2).
+func getCgroupMemoryInfo(cgroupsPath string) (*pb.ContainerMemoryInfo, error) {
+	root := cgroupMemoryPath
+	usageFile, limitFile := memoryUsageFile, memoryLimitFile
+	cacheKey, rssKey := "cache", "rss"
+
+	if unifiedCgroupHierarchy {
+		root = cgroupPath
+		usageFile, limitFile = memoryCurrentFile, memoryMaxFile
+		cacheKey, rssKey = "file", "anon"
+	}
+
+	dir := filepath.Join(root, cgroupsPath)
+
+	usage, err := readCgroupUint(filepath.Join(dir, usageFile))
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := readCgroupUint(filepath.Join(dir, limitFile))
+	if err != nil {
+		return nil, err
+	}
+	if limit >= memoryUnlimitedV1 {
+		limit = 0
+	}
+
+	stat, err := readCgroupStat(filepath.Join(dir, memoryStatFile))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ContainerMemoryInfo{
+		Usage: usage,
+		Limit: limit,
+		Cache: stat[cacheKey],
+		Rss:   stat[rssKey],
+	}, nil
+}
+
+// getMemoryInfo returns the guest memory statistics together with the ones
+// of every container, all collected while holding the sandbox lock so that
+// no container is added or removed meanwhile.
+func (s *sandbox) getMemoryInfo() (*pb.MemoryInfo, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	guest, err := getGuestMemoryInfo()
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not read guest memory info: %v", err)
+	}
+
+	info := &pb.MemoryInfo{Guest: guest}
+
+	for id, ctr := range s.containers {
+		if ctr.config.Cgroups == nil {
+			continue
+		}
+
+		ctrInfo, err := getCgroupMemoryInfo(ctr.config.Cgroups.Path)
+		if err != nil {
+			// The container may be going away.
+			agentLog.WithError(err).WithField("container", id).Warn("Could not read container memory info")
+			continue
+		}
+
+		ctrInfo.ContainerId = id
+		info.Containers = append(info.Containers, ctrInfo)
+	}
+
+	sort.Slice(info.Containers, func(i, j int) bool {
+		return info.Containers[i].ContainerId < info.Containers[j].ContainerId
+	})
+
+	return info, nil
+}
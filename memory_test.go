@@ -0,0 +1,224 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMeminfo(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedMeminfo := meminfo
+	defer func() {
+		meminfo = savedMeminfo
+	}()
+
+	meminfo = filepath.Join(dir, "meminfo")
+
+	// Missing file
+	_, err = getMeminfo()
+	assert.Error(err)
+
+	contents := "MemTotal:        2041248 kB\nMemFree:          120 kB\nHugePages_Total:       4\n\n"
+	err = createFile(meminfo, contents)
+	assert.NoError(err)
+
+	info, err := getMeminfo()
+	assert.NoError(err)
+	assert.Equal(uint64(2041248*1024), info["MemTotal"])
+	assert.Equal(uint64(120*1024), info["MemFree"])
+	assert.Equal(uint64(4), info["HugePages_Total"])
+
+	memFree, err := getMemFree()
+	assert.NoError(err)
+	assert.Equal(uint64(120*1024), memFree)
+
+	err = createFile(meminfo, "MemTotal:        foo kB\n")
+	assert.NoError(err)
+
+	_, err = getMeminfo()
+	assert.Error(err)
+
+	err = createFile(meminfo, "MemTotal:        2041248 kB\n")
+	assert.NoError(err)
+
+	_, err = getMemFree()
+	assert.Error(err)
+}
+
+func TestDropCaches(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedProcSysDir := procSysDir
+	savedGetMeminfo := getMeminfo
+	defer func() {
+		procSysDir = savedProcSysDir
+		getMeminfo = savedGetMeminfo
+	}()
+
+	procSysDir = filepath.Join(tmpDir, "proc", "sys")
+	vmDir := filepath.Join(procSysDir, "vm")
+	err = os.MkdirAll(vmDir, 0755)
+	assert.NoError(err)
+
+	freeValues := []uint64{1000, 5096}
+	getMeminfo = func() (map[string]uint64, error) {
+		value := freeValues[0]
+		freeValues = freeValues[1:]
+		return map[string]uint64{"MemFree": value}, nil
+	}
+
+	a := &agentGRPC{}
+
+	_, err = a.DropCaches(context.TODO(), &pb.DropCachesRequest{Mode: 0})
+	assert.Error(err)
+
+	_, err = a.DropCaches(context.TODO(), &pb.DropCachesRequest{Mode: 4})
+	assert.Error(err)
+
+	resp, err := a.DropCaches(context.TODO(), &pb.DropCachesRequest{Mode: 3, CompactMemory: true})
+	assert.NoError(err)
+	assert.Equal(int64(4096), resp.FreedBytes)
+
+	content, err := ioutil.ReadFile(filepath.Join(vmDir, "drop_caches"))
+	assert.NoError(err)
+	assert.Equal("3", string(content))
+
+	content, err = ioutil.ReadFile(filepath.Join(vmDir, "compact_memory"))
+	assert.NoError(err)
+	assert.Equal("1", string(content))
+
+	// Free memory may shrink in the meantime.
+	os.Remove(filepath.Join(vmDir, "compact_memory"))
+	freeValues = []uint64{2000, 1000}
+
+	resp, err = a.DropCaches(context.TODO(), &pb.DropCachesRequest{Mode: 1})
+	assert.NoError(err)
+	assert.Equal(int64(-1000), resp.FreedBytes)
+
+	content, err = ioutil.ReadFile(filepath.Join(vmDir, "drop_caches"))
+	assert.NoError(err)
+	assert.Equal("1", string(content))
+
+	_, err = os.Stat(filepath.Join(vmDir, "compact_memory"))
+	assert.True(os.IsNotExist(err))
+}
+
+func writeCgroupMemoryFiles(t *testing.T, dir string, files map[string]string) {
+	err := os.MkdirAll(dir, testDirMode)
+	assert.NoError(t, err)
+
+	for name, content := range files {
+		err = createFile(filepath.Join(dir, name), content)
+		assert.NoError(t, err)
+	}
+}
+
+func TestGetMemoryInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedMeminfo := meminfo
+	savedCgroupMemoryPath := cgroupMemoryPath
+	savedCgroupPath := cgroupPath
+	savedUnified := unifiedCgroupHierarchy
+	defer func() {
+		meminfo = savedMeminfo
+		cgroupMemoryPath = savedCgroupMemoryPath
+		cgroupPath = savedCgroupPath
+		unifiedCgroupHierarchy = savedUnified
+	}()
+
+	meminfo = filepath.Join(dir, "meminfo")
+	cgroupMemoryPath = filepath.Join(dir, "v1")
+	cgroupPath = filepath.Join(dir, "v2")
+	unifiedCgroupHierarchy = false
+
+	contents := "MemTotal:        2048 kB\nMemFree:          1024 kB\nMemAvailable:     1536 kB\n" +
+		"Buffers:          8 kB\nCached:           256 kB\nSwapTotal:        0 kB\nSwapFree:         0 kB\n"
+	err = createFile(meminfo, contents)
+	assert.NoError(err)
+
+	writeCgroupMemoryFiles(t, filepath.Join(cgroupMemoryPath, "kata/ctr-a"), map[string]string{
+		memoryUsageFile: "4096\n",
+		memoryLimitFile: "8192\n",
+		memoryStatFile:  "cache 1024\nrss 2048\ntotal_cache 1024\n",
+	})
+	writeCgroupMemoryFiles(t, filepath.Join(cgroupMemoryPath, "kata/ctr-b"), map[string]string{
+		memoryUsageFile: "100\n",
+		memoryLimitFile: "9223372036854771712\n",
+		memoryStatFile:  "cache 10\nrss 90\n",
+	})
+
+	s := &sandbox{
+		containers: map[string]*container{
+			"ctr-b": {id: "ctr-b", config: configs.Config{Cgroups: &configs.Cgroup{Path: "kata/ctr-b"}}},
+			"ctr-a": {id: "ctr-a", config: configs.Config{Cgroups: &configs.Cgroup{Path: "kata/ctr-a"}}},
+			// cgroup already gone
+			"ctr-c": {id: "ctr-c", config: configs.Config{Cgroups: &configs.Cgroup{Path: "kata/ctr-c"}}},
+			// no cgroup
+			"ctr-d": {id: "ctr-d"},
+		},
+	}
+
+	a := &agentGRPC{sandbox: s}
+
+	info, err := a.GetMemoryInfo(context.Background(), &pb.GetMemoryInfoRequest{})
+	assert.NoError(err)
+
+	assert.Equal(&pb.GuestMemoryInfo{
+		Total:     2048 * 1024,
+		Free:      1024 * 1024,
+		Available: 1536 * 1024,
+		Buffers:   8 * 1024,
+		Cached:    256 * 1024,
+	}, info.Guest)
+
+	assert.Equal([]*pb.ContainerMemoryInfo{
+		{ContainerId: "ctr-a", Usage: 4096, Limit: 8192, Cache: 1024, Rss: 2048},
+		{ContainerId: "ctr-b", Usage: 100, Limit: 0, Cache: 10, Rss: 90},
+	}, info.Containers)
+
+	// cgroup v2
+	unifiedCgroupHierarchy = true
+	writeCgroupMemoryFiles(t, filepath.Join(cgroupPath, "kata/ctr-a"), map[string]string{
+		memoryCurrentFile: "300\n",
+		memoryMaxFile:     "max\n",
+		memoryStatFile:    "anon 200\nfile 100\n",
+	})
+
+	info, err = a.GetMemoryInfo(context.Background(), &pb.GetMemoryInfoRequest{})
+	assert.NoError(err)
+	assert.Equal([]*pb.ContainerMemoryInfo{
+		{ContainerId: "ctr-a", Usage: 300, Limit: 0, Cache: 100, Rss: 200},
+	}, info.Containers)
+
+	// Missing meminfo
+	os.Remove(meminfo)
+	_, err = a.GetMemoryInfo(context.Background(), &pb.GetMemoryInfoRequest{})
+	assert.Error(err)
+}
@@ -0,0 +1,249 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// metric is a value exposed through GetMetrics.
+type metric interface {
+	metricName() string
+	// write appends the metric in the Prometheus text format to b.
+	write(b *strings.Builder)
+}
+
+var metricsRegistry []metric
+
+func registerMetric(m metric) {
+	metricsRegistry = append(metricsRegistry, m)
+}
+
+// gauge is a metric holding a value which can go up and down.
+type gauge struct {
+	name  string
+	help  string
+	value int64
+}
+
+func newGauge(name, help string) *gauge {
+	g := &gauge{name: name, help: help}
+	registerMetric(g)
+	return g
+}
+
+func (g *gauge) add(delta int64) {
+	atomic.AddInt64(&g.value, delta)
+}
+
+func (g *gauge) set(value int64) {
+	atomic.StoreInt64(&g.value, value)
+}
+
+func (g *gauge) get() int64 {
+	return atomic.LoadInt64(&g.value)
+}
+
+func (g *gauge) metricName() string {
+	return g.name
+}
+
+func (g *gauge) write(b *strings.Builder) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s gauge\n%s %d\n", g.name, g.help, g.name, g.name, g.get())
+}
+
+// counter is a metric holding a value which only goes up.
+type counter struct {
+	name  string
+	help  string
+	value uint64
+}
+
+func newCounter(name, help string) *counter {
+	c := &counter{name: name, help: help}
+	registerMetric(c)
+	return c
+}
+
+func (c *counter) inc() {
+	atomic.AddUint64(&c.value, 1)
+}
+
+func (c *counter) get() uint64 {
+	return atomic.LoadUint64(&c.value)
+}
+
+func (c *counter) metricName() string {
+	return c.name
+}
+
+func (c *counter) write(b *strings.Builder) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", c.name, c.help, c.name, c.name, c.get())
+}
+
+// histogramVec is a metric counting observed values in buckets, with a
+// series for each set of label values.
+type histogramVec struct {
+	name    string
+	help    string
+	labels  []string
+	buckets []float64
+
+	sync.RWMutex
+	series map[string]*histogramSeries
+}
+
+// histogramSeries holds the observations of a set of label values.
+type histogramSeries struct {
+	labelValues []string
+	// counts[i] is the number of values lower or equal to buckets[i], the
+	// last count holding the values above every bucket.
+	counts []uint64
+	// sum is stored as the bits of a float64.
+	sum uint64
+}
+
+func newHistogramVec(name, help string, buckets []float64, labels ...string) *histogramVec {
+	h := &histogramVec{
+		name:    name,
+		help:    help,
+		labels:  labels,
+		buckets: buckets,
+		series:  make(map[string]*histogramSeries),
+	}
+	registerMetric(h)
+	return h
+}
+
+// getSeries returns the series of labelValues, creating it on first use.
+func (h *histogramVec) getSeries(labelValues []string) *histogramSeries {
+	key := strings.Join(labelValues, "\xff")
+
+	h.RLock()
+	series, ok := h.series[key]
+	h.RUnlock()
+	if ok {
+		return series
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	if series, ok = h.series[key]; !ok {
+		series = &histogramSeries{
+			labelValues: append([]string(nil), labelValues...),
+			counts:      make([]uint64, len(h.buckets)+1),
+		}
+		h.series[key] = series
+	}
+
+	return series
+}
+
+// observe adds value to the series of labelValues, given in the order of
+// the histogram labels.
+func (h *histogramVec) observe(value float64, labelValues ...string) {
+	series := h.getSeries(labelValues)
+
+	i := sort.SearchFloat64s(h.buckets, value)
+	atomic.AddUint64(&series.counts[i], 1)
+
+	for {
+		old := atomic.LoadUint64(&series.sum)
+		sum := math.Float64bits(math.Float64frombits(old) + value)
+		if atomic.CompareAndSwapUint64(&series.sum, old, sum) {
+			break
+		}
+	}
+}
+
+// count returns the number of values observed for labelValues.
+func (h *histogramVec) count(labelValues ...string) uint64 {
+	h.RLock()
+	series, ok := h.series[strings.Join(labelValues, "\xff")]
+	h.RUnlock()
+	if !ok {
+		return 0
+	}
+
+	var count uint64
+	for i := range series.counts {
+		count += atomic.LoadUint64(&series.counts[i])
+	}
+
+	return count
+}
+
+func (h *histogramVec) metricName() string {
+	return h.name
+}
+
+func (h *histogramVec) write(b *strings.Builder) {
+	h.RLock()
+	series := make([]*histogramSeries, 0, len(h.series))
+	for _, s := range h.series {
+		series = append(series, s)
+	}
+	h.RUnlock()
+
+	sort.Slice(series, func(i, j int) bool {
+		return strings.Join(series[i].labelValues, ",") < strings.Join(series[j].labelValues, ",")
+	})
+
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s histogram\n", h.name, h.help, h.name)
+
+	for _, s := range series {
+		var pairs []string
+		for i, label := range h.labels {
+			pairs = append(pairs, fmt.Sprintf("%s=%q", label, s.labelValues[i]))
+		}
+		labels := strings.Join(pairs, ",")
+		if labels != "" {
+			labels += ","
+		}
+
+		var count uint64
+		for i, bucket := range h.buckets {
+			count += atomic.LoadUint64(&s.counts[i])
+			fmt.Fprintf(b, "%s_bucket{%sle=\"%s\"} %d\n", h.name, labels, formatFloat(bucket), count)
+		}
+		count += atomic.LoadUint64(&s.counts[len(h.buckets)])
+		fmt.Fprintf(b, "%s_bucket{%sle=\"+Inf\"} %d\n", h.name, labels, count)
+
+		labels = strings.TrimSuffix(labels, ",")
+		sum := math.Float64frombits(atomic.LoadUint64(&s.sum))
+		fmt.Fprintf(b, "%s_sum{%s} %s\n%s_count{%s} %d\n", h.name, labels, formatFloat(sum), h.name, labels, count)
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// gatherMetrics returns the registered metrics, sorted by name, in the
+// Prometheus text format.
+func gatherMetrics() string {
+	metrics := make([]metric, len(metricsRegistry))
+	copy(metrics, metricsRegistry)
+
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].metricName() < metrics[j].metricName()
+	})
+
+	var b strings.Builder
+	for _, m := range metrics {
+		m.write(&b)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,90 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatherMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	savedRegistry := metricsRegistry
+	metricsRegistry = nil
+	defer func() {
+		metricsRegistry = savedRegistry
+	}()
+
+	g := newGauge("test_gauge", "A test gauge.")
+	c := newCounter("test_counter", "A test counter.")
+
+	g.set(5)
+	g.add(-7)
+	c.inc()
+	c.inc()
+
+	assert.Equal(`# HELP test_counter A test counter.
+# TYPE test_counter counter
+test_counter 2
+# HELP test_gauge A test gauge.
+# TYPE test_gauge gauge
+test_gauge -2
+`, gatherMetrics())
+}
+
+func TestHistogramVec(t *testing.T) {
+	assert := assert.New(t)
+
+	savedRegistry := metricsRegistry
+	metricsRegistry = nil
+	defer func() {
+		metricsRegistry = savedRegistry
+	}()
+
+	h := newHistogramVec("test_histogram", "A test histogram.", []float64{0.5, 1}, "driver", "result")
+	assert.Equal(uint64(0), h.count("blk", "success"))
+
+	h.observe(0.25, "blk", "success")
+	h.observe(0.5, "blk", "success")
+	h.observe(2, "blk", "success")
+	h.observe(0.75, "9p", "failure")
+
+	assert.Equal(uint64(3), h.count("blk", "success"))
+	assert.Equal(uint64(1), h.count("9p", "failure"))
+	assert.Equal(uint64(0), h.count("9p", "success"))
+
+	assert.Equal(`# HELP test_histogram A test histogram.
+# TYPE test_histogram histogram
+test_histogram_bucket{driver="9p",result="failure",le="0.5"} 0
+test_histogram_bucket{driver="9p",result="failure",le="1"} 1
+test_histogram_bucket{driver="9p",result="failure",le="+Inf"} 1
+test_histogram_sum{driver="9p",result="failure"} 0.75
+test_histogram_count{driver="9p",result="failure"} 1
+test_histogram_bucket{driver="blk",result="success",le="0.5"} 2
+test_histogram_bucket{driver="blk",result="success",le="1"} 2
+test_histogram_bucket{driver="blk",result="success",le="+Inf"} 3
+test_histogram_sum{driver="blk",result="success"} 2.75
+test_histogram_count{driver="blk",result="success"} 3
+`, gatherMetrics())
+}
+
+func TestGetMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{}
+
+	resp, err := a.GetMetrics(context.Background(), &pb.GetMetricsRequest{})
+	assert.NoError(err)
+	assert.True(strings.Contains(resp.Metrics, "\nkata_agent_stream_buffered_bytes "), resp.Metrics)
+	assert.True(strings.Contains(resp.Metrics, "\nkata_agent_stream_dropped_messages_total "), resp.Metrics)
+	assert.True(strings.Contains(resp.Metrics, "\n# TYPE kata_agent_storage_mount_duration_seconds histogram\n"), resp.Metrics)
+}
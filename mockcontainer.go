@@ -20,6 +20,12 @@ type mockContainer struct {
 	status    libcontainer.Status
 	stats     libcontainer.Stats
 	processes []int
+	criuOpts  *libcontainer.CriuOpts
+	// signals received, the container being stopped by the ones of
+	// stopSignals
+	signals     []os.Signal
+	stopSignals []os.Signal
+	destroyed   bool
 }
 
 func (m *mockContainer) ID() string {
@@ -70,10 +76,17 @@ func (m *mockContainer) Run(process *libcontainer.Process) (err error) {
 }
 
 func (m *mockContainer) Destroy() error {
+	m.destroyed = true
 	return nil
 }
 
 func (m *mockContainer) Signal(s os.Signal, all bool) error {
+	m.signals = append(m.signals, s)
+	for _, stop := range m.stopSignals {
+		if s == stop {
+			m.status = libcontainer.Stopped
+		}
+	}
 	return nil
 }
 
@@ -82,10 +95,12 @@ func (m *mockContainer) Exec() error {
 }
 
 func (m *mockContainer) Checkpoint(criuOpts *libcontainer.CriuOpts) error {
+	m.criuOpts = criuOpts
 	return nil
 }
 
 func (m *mockContainer) Restore(process *libcontainer.Process, criuOpts *libcontainer.CriuOpts) error {
+	m.criuOpts = criuOpts
 	return nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	pb "github.com/kata-containers/agent/protocols/grpc"
 	"github.com/pkg/errors"
@@ -94,15 +95,15 @@ func mount(source, destination, fsType string, flags int, options string) error
 	}).Debug()
 
 	if source == "" {
-		return fmt.Errorf("need mount source")
+		return grpcStatus.Error(codes.InvalidArgument, "need mount source")
 	}
 
 	if destination == "" {
-		return fmt.Errorf("need mount destination")
+		return grpcStatus.Error(codes.InvalidArgument, "need mount destination")
 	}
 
 	if fsType == "" {
-		return fmt.Errorf("need mount FS type")
+		return grpcStatus.Error(codes.InvalidArgument, "need mount FS type")
 	}
 
 	var err error
@@ -126,7 +127,7 @@ func mount(source, destination, fsType string, flags int, options string) error
 	default:
 		absSource, err = filepath.EvalSymlinks(source)
 		if err != nil {
-			return grpcStatus.Errorf(codes.Internal, "Could not resolve symlink for source %v", source)
+			return grpcStatus.Errorf(errorCode(err), "Could not resolve symlink for source %v: %v", source, err)
 		}
 
 		if err = ensureDestinationExists(absSource, destination, fsType); err != nil {
@@ -141,6 +142,47 @@ func mount(source, destination, fsType string, flags int, options string) error
 			absSource, destination, err)
 	}
 
+	if isReadonlyBind(flags) {
+		if err = remountReadonly(destination, flags); err != nil {
+			if e := syscall.Unmount(destination, 0); e != nil {
+				agentLog.WithError(e).WithField("mount-destination", destination).Warn("Could not unmount read-only bind mount")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flags of a bind mount kept by its read-only remount, the others being
+// ignored or rejected by the kernel.
+const bindRemountFlags = unix.MS_NOSUID | unix.MS_NODEV | unix.MS_NOEXEC |
+	unix.MS_NOATIME | unix.MS_NODIRATIME | unix.MS_RELATIME | unix.MS_STRICTATIME
+
+// isReadonlyBind returns whether flags describe a new read-only bind mount,
+// for which the kernel ignores MS_RDONLY.
+func isReadonlyBind(flags int) bool {
+	return flags&unix.MS_BIND != 0 && flags&unix.MS_RDONLY != 0 && flags&unix.MS_REMOUNT == 0
+}
+
+// remountReadonly remounts the bind mount destination, created with flags,
+// read-only and checks the result.
+func remountReadonly(destination string, flags int) error {
+	remountFlags := unix.MS_REMOUNT | unix.MS_BIND | unix.MS_RDONLY | flags&bindRemountFlags
+
+	if err := syscall.Mount("", destination, "", uintptr(remountFlags), ""); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not remount %v read-only: %v", destination, err)
+	}
+
+	var st unix.Statfs_t
+	if err := unix.Statfs(destination, &st); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not check the read-only remount of %v: %v", destination, err)
+	}
+
+	if st.Flags&unix.ST_RDONLY == 0 {
+		return grpcStatus.Errorf(codes.Internal, "Mount %v is still writable after its read-only remount", destination)
+	}
+
 	return nil
 }
 
@@ -215,12 +257,142 @@ func ensureDestinationExists(source, destination string, fsType string) error {
 	return nil
 }
 
-func parseMountFlagsAndOptions(optionList []string) (int, string) {
+// mountOptionConflicts lists groups of mount options that cannot be used
+// together. Options taking a value are identified by their key.
+var mountOptionConflicts = [][]string{
+	{"ro", "rw"},
+	{"sync", "async"},
+	{"dev", "nodev"},
+	{"exec", "noexec"},
+	{"suid", "nosuid"},
+	{"noatime", "relatime", "strictatime"},
+	{"private", "shared", "slave", "unbindable", "rprivate", "rshared", "rslave", "runbindable"},
+	// The kernel refuses context= together with the other SELinux contexts.
+	{"context", "fscontext"},
+	{"context", "defcontext"},
+	{"context", "rootcontext"},
+	// An SELinux context cannot be enforced on an idmapped mount.
+	{"context", "idmap"},
+	{"context", "X-mount.idmap"},
+}
+
+// mountOptionKey returns the key of a "key=value" mount option, or the
+// option itself otherwise.
+func mountOptionKey(opt string) string {
+	if idx := strings.Index(opt, "="); idx > 0 {
+		return opt[:idx]
+	}
+
+	return opt
+}
+
+// checkMountOptions returns an error naming the first pair of options from
+// optionList that cannot be applied together.
+func checkMountOptions(optionList []string) error {
+	seen := make(map[string]string)
+
+	for _, opt := range optionList {
+		key := mountOptionKey(opt)
+
+		if prev, ok := seen[key]; ok && prev != opt {
+			return grpcStatus.Errorf(codes.InvalidArgument,
+				"Conflicting mount options %q and %q", prev, opt)
+		}
+
+		for _, group := range mountOptionConflicts {
+			inGroup := false
+			for _, member := range group {
+				if member == key {
+					inGroup = true
+					break
+				}
+			}
+
+			if !inGroup {
+				continue
+			}
+
+			for _, other := range group {
+				if prev, ok := seen[other]; ok && other != key {
+					return grpcStatus.Errorf(codes.InvalidArgument,
+						"Conflicting mount options %q and %q", prev, opt)
+				}
+			}
+		}
+
+		seen[key] = opt
+	}
+
+	return nil
+}
+
+// mountOptionForbidden returns whether the operator forbids the mount option
+// opt, through either the denylist or the allowlist.
+func mountOptionForbidden(opt string) bool {
+	key := mountOptionKey(opt)
+
+	for _, denied := range deniedMountOptions {
+		if key == denied {
+			return true
+		}
+	}
+
+	if len(allowedMountOptions) == 0 {
+		return false
+	}
+
+	for _, allowed := range allowedMountOptions {
+		if key == allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sanitizeMountOptions returns the options from optionList which are not
+// forbidden, along with the stripped ones. It fails on the first forbidden
+// option instead if those must be rejected.
+func sanitizeMountOptions(optionList []string) ([]string, []string, error) {
+	var kept, stripped []string
+
+	for _, opt := range optionList {
+		if !mountOptionForbidden(opt) {
+			kept = append(kept, opt)
+			continue
+		}
+
+		if rejectForbiddenMountOptions {
+			return nil, nil, grpcStatus.Errorf(codes.InvalidArgument, "Forbidden mount option %q", opt)
+		}
+
+		stripped = append(stripped, opt)
+	}
+
+	return kept, stripped, nil
+}
+
+// validateMountOptions checks optionList can be mounted, once forbidden
+// options have been stripped.
+func validateMountOptions(optionList []string) error {
+	optionList, _, err := sanitizeMountOptions(optionList)
+	if err != nil {
+		return err
+	}
+
+	return checkMountOptions(optionList)
+}
+
+func parseMountFlagsAndOptions(optionList []string) (int, string, error) {
 	var (
 		flags   int
 		options []string
 	)
 
+	if err := checkMountOptions(optionList); err != nil {
+		return 0, "", err
+	}
+
 	for _, opt := range optionList {
 		flag, ok := flagList[opt]
 		if ok {
@@ -231,7 +403,7 @@ func parseMountFlagsAndOptions(optionList []string) (int, string) {
 		options = append(options, opt)
 	}
 
-	return flags, strings.Join(options, ",")
+	return flags, strings.Join(options, ","), nil
 }
 
 func parseOptions(optionList []string) map[string]string {
@@ -274,6 +446,32 @@ var storageHandlerList = map[string]storageHandler{
 	driverNvdimmType:    nvdimmStorageHandler,
 }
 
+// Buckets, in seconds, of the storage mount durations.
+var storageMountBuckets = []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+var storageMountDuration = newHistogramVec("kata_agent_storage_mount_duration_seconds",
+	"Time taken by the storage drivers to mount a storage.", storageMountBuckets, "driver", "result")
+
+// observeStorageMount records the time the driver of storage took to mount
+// it, logging it when above mountLogThreshold.
+func observeStorageMount(storage pb.Storage, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+
+	storageMountDuration.observe(duration.Seconds(), storage.Driver, result)
+
+	if mountLogThreshold > 0 && duration > mountLogThreshold {
+		agentLog.WithFields(logrus.Fields{
+			"driver":      storage.Driver,
+			"mount-point": storage.MountPoint,
+			"result":      result,
+			"duration":    duration,
+		}).Warn("Slow storage mount")
+	}
+}
+
 func ephemeralStorageHandler(_ context.Context, storage pb.Storage, s *sandbox) (string, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -331,7 +529,7 @@ func virtioMmioBlkStorageHandler(_ context.Context, storage pb.Storage, s *sandb
 
 // virtioBlkCCWStorageHandler handles the storage for blk ccw driver.
 func virtioBlkCCWStorageHandler(ctx context.Context, storage pb.Storage, s *sandbox) (string, error) {
-	devPath, err := getBlkCCWDevPath(s, storage.Source)
+	devPath, err := getBlkCCWDevPath(ctx, s, storage.Source)
 	if err != nil {
 		return "", err
 	}
@@ -349,7 +547,7 @@ func virtioFSStorageHandler(_ context.Context, storage pb.Storage, s *sandbox) (
 }
 
 // virtioBlkStorageHandler handles the storage for blk driver.
-func virtioBlkStorageHandler(_ context.Context, storage pb.Storage, s *sandbox) (string, error) {
+func virtioBlkStorageHandler(ctx context.Context, storage pb.Storage, s *sandbox) (string, error) {
 
 	// If hot-plugged, get the device node path based on the PCI
 	// path else use the virt path provided in Storage Source
@@ -362,11 +560,11 @@ func virtioBlkStorageHandler(_ context.Context, storage pb.Storage, s *sandbox)
 		}
 		// Make sure the virt path is valid
 		if FileInfo.Mode()&os.ModeDevice == 0 {
-			return "", fmt.Errorf("invalid device %s", storage.Source)
+			return "", grpcStatus.Errorf(codes.InvalidArgument, "invalid device %s", storage.Source)
 		}
 
 	} else {
-		devPath, err := getPCIDeviceName(s, PciPath{storage.Source})
+		devPath, err := getPCIDeviceName(ctx, s, PciPath{storage.Source})
 		if err != nil {
 			return "", err
 		}
@@ -377,12 +575,12 @@ func virtioBlkStorageHandler(_ context.Context, storage pb.Storage, s *sandbox)
 	return commonStorageHandler(storage)
 }
 
-func nvdimmStorageHandler(_ context.Context, storage pb.Storage, s *sandbox) (string, error) {
+func nvdimmStorageHandler(ctx context.Context, storage pb.Storage, s *sandbox) (string, error) {
 	// waiting for a pmem device
 	if strings.HasPrefix(storage.Source, "/dev") && strings.HasPrefix(filepath.Base(storage.Source), "pmem") {
 		// Retrieve the device path from ACPI pmem address.
 		// for example: /devices/LNXSYSTM:00/LNXSYBUS:00/ACPI0012:00/ndbus0/region1/pfn1.1/block/pmem1
-		devPath, err := getPmemDevPath(s, storage.Source)
+		devPath, err := getPmemDevPath(ctx, s, storage.Source)
 		if err != nil {
 			return "", err
 		}
@@ -390,13 +588,13 @@ func nvdimmStorageHandler(_ context.Context, storage pb.Storage, s *sandbox) (st
 		return commonStorageHandler(storage)
 	}
 
-	return "", fmt.Errorf("invalid nvdimm source path: %v", storage.Source)
+	return "", grpcStatus.Errorf(codes.InvalidArgument, "invalid nvdimm source path: %v", storage.Source)
 }
 
 // virtioSCSIStorageHandler handles the storage for scsi driver.
 func virtioSCSIStorageHandler(ctx context.Context, storage pb.Storage, s *sandbox) (string, error) {
 	// Retrieve the device path from SCSI address.
-	devPath, err := getSCSIDevPath(s, storage.Source)
+	devPath, err := getSCSIDevPath(ctx, s, storage.Source)
 	if err != nil {
 		return "", err
 	}
@@ -414,9 +612,88 @@ func commonStorageHandler(storage pb.Storage) (string, error) {
 	return storage.MountPoint, nil
 }
 
-// mountStorage performs the mount described by the storage structure.
+// createMountPoint creates the mount point of storage, if missing, with
+// the requested attributes. For a bind mount of a file, the mount point is
+// an empty file instead of a directory. Nothing is done when no attributes
+// are requested, the mount point being created by mount() then.
+func createMountPoint(storage pb.Storage) error {
+	attrs := storage.MountPointAttributes
+	if attrs == nil {
+		return nil
+	}
+
+	if attrs.Mode&^07777 != 0 {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid mode %#o for mount point %v", attrs.Mode, storage.MountPoint)
+	}
+
+	if _, err := os.Lstat(storage.MountPoint); !os.IsNotExist(err) {
+		return err
+	}
+
+	mode := uint32(mountPerm)
+	if attrs.Mode != 0 {
+		mode = attrs.Mode
+	}
+
+	if err := createDestinationDir(storage.MountPoint); err != nil {
+		return err
+	}
+
+	isDir := true
+	if storage.Fstype == "bind" {
+		if fi, err := os.Stat(storage.Source); err == nil && !fi.IsDir() {
+			isDir = false
+		}
+	}
+
+	if isDir {
+		if err := os.Mkdir(storage.MountPoint, 0700); err != nil {
+			return err
+		}
+	} else {
+		file, err := os.OpenFile(storage.MountPoint, os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return err
+		}
+		file.Close()
+	}
+
+	// Changing the owner clears the setuid and setgid bits, the mode is
+	// set last.
+	err := os.Chown(storage.MountPoint, int(attrs.Uid), int(attrs.Gid))
+	if err == nil {
+		err = unix.Chmod(storage.MountPoint, mode)
+	}
+	if err != nil {
+		os.Remove(storage.MountPoint)
+		return grpcStatus.Errorf(codes.Internal, "Could not set attributes of mount point %v: %v", storage.MountPoint, err)
+	}
+
+	return nil
+}
+
+// mountStorage performs the mount described by the storage structure. The
+// options the operator forbids are stripped or rejected, unlike for the mounts
+// of the agent itself.
 func mountStorage(storage pb.Storage) error {
-	flags, options := parseMountFlagsAndOptions(storage.Options)
+	optionList, stripped, err := sanitizeMountOptions(storage.Options)
+	if err != nil {
+		agentLog.WithError(err).Warn("Rejecting mount")
+		return err
+	}
+
+	for _, opt := range stripped {
+		agentLog.WithField("mount-option", opt).Warn("Stripping forbidden mount option")
+	}
+
+	flags, options, err := parseMountFlagsAndOptions(optionList)
+	if err != nil {
+		return err
+	}
+
+	if err := createMountPoint(storage); err != nil {
+		return err
+	}
 
 	return mount(storage.Source, storage.MountPoint, storage.Fstype, flags, options)
 }
@@ -431,20 +708,45 @@ func addStorages(ctx context.Context, storages []*pb.Storage, s *sandbox) (mount
 	defer span.finish()
 
 	var mountList []string
-	var storageList []string
+	// The sandbox storages and the mounts to roll back on failure, the last
+	// one added first.
+	var rollbackList []string
+	sandboxStorages := make(map[string]bool)
+
+	storages, err = sortStorages(storages)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject conflicting or forbidden options before waiting for any device.
+	for _, storage := range storages {
+		if err := validateMountOptions(storage.Options); err != nil {
+			return nil, err
+		}
+	}
 
 	defer func() {
-		if err != nil {
-			s.Lock()
-			for _, path := range storageList {
-				if err := s.unsetAndRemoveSandboxStorage(path); err != nil {
-					agentLog.WithFields(logrus.Fields{
-						"error": err,
-						"path":  path,
-					}).Error("failed to roll back addStorages")
-				}
+		if err == nil {
+			return
+		}
+
+		s.Lock()
+		defer s.Unlock()
+
+		for _, path := range rollbackList {
+			var rollbackErr error
+			if sandboxStorages[path] {
+				rollbackErr = s.unsetAndRemoveSandboxStorage(path)
+			} else {
+				rollbackErr = syscall.Unmount(path, 0)
+			}
+
+			if rollbackErr != nil {
+				agentLog.WithFields(logrus.Fields{
+					"error": rollbackErr,
+					"path":  path,
+				}).Error("failed to roll back addStorages")
 			}
-			s.Unlock()
 		}
 	}()
 
@@ -463,11 +765,14 @@ func addStorages(ctx context.Context, storages []*pb.Storage, s *sandbox) (mount
 		// the handler interface but also to avoid having to add trace
 		// code to each driver.
 		handlerSpan, _ := trace(ctx, "mount", storage.Driver)
+		start := time.Now()
 		mountPoint, err := devHandler(ctx, *storage, s)
+		observeStorageMount(*storage, time.Since(start), err)
 		handlerSpan.finish()
 
 		if _, ok := s.storages[storage.MountPoint]; ok {
-			storageList = append([]string{storage.MountPoint}, storageList...)
+			sandboxStorages[storage.MountPoint] = true
+			rollbackList = append([]string{storage.MountPoint}, rollbackList...)
 		}
 
 		if err != nil {
@@ -477,41 +782,109 @@ func addStorages(ctx context.Context, storages []*pb.Storage, s *sandbox) (mount
 		if mountPoint != "" {
 			// Prepend mount point to mount list.
 			mountList = append([]string{mountPoint}, mountList...)
+			rollbackList = append([]string{mountPoint}, rollbackList...)
+		}
+
+		if err := setStorageOwnership(ctx, *storage, mountPoint); err != nil {
+			return nil, err
 		}
 	}
 
 	return mountList, nil
 }
 
+// sortStorages orders the storages so that every storage comes after the
+// storages it depends on, keeping the request order otherwise. Dependencies
+// on mount points which are not part of the list are considered satisfied,
+// and a cyclic dependency is reported as an error.
+func sortStorages(storages []*pb.Storage) ([]*pb.Storage, error) {
+	var pending []*pb.Storage
+	mountPoints := make(map[string]bool)
+
+	for _, storage := range storages {
+		if storage == nil {
+			continue
+		}
+
+		pending = append(pending, storage)
+		mountPoints[storage.MountPoint] = true
+	}
+
+	sorted := make([]*pb.Storage, 0, len(pending))
+	mounted := make(map[string]bool)
+
+	for len(pending) > 0 {
+		var next []*pb.Storage
+
+		for _, storage := range pending {
+			ready := true
+			for _, dep := range storage.DependsOn {
+				if mountPoints[dep] && !mounted[dep] {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				sorted = append(sorted, storage)
+				mounted[storage.MountPoint] = true
+			} else {
+				next = append(next, storage)
+			}
+		}
+
+		if len(next) == len(pending) {
+			var cycle []string
+			for _, storage := range next {
+				cycle = append(cycle, storage.MountPoint)
+			}
+
+			return nil, grpcStatus.Errorf(codes.InvalidArgument,
+				"Cyclic dependency between storages %v", cycle)
+		}
+
+		pending = next
+	}
+
+	return sorted, nil
+}
+
 // getMountFSType returns the FS type corresponding to the passed mount point and
 // any error ecountered.
 func getMountFSType(mountPoint string) (string, error) {
+	_, fsType, err := getMountDeviceAndFSType(mountPoint)
+	return fsType, err
+}
+
+// getMountDeviceAndFSType returns the device and the FS type corresponding
+// to the passed mount point and any error ecountered.
+func getMountDeviceAndFSType(mountPoint string) (string, string, error) {
 	if mountPoint == "" {
-		return "", errors.Errorf("Invalid mount point '%s'", mountPoint)
+		return "", "", errors.Errorf("Invalid mount point '%s'", mountPoint)
 	}
 
 	mountstats, err := os.Open(procMountStats)
 	if err != nil {
-		return "", errors.Wrapf(err, "Failed to open file '%s'", procMountStats)
+		return "", "", errors.Wrapf(err, "Failed to open file '%s'", procMountStats)
 	}
 	defer mountstats.Close()
 
 	// Refer to fs/proc_namespace.c:show_vfsstat() for
 	// the file format.
-	re := regexp.MustCompile(fmt.Sprintf(`device .+ mounted on %s with fstype (.+)`, mountPoint))
+	re := regexp.MustCompile(fmt.Sprintf(`^device (\S+) mounted on %s with fstype (\S+)`, regexp.QuoteMeta(mountPoint)))
 
 	scanner := bufio.NewScanner(mountstats)
 	for scanner.Scan() {
 		line := scanner.Text()
 		matches := re.FindStringSubmatch(line)
-		if len(matches) > 1 {
-			return matches[1], nil
+		if len(matches) > 2 {
+			return matches[1], matches[2], nil
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		return "", errors.Wrapf(err, "Failed to parse proc mount stats file %s", procMountStats)
+		return "", "", errors.Wrapf(err, "Failed to parse proc mount stats file %s", procMountStats)
 	}
 
-	return "", errors.Errorf("Failed to find FS type for mount point '%s'", mountPoint)
+	return "", "", errors.Errorf("Failed to find FS type for mount point '%s'", mountPoint)
 }
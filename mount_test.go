@@ -7,17 +7,24 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	pb "github.com/kata-containers/agent/protocols/grpc"
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 func createSafeAndFakeStorage() (pb.Storage, error) {
@@ -326,7 +333,7 @@ func TestVirtioSCSIStorageHandlerFailure(t *testing.T) {
 	const expectedDevPath = "/dev/some/where"
 
 	savedSCSIDevPathFunc := getSCSIDevPath
-	getSCSIDevPath = func(s *sandbox, scsiAddr string) (string, error) {
+	getSCSIDevPath = func(ctx context.Context, s *sandbox, scsiAddr string) (string, error) {
 		return expectedDevPath, nil
 	}
 
@@ -443,6 +450,195 @@ func TestAddStoragesNoopHandlerFailure(t *testing.T) {
 	testAddStoragesFailure(t, storages)
 }
 
+func TestAddStoragesMountDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	savedRegistry := metricsRegistry
+	savedStorageMountDuration := storageMountDuration
+	savedStorageHandlerList := storageHandlerList
+	savedLog := agentLog
+	defer func() {
+		metricsRegistry = savedRegistry
+		storageMountDuration = savedStorageMountDuration
+		storageHandlerList = savedStorageHandlerList
+		agentLog = savedLog
+		mountLogThreshold = 0
+	}()
+
+	metricsRegistry = nil
+	storageMountDuration = newHistogramVec("test_mount_duration_seconds", "Test mount durations.", storageMountBuckets, "driver", "result")
+
+	slowHandler := func(_ context.Context, storage pb.Storage, s *sandbox) (string, error) {
+		time.Sleep(50 * time.Millisecond)
+		return "", nil
+	}
+
+	storageHandlerList = map[string]storageHandler{
+		"fast":   noopStorageHandlerReturnNil,
+		"broken": noopStorageHandlerReturnError,
+		"slow":   slowHandler,
+	}
+
+	buf := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.Formatter = &logrus.TextFormatter{DisableColors: true}
+	logger.Out = buf
+	agentLog = logger.WithField("test-agent-logger", true)
+
+	type testData struct {
+		driver         string
+		threshold      time.Duration
+		expectedResult string
+		expectLog      bool
+	}
+
+	data := []testData{
+		{"fast", 0, "success", false},
+		{"fast", time.Second, "success", false},
+		{"broken", 0, "failure", false},
+		{"slow", 0, "success", false},
+		{"slow", time.Second, "success", false},
+		{"slow", 10 * time.Millisecond, "success", true},
+	}
+
+	for i, d := range data {
+		buf.Reset()
+		mountLogThreshold = d.threshold
+		count := storageMountDuration.count(d.driver, d.expectedResult)
+
+		_, err := addStorages(context.Background(), []*pb.Storage{{Driver: d.driver, MountPoint: "/mnt/" + d.driver}}, &sandbox{})
+		assert.Equal(d.expectedResult == "failure", err != nil, "test %d (%+v)", i, d)
+
+		assert.Equal(count+1, storageMountDuration.count(d.driver, d.expectedResult), "test %d (%+v)", i, d)
+
+		logged := strings.Contains(buf.String(), "Slow storage mount")
+		assert.Equal(d.expectLog, logged, "test %d (%+v): %s", i, d, buf.String())
+		if d.expectLog {
+			assert.True(strings.Contains(buf.String(), "driver=slow"), buf.String())
+			assert.True(strings.Contains(buf.String(), "mount-point=/mnt/slow"), buf.String())
+		}
+	}
+
+	metrics := gatherMetrics()
+	assert.True(strings.Contains(metrics, `test_mount_duration_seconds_count{driver="fast",result="success"} 2`), metrics)
+	assert.True(strings.Contains(metrics, `test_mount_duration_seconds_count{driver="broken",result="failure"} 1`), metrics)
+	assert.True(strings.Contains(metrics, `test_mount_duration_seconds_bucket{driver="slow",result="success",le="0.01"} 0`), metrics)
+	assert.True(strings.Contains(metrics, `test_mount_duration_seconds_count{driver="slow",result="success"} 3`), metrics)
+}
+
+func TestSortStorages(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		storages      []*pb.Storage
+		expectedOrder []string
+		expectError   bool
+	}
+
+	data := []testData{
+		{nil, []string{}, false},
+		{[]*pb.Storage{nil}, []string{}, false},
+		{
+			[]*pb.Storage{
+				{MountPoint: "/a"},
+				{MountPoint: "/b"},
+			},
+			[]string{"/a", "/b"},
+			false,
+		},
+		{
+			// Chain: /overlay -> /lower -> /base
+			[]*pb.Storage{
+				{MountPoint: "/overlay", DependsOn: []string{"/lower"}},
+				{MountPoint: "/lower", DependsOn: []string{"/base"}},
+				{MountPoint: "/base"},
+			},
+			[]string{"/base", "/lower", "/overlay"},
+			false,
+		},
+		{
+			// Dependencies outside of the request are ignored
+			[]*pb.Storage{
+				{MountPoint: "/overlay", DependsOn: []string{"/run/kata-containers/shared"}},
+				nil,
+				{MountPoint: "/other"},
+			},
+			[]string{"/overlay", "/other"},
+			false,
+		},
+		{
+			[]*pb.Storage{
+				{MountPoint: "/self", DependsOn: []string{"/self"}},
+			},
+			nil,
+			true,
+		},
+		{
+			// Cycle: /a -> /b -> /c -> /a
+			[]*pb.Storage{
+				{MountPoint: "/a", DependsOn: []string{"/b"}},
+				{MountPoint: "/b", DependsOn: []string{"/c"}},
+				{MountPoint: "/c", DependsOn: []string{"/a"}},
+				{MountPoint: "/d"},
+			},
+			nil,
+			true,
+		},
+	}
+
+	for i, d := range data {
+		sorted, err := sortStorages(d.storages)
+		if d.expectError {
+			assert.Error(err, "test %d", i)
+			continue
+		}
+
+		assert.NoError(err, "test %d", i)
+
+		order := []string{}
+		for _, storage := range sorted {
+			order = append(order, storage.MountPoint)
+		}
+		assert.Equal(d.expectedOrder, order, "test %d", i)
+	}
+}
+
+func TestAddStoragesDependencyOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	noopHandlerTag := "noop"
+	savedStorageHandlerList := storageHandlerList
+
+	var mounted []string
+	storageHandlerList = map[string]storageHandler{
+		noopHandlerTag: func(_ context.Context, storage pb.Storage, s *sandbox) (string, error) {
+			mounted = append(mounted, storage.MountPoint)
+			return "", nil
+		},
+	}
+
+	defer func() {
+		storageHandlerList = savedStorageHandlerList
+	}()
+
+	storages := []*pb.Storage{
+		{Driver: noopHandlerTag, MountPoint: "/overlay", DependsOn: []string{"/lower1", "/lower2"}},
+		{Driver: noopHandlerTag, MountPoint: "/lower1", DependsOn: []string{"/lower2"}},
+		{Driver: noopHandlerTag, MountPoint: "/lower2"},
+	}
+
+	_, err := addStorages(context.Background(), storages, &sandbox{})
+	assert.NoError(err)
+	assert.Equal([]string{"/lower2", "/lower1", "/overlay"}, mounted)
+
+	mounted = nil
+	storages[2].DependsOn = []string{"/overlay"}
+
+	_, err = addStorages(context.Background(), storages, &sandbox{})
+	assert.Error(err)
+	assert.Empty(mounted)
+}
+
 func TestMount(t *testing.T) {
 	assert := assert.New(t)
 
@@ -519,6 +715,85 @@ func TestMount(t *testing.T) {
 	}
 }
 
+func TestIsReadonlyBind(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		options  []string
+		expected bool
+	}
+
+	data := []testData{
+		{nil, false},
+		{[]string{"ro"}, false},
+		{[]string{"bind"}, false},
+		{[]string{"rbind", "rw"}, false},
+		{[]string{"bind", "ro"}, true},
+		{[]string{"rbind", "ro", "nosuid"}, true},
+		{[]string{"remount", "bind", "ro"}, false},
+	}
+
+	for i, d := range data {
+		flags, _, err := parseMountFlagsAndOptions(d.options)
+		assert.NoError(err)
+		assert.Equal(d.expected, isReadonlyBind(flags), "test %d (%+v)", i, d)
+	}
+}
+
+func TestMountBindReadonly(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpdir, err := ioutil.TempDir("", "bind-ro")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpdir)
+
+	source := filepath.Join(tmpdir, "source")
+	err = os.Mkdir(source, mountPerm)
+	assert.NoError(err)
+
+	type testData struct {
+		options          []string
+		expectedReadonly bool
+	}
+
+	data := []testData{
+		{[]string{"bind"}, false},
+		{[]string{"rbind", "rw"}, false},
+		{[]string{"bind", "ro"}, true},
+		{[]string{"rbind", "ro", "nosuid", "nodev"}, true},
+	}
+
+	for i, d := range data {
+		destination := filepath.Join(tmpdir, fmt.Sprintf("mount-%d", i))
+
+		flags, options, err := parseMountFlagsAndOptions(d.options)
+		assert.NoError(err)
+
+		err = mount(source, destination, "bind", flags, options)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		err = ioutil.WriteFile(filepath.Join(destination, "file"), nil, 0644)
+		if d.expectedReadonly {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		var st syscall.Statfs_t
+		err = syscall.Statfs(destination, &st)
+		assert.NoError(err)
+		assert.Equal(d.expectedReadonly, st.Flags&syscall.MS_RDONLY != 0, "test %d (%+v)", i, d)
+
+		// The source itself stays writable.
+		err = ioutil.WriteFile(filepath.Join(source, "file"), nil, 0644)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		assert.NoError(syscall.Unmount(destination, 0))
+	}
+}
+
 func TestMountParseMountFlagsAndOptions(t *testing.T) {
 	assert := assert.New(t)
 
@@ -551,14 +826,250 @@ func TestMountParseMountFlagsAndOptions(t *testing.T) {
 	for i, d := range data {
 		msg := fmt.Sprintf("test[%d]: %+v\n", i, d)
 
-		flags, options := parseMountFlagsAndOptions(d.options)
+		flags, options, err := parseMountFlagsAndOptions(d.options)
 
+		assert.NoError(err, msg)
 		assert.Equal(d.expectedFlags, flags, msg)
 		assert.Equal(d.expectedOptions, options, msg)
 
 	}
 }
 
+func TestCheckMountOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		options   []string
+		conflicts []string
+	}
+
+	data := []testData{
+		// valid combinations
+		{[]string{}, nil},
+		{[]string{"ro", "nosuid", "nodev", "noexec"}, nil},
+		{[]string{"rw", "rshared", "relatime"}, nil},
+		{[]string{"ro", "ro"}, nil},
+		{[]string{"context=system_u:object_r:container_file_t:s0", "ro"}, nil},
+		{[]string{"fscontext=a", "defcontext=b", "rootcontext=c"}, nil},
+		{[]string{"X-mount.idmap=u:0:1000:1", "rbind"}, nil},
+		{[]string{"mode=755", "size=64m"}, nil},
+
+		// conflicting combinations
+		{[]string{"ro", "rw"}, []string{"ro", "rw"}},
+		{[]string{"nodev", "exec", "dev"}, []string{"nodev", "dev"}},
+		{[]string{"noatime", "strictatime"}, []string{"noatime", "strictatime"}},
+		{[]string{"rprivate", "nosuid", "shared"}, []string{"rprivate", "shared"}},
+		{[]string{"context=a", "fscontext=b"}, []string{"context=a", "fscontext=b"}},
+		{[]string{"defcontext=b", "context=a"}, []string{"defcontext=b", "context=a"}},
+		{[]string{"context=a", "X-mount.idmap=u:0:1000:1"}, []string{"context=a", "X-mount.idmap=u:0:1000:1"}},
+		{[]string{"idmap", "context=a"}, []string{"idmap", "context=a"}},
+		{[]string{"mode=755", "mode=700"}, []string{"mode=755", "mode=700"}},
+	}
+
+	for i, d := range data {
+		msg := fmt.Sprintf("test[%d]: %+v", i, d)
+
+		err := checkMountOptions(d.options)
+		if d.conflicts == nil {
+			assert.NoError(err, msg)
+			continue
+		}
+
+		assert.Error(err, msg)
+		assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), msg)
+		for _, opt := range d.conflicts {
+			assert.Contains(err.Error(), fmt.Sprintf("%q", opt), msg)
+		}
+
+		_, _, err = parseMountFlagsAndOptions(d.options)
+		assert.Error(err, msg)
+	}
+}
+
+func TestAddStoragesConflictingOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	storages := []*pb.Storage{
+		{
+			Driver:     driverLocalType,
+			MountPoint: "/does/not/matter",
+			Options:    []string{"ro", "rw"},
+		},
+	}
+
+	s := &sandbox{
+		storages: make(map[string]*sandboxStorage),
+	}
+
+	_, err := addStorages(context.Background(), storages, s)
+	assert.Error(err)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+	assert.Empty(s.storages)
+}
+
+func TestAddStoragesRollback(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "add-storages")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	storageHandlerList["fail"] = func(ctx context.Context, storage pb.Storage, s *sandbox) (string, error) {
+		return "", fmt.Errorf("storage failure")
+	}
+	defer delete(storageHandlerList, "fail")
+
+	mountPoint9p := filepath.Join(tmpDir, "9p")
+	mountPointEphemeral := filepath.Join(mountPoint9p, "ephemeral")
+	mountPointRO := filepath.Join(tmpDir, "ro")
+
+	for _, path := range []string{mountPoint9p, mountPointRO} {
+		err = os.Mkdir(path, 0755)
+		assert.NoError(err)
+	}
+
+	type testData struct {
+		storages []*pb.Storage
+	}
+
+	data := []testData{
+		// A later storage fails, the sandbox storage mounted over the
+		// 9p one being released first.
+		{[]*pb.Storage{
+			{Driver: driver9pType, Source: "tmpfs", Fstype: "tmpfs", MountPoint: mountPoint9p},
+			{Driver: driverEphemeralType, Source: "tmpfs", Fstype: "tmpfs", MountPoint: mountPointEphemeral},
+			{Driver: "fail", MountPoint: filepath.Join(tmpDir, "fail")},
+		}},
+		// The ownership of the storage cannot be changed once mounted.
+		{[]*pb.Storage{
+			{Driver: driver9pType, Source: "tmpfs", Fstype: "tmpfs", MountPoint: mountPoint9p},
+			{
+				Driver:     driver9pType,
+				Source:     "tmpfs",
+				Fstype:     "tmpfs",
+				MountPoint: mountPointRO,
+				Options:    []string{"ro"},
+				Ownership:  &pb.StorageOwnership{Uid: testChownUID, Gid: testChownGID},
+			},
+		}},
+	}
+
+	for i, d := range data {
+		s := &sandbox{
+			storages: make(map[string]*sandboxStorage),
+		}
+
+		mounts, err := addStorages(context.Background(), d.storages, s)
+		assert.Error(err, "test %d (%+v)", i, d)
+		assert.Empty(mounts, "test %d (%+v)", i, d)
+
+		// Nothing is left mounted.
+		assert.Empty(s.storages, "test %d (%+v)", i, d)
+		for _, path := range []string{mountPoint9p, mountPointEphemeral, mountPointRO} {
+			mounted, err := mountinfo.Mounted(path)
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.False(mounted, "test %d (%+v): %s still mounted", i, d, path)
+		}
+	}
+}
+
+func TestSanitizeMountOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		denied   []string
+		allowed  []string
+		reject   bool
+		options  []string
+		kept     []string
+		stripped []string
+		expected string
+		rejected bool
+	}
+
+	data := []testData{
+		{nil, nil, false, []string{"suid", "ro"}, []string{"suid", "ro"}, nil, "suid", false},
+		{[]string{"suid", "dev"}, nil, false, []string{"suid", "ro", "mode=0755"}, []string{"ro", "mode=0755"}, []string{"suid"}, "suid,mode=0755", false},
+		{[]string{"suid", "dev"}, nil, true, []string{"suid", "ro"}, nil, nil, "suid", true},
+		{[]string{"mode"}, nil, false, []string{"mode=0755", "ro"}, []string{"ro"}, []string{"mode=0755"}, "mode=0755", false},
+		{nil, []string{"ro", "mode"}, false, []string{"suid", "ro", "mode=0755"}, []string{"ro", "mode=0755"}, []string{"suid"}, "suid,mode=0755", false},
+		{nil, []string{"ro"}, true, []string{"ro", "exec"}, nil, nil, "exec", true},
+		{[]string{"ro"}, []string{"ro"}, false, []string{"ro"}, nil, []string{"ro"}, "", false},
+		// The options of the cgroup mounts of the agent are kept.
+		{nil, []string{"ro"}, true, []string{"nodev", "noexec", "nosuid", "cpu", "cpuacct"}, nil, nil, "cpu,cpuacct", true},
+	}
+
+	defer func() {
+		deniedMountOptions = nil
+		allowedMountOptions = nil
+		rejectForbiddenMountOptions = false
+	}()
+
+	for i, d := range data {
+		deniedMountOptions = d.denied
+		allowedMountOptions = d.allowed
+		rejectForbiddenMountOptions = d.reject
+
+		// The parser shared with the mounts of the agent itself ignores
+		// the policy.
+		_, options, err := parseMountFlagsAndOptions(d.options)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expected, options, "test %d (%+v)", i, d)
+
+		kept, stripped, err := sanitizeMountOptions(d.options)
+		if d.rejected {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.kept, kept, "test %d (%+v)", i, d)
+		assert.Equal(d.stripped, stripped, "test %d (%+v)", i, d)
+	}
+}
+
+func TestAddStoragesForbiddenOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	deniedMountOptions = []string{"suid"}
+	rejectForbiddenMountOptions = true
+	defer func() {
+		deniedMountOptions = nil
+		rejectForbiddenMountOptions = false
+	}()
+
+	drivers := []string{driverLocalType, driverEphemeralType, driver9pType, driverVirtioFSType, driverBlkType}
+
+	for _, driver := range drivers {
+		storages := []*pb.Storage{
+			{
+				Driver:     driver,
+				Source:     "/does/not/matter",
+				MountPoint: "/does/not/matter",
+				Options:    []string{"nodev", "suid"},
+			},
+		}
+
+		s := &sandbox{
+			storages: make(map[string]*sandboxStorage),
+		}
+
+		_, err := addStorages(context.Background(), storages, s)
+		assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "driver %s", driver)
+		assert.Empty(s.storages, "driver %s", driver)
+	}
+
+	// The options are checked again as the storage is mounted.
+	err := mountStorage(pb.Storage{
+		Source:     "/does/not/matter",
+		MountPoint: "/does/not/matter",
+		Options:    []string{"suid"},
+	})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
+
 func TestMountParseOptions(t *testing.T) {
 	assert := assert.New(t)
 
@@ -690,6 +1201,93 @@ func TestMountEnsureDestinationExists(t *testing.T) {
 	}
 }
 
+func TestCreateMountPoint(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "mount-point")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	sourceFile := filepath.Join(tmpDir, "source-file")
+	err = ioutil.WriteFile(sourceFile, nil, 0644)
+	assert.NoError(err)
+
+	existing := filepath.Join(tmpDir, "existing")
+	err = os.Mkdir(existing, 0750)
+	assert.NoError(err)
+
+	type testData struct {
+		storage      pb.Storage
+		expectedCode codes.Code
+		// expected mode of a created mount point
+		expectedMode os.FileMode
+		expectedUID  int
+		expectedGID  int
+	}
+
+	data := []testData{
+		{pb.Storage{Fstype: "tmpfs", MountPointAttributes: &pb.MountPointAttributes{}},
+			codes.OK, os.ModeDir | 0755, 0, 0},
+		{pb.Storage{Fstype: "tmpfs", MountPointAttributes: &pb.MountPointAttributes{Mode: 01777}},
+			codes.OK, os.ModeDir | os.ModeSticky | 0777, 0, 0},
+		// The setgid bit is kept by the change of owner.
+		{pb.Storage{Fstype: "tmpfs", MountPointAttributes: &pb.MountPointAttributes{Mode: 02770, Uid: 1000, Gid: 1001}},
+			codes.OK, os.ModeDir | os.ModeSetgid | 0770, 1000, 1001},
+		{pb.Storage{Fstype: "bind", Source: sourceFile, MountPointAttributes: &pb.MountPointAttributes{Mode: 0600, Uid: 1000}},
+			codes.OK, 0600, 1000, 0},
+		{pb.Storage{Fstype: "tmpfs", MountPointAttributes: &pb.MountPointAttributes{Mode: 010000}},
+			codes.InvalidArgument, 0, 0, 0},
+	}
+
+	for i, d := range data {
+		d.storage.MountPoint = filepath.Join(tmpDir, fmt.Sprintf("parent%d", i), "mount-point")
+
+		err := createMountPoint(d.storage)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		if d.expectedCode != codes.OK {
+			continue
+		}
+
+		fi, err := os.Lstat(d.storage.MountPoint)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedMode, fi.Mode(), "test %d (%+v)", i, d)
+
+		stat := fi.Sys().(*syscall.Stat_t)
+		assert.Equal(d.expectedUID, int(stat.Uid), "test %d (%+v)", i, d)
+		assert.Equal(d.expectedGID, int(stat.Gid), "test %d (%+v)", i, d)
+
+		// The missing parent directories get the default mode.
+		fi, err = os.Stat(filepath.Dir(d.storage.MountPoint))
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(os.ModeDir|mountPerm, fi.Mode(), "test %d (%+v)", i, d)
+	}
+
+	// An existing mount point is left untouched.
+	err = createMountPoint(pb.Storage{MountPoint: existing, MountPointAttributes: &pb.MountPointAttributes{Mode: 01777}})
+	assert.NoError(err)
+	fi, err := os.Stat(existing)
+	assert.NoError(err)
+	assert.Equal(os.ModeDir|0750, fi.Mode())
+
+	// Nothing is created without attributes, the default mount point
+	// being created by mount().
+	missing := filepath.Join(tmpDir, "missing", "mount-point")
+	err = createMountPoint(pb.Storage{MountPoint: missing})
+	assert.NoError(err)
+	_, err = os.Stat(filepath.Dir(missing))
+	assert.True(os.IsNotExist(err))
+
+	err = mountStorage(pb.Storage{Source: existing, Fstype: "bind", Options: []string{"bind"}, MountPoint: missing})
+	assert.NoError(err)
+	err = syscall.Unmount(missing, 0)
+	assert.NoError(err)
+	fi, err = os.Stat(missing)
+	assert.NoError(err)
+	assert.Equal(os.ModeDir|mountPerm, fi.Mode())
+}
+
 func TestGetMountFSType(t *testing.T) {
 	assert := assert.New(t)
 
@@ -13,7 +13,11 @@ import (
 	"runtime"
 	"sync"
 
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 var persistentNsDir = "/var/run/sandbox-ns"
@@ -32,12 +36,91 @@ const (
 	nsTypeIPC nsType = "ipc"
 	nsTypeNet nsType = "net"
 	nsTypeUTS nsType = "uts"
+	nsTypePID nsType = "pid"
 )
 
 var cloneFlagsTable = map[nsType]int{
 	nsTypeIPC: unix.CLONE_NEWIPC,
 	nsTypeNet: unix.CLONE_NEWNET,
 	nsTypeUTS: unix.CLONE_NEWUTS,
+	nsTypePID: unix.CLONE_NEWPID,
+}
+
+// Clone flags of the namespace types a container can join by path.
+var specNamespaceCloneFlags = map[specs.LinuxNamespaceType]int{
+	specs.PIDNamespace:     unix.CLONE_NEWPID,
+	specs.NetworkNamespace: unix.CLONE_NEWNET,
+	specs.MountNamespace:   unix.CLONE_NEWNS,
+	specs.IPCNamespace:     unix.CLONE_NEWIPC,
+	specs.UTSNamespace:     unix.CLONE_NEWUTS,
+	specs.UserNamespace:    unix.CLONE_NEWUSER,
+	specs.CgroupNamespace:  unix.CLONE_NEWCGROUP,
+}
+
+// checkNamespacePath checks that path is a namespace file, as found in
+// /proc/<pid>/ns or bind mounted from there, of the type given by its clone
+// flag.
+func checkNamespacePath(path string, cloneFlag int) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var st unix.Statfs_t
+	if err := unix.Fstatfs(int(f.Fd()), &st); err != nil {
+		return err
+	}
+
+	if st.Type != unix.NSFS_MAGIC {
+		return fmt.Errorf("not a namespace file")
+	}
+
+	// Kernels older than 4.11 cannot tell the type of a namespace.
+	nsType, err := unix.IoctlRetInt(int(f.Fd()), unix.NS_GET_NSTYPE)
+	if err == unix.ENOTTY {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if nsType != cloneFlag {
+		return fmt.Errorf("namespace of another type")
+	}
+
+	return nil
+}
+
+// checkNamespacePaths checks the namespaces of spec which are joined by path
+// rather than created along with the container.
+func checkNamespacePaths(spec *pb.Spec) error {
+	if spec == nil || spec.Linux == nil {
+		return nil
+	}
+
+	for _, ns := range spec.Linux.Namespaces {
+		if ns.Path == "" {
+			continue
+		}
+
+		cloneFlag, ok := specNamespaceCloneFlags[specs.LinuxNamespaceType(ns.Type)]
+		if !ok {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Namespace of type %q cannot be joined by path", ns.Type)
+		}
+
+		if !filepath.IsAbs(ns.Path) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid %s namespace path %q: must be absolute", ns.Type, ns.Path)
+		}
+
+		err := checkNamespacePath(ns.Path, cloneFlag)
+		if os.IsNotExist(err) {
+			return grpcStatus.Errorf(codes.NotFound, "%s namespace path %s not found", ns.Type, ns.Path)
+		} else if err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid %s namespace path %s: %v", ns.Type, ns.Path, err)
+		}
+	}
+
+	return nil
 }
 
 func getCurrentThreadNSPath(nType nsType) string {
@@ -104,3 +187,49 @@ func setupPersistentNs(namespaceType nsType) (*namespace, error) {
 
 	return &namespace{path: nsPath}, nil
 }
+
+// runInNamespace runs fn from a thread which joined the namespace of type
+// nType found at nsPath, switching back to the original namespace of the
+// thread once fn returns.
+func runInNamespace(nsPath string, namespaceType nsType, fn func() error) error {
+	var err error
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go (func() {
+		defer wg.Done()
+		runtime.LockOSThread()
+
+		var origNsFd *os.File
+		origNsFd, err = os.Open(getCurrentThreadNSPath(namespaceType))
+		if err != nil {
+			return
+		}
+		defer origNsFd.Close()
+
+		var nsFd *os.File
+		nsFd, err = os.Open(nsPath)
+		if err != nil {
+			return
+		}
+		defer nsFd.Close()
+
+		if err = unix.Setns(int(nsFd.Fd()), cloneFlagsTable[namespaceType]); err != nil {
+			return
+		}
+
+		fnErr := fn()
+
+		// Switch back to original namespace. If this fails, the thread
+		// is left locked so that it gets terminated with the goroutine.
+		if err = unix.Setns(int(origNsFd.Fd()), cloneFlagsTable[namespaceType]); err != nil {
+			return
+		}
+		runtime.UnlockOSThread()
+
+		err = fnErr
+	})()
+	wg.Wait()
+
+	return err
+}
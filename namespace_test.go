@@ -7,12 +7,18 @@
 package main
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 func TestSetupPersistentNs(t *testing.T) {
@@ -52,3 +58,153 @@ func TestSetupPersistentNs(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCheckNamespacePaths(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpFile, err := ioutil.TempFile("", "ns")
+	assert.NoError(err)
+	tmpFile.Close()
+	defer os.Remove(tmpFile.Name())
+
+	type testData struct {
+		namespaces   []pb.LinuxNamespace
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{nil, codes.OK},
+		{[]pb.LinuxNamespace{{Type: "network"}, {Type: "mount"}}, codes.OK},
+		{[]pb.LinuxNamespace{{Type: "network", Path: "/proc/self/ns/net"}}, codes.OK},
+		{[]pb.LinuxNamespace{{Type: "ipc", Path: "/proc/self/ns/ipc"}, {Type: "uts", Path: "/proc/self/ns/uts"}}, codes.OK},
+		{[]pb.LinuxNamespace{{Type: "network", Path: "/proc/self/ns/ipc"}}, codes.InvalidArgument},
+		{[]pb.LinuxNamespace{{Type: "network", Path: tmpFile.Name()}}, codes.InvalidArgument},
+		{[]pb.LinuxNamespace{{Type: "network", Path: "/proc/self/ns"}}, codes.InvalidArgument},
+		{[]pb.LinuxNamespace{{Type: "network", Path: "proc/self/ns/net"}}, codes.InvalidArgument},
+		{[]pb.LinuxNamespace{{Type: "foo", Path: "/proc/self/ns/net"}}, codes.InvalidArgument},
+		{[]pb.LinuxNamespace{{Type: "network", Path: "/proc/self/ns/missing"}}, codes.NotFound},
+	}
+
+	for i, d := range data {
+		err := checkNamespacePaths(&pb.Spec{Linux: &pb.Linux{Namespaces: d.namespaces}})
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+	}
+
+	assert.NoError(checkNamespacePaths(nil))
+	assert.NoError(checkNamespacePaths(&pb.Spec{}))
+}
+
+func TestJoinNamespacePath(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "netns")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedPersistentNsDir := persistentNsDir
+	persistentNsDir = tmpDir
+	defer func() {
+		persistentNsDir = savedPersistentNsDir
+	}()
+
+	ns, err := setupPersistentNs(nsTypeNet)
+	assert.NoError(err)
+	defer unix.Unmount(ns.path, unix.MNT_DETACH)
+
+	spec := &pb.Spec{Linux: &pb.Linux{Namespaces: []pb.LinuxNamespace{{Type: "network", Path: ns.path}}}}
+	err = checkNamespacePaths(spec)
+	assert.NoError(err)
+
+	var st unix.Stat_t
+	err = unix.Stat(ns.path, &st)
+	assert.NoError(err)
+
+	c, cleanup := createTestContainerWithConfig(t, "test-join-netns", func(config *configs.Config) {
+		config.Namespaces = append(config.Namespaces, configs.Namespace{Type: configs.NEWNET, Path: ns.path})
+	})
+	defer cleanup()
+
+	proc, err := buildProcess(&pb.Process{
+		Args: []string{"readlink", "/proc/self/ns/mnt", "/proc/self/ns/net"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "exec", false)
+	assert.NoError(err)
+
+	paths := strings.Fields(runTestProcess(t, c, proc))
+	assert.Len(paths, 2)
+
+	mntNs, err := os.Readlink("/proc/self/ns/mnt")
+	assert.NoError(err)
+	if len(paths) > 0 && paths[0] == mntNs {
+		t.Skip("Test disabled as containers do not get their own namespaces")
+	}
+
+	assert.Equal([]string{paths[0], fmt.Sprintf("net:[%d]", st.Ino)}, paths)
+}
+
+func TestJoinPidNamespacePath(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	// The PID namespace of a running container is joined by another one.
+	c1, cleanup1 := createTestContainer(t, "test-pidns-1")
+	defer cleanup1()
+
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"sleep", "100"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c1.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	pid, err := initProc.pid()
+	assert.NoError(err)
+
+	path := fmt.Sprintf("/proc/%d/ns/pid", pid)
+	pidNs, err := os.Readlink(path)
+	assert.NoError(err)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			running:    true,
+		},
+	}
+
+	req := &pb.CreateContainerRequest{
+		ContainerId: "test-pidns-2",
+		OCI:         &pb.Spec{Linux: &pb.Linux{Namespaces: []pb.LinuxNamespace{{Type: "pid", Path: path}}}},
+	}
+	err = a.createContainerChecks(req)
+	assert.NoError(err)
+
+	c2, cleanup2 := createTestContainerWithConfig(t, req.ContainerId, func(config *configs.Config) {
+		config.Namespaces = configs.Namespaces{
+			{Type: configs.NEWNS},
+			{Type: configs.NEWPID, Path: path},
+		}
+
+		// The PID namespace path is kept over the sandbox namespaces.
+		a.updateContainerConfigNamespaces(config, &container{id: req.ContainerId})
+		assert.Equal(path, config.Namespaces.PathOf(configs.NEWPID))
+	})
+	defer cleanup2()
+
+	proc, err := buildProcess(&pb.Process{
+		Args: []string{"readlink", "/proc/self/ns/pid"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	assert.Equal(pidNs+"\n", runTestProcess(t, c2, proc))
+}
@@ -7,6 +7,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
@@ -238,7 +239,7 @@ func (s *sandbox) updateInterface(netHandle *netlink.Handle, iface *types.Interf
 	// If the PCI path of the network device is provided,
 	// wait/check for the device to be available first
 	if iface.PciPath != "" {
-		_, err := getPCIDeviceName(s, PciPath{iface.PciPath})
+		_, err := getPCIDeviceName(context.Background(), s, PciPath{iface.PciPath})
 		if err != nil {
 			return nil, err
 		}
@@ -685,8 +686,6 @@ func (s *sandbox) addARPNeighbors(netHandle *netlink.Handle, requestedNeighbors
 /////////
 
 func setupDNS(dns []string) (err error) {
-	var file *os.File
-
 	if len(dns) == 0 {
 		agentLog.Debug("Did not set sandbox DNS as DNS not received as part of grpc request.")
 		return nil
@@ -700,7 +699,18 @@ func setupDNS(dns []string) (err error) {
 	if err := os.MkdirAll(filepath.Dir(kataGuestSandboxDNSFile), 0700); err != nil {
 		return err
 	}
-	if file, err = os.Create(kataGuestSandboxDNSFile); err != nil {
+	if err := writeDNSFile(kataGuestSandboxDNSFile, dns); err != nil {
+		return err
+	}
+
+	return mount(kataGuestSandboxDNSFile, guestDNSFile, "bind", syscall.MS_BIND, "")
+}
+
+// writeDNSFile writes dns to path. The file is rewritten in place, so that
+// the change is seen through its bind mounts.
+func writeDNSFile(path string, dns []string) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
 		return err
 	}
 	defer file.Close()
@@ -717,7 +727,22 @@ func setupDNS(dns []string) (err error) {
 		}
 	}
 
-	return mount(kataGuestSandboxDNSFile, guestDNSFile, "bind", syscall.MS_BIND, "")
+	return nil
+}
+
+// runInNetNamespace runs fn in the network namespace found at nsPath, or in
+// the agent network namespace if nsPath is empty. The agent namespace is
+// restored once fn returns, whether it fails or not.
+func runInNetNamespace(nsPath string, fn func() error) error {
+	if nsPath == "" {
+		return fn()
+	}
+
+	if !filepath.IsAbs(nsPath) {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Network namespace path %q must be absolute", nsPath)
+	}
+
+	return runInNamespace(nsPath, nsTypeNet, fn)
 }
 
 ////////////
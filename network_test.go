@@ -7,6 +7,8 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
@@ -625,3 +627,74 @@ func TestAddARPNeighbors(t *testing.T) {
 	}
 
 }
+
+func TestNetworkRequestsInNetns(t *testing.T) {
+	tearDown := setupNetworkTest(t)
+	defer tearDown()
+
+	assert := assert.New(t)
+
+	// The namespace of the test thread stands for the agent one.
+	agentNs, err := netns.Get()
+	assert.NoError(err)
+	defer agentNs.Close()
+
+	otherNs, err := netns.New()
+	assert.NoError(err)
+	defer otherNs.Close()
+
+	lo, err := netlink.LinkByName("lo")
+	assert.NoError(err)
+	err = netlink.LinkSetUp(lo)
+	assert.NoError(err)
+
+	err = netns.Set(agentNs)
+	assert.NoError(err)
+
+	otherNsPath := fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), int(otherNs))
+
+	a := &agentGRPC{sandbox: &sandbox{}}
+
+	requested := &pb.Routes{
+		Routes: []*types.Route{
+			{Dest: "10.10.0.0/16", Device: "lo", Scope: 253},
+		},
+	}
+
+	_, err = a.UpdateRoutes(context.Background(), &pb.UpdateRoutesRequest{
+		Routes:    requested,
+		NetnsPath: otherNsPath,
+	})
+	assert.NoError(err)
+
+	routes, err := a.ListRoutes(context.Background(), &pb.ListRoutesRequest{NetnsPath: otherNsPath})
+	assert.NoError(err)
+	assert.Len(routes.Routes, 1)
+	assert.Equal("10.10.0.0/16", routes.Routes[0].Dest)
+
+	ifaces, err := a.ListInterfaces(context.Background(), &pb.ListInterfacesRequest{NetnsPath: otherNsPath})
+	assert.NoError(err)
+	assert.Len(ifaces.Interfaces, 1)
+
+	// Failures happen in the requested namespace too.
+	_, err = a.UpdateRoutes(context.Background(), &pb.UpdateRoutesRequest{
+		Routes: &pb.Routes{
+			Routes: []*types.Route{{Dest: "10.20.0.0/16", Device: "does-not-exist"}},
+		},
+		NetnsPath: otherNsPath,
+	})
+	assert.Error(err)
+
+	_, err = a.ListInterfaces(context.Background(), &pb.ListInterfacesRequest{NetnsPath: "relative/path"})
+	assert.Error(err)
+
+	// The agent namespace has been left untouched.
+	currentNs, err := netns.Get()
+	assert.NoError(err)
+	defer currentNs.Close()
+	assert.True(currentNs.Equal(agentNs))
+
+	routes, err = a.ListRoutes(context.Background(), &pb.ListRoutesRequest{})
+	assert.NoError(err)
+	assert.Empty(routes.Routes)
+}
@@ -7,40 +7,131 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 // OCI config file
 const (
 	ociConfigFile     string      = "config.json"
 	ociConfigFileMode os.FileMode = 0444
-	ociConfigBasePath string      = "/run/libcontainer"
 )
 
+// Directory holding the OCI spec of each container, overridden in unit tests.
+var ociConfigBasePath = "/run/libcontainer"
+
+// Error returned when the OCI spec files cannot be written, see
+// setupOCIConfigBasePath().
+var ociConfigBaseErr error
+
+// Error returned when the state of the containers cannot be written, see
+// setupLibcontainerPath().
+var libcontainerPathErr error
+
+// checkWritableDir checks that files can be created in dir, created if
+// needed, by creating and removing a probe file.
+func checkWritableDir(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(dir, ".probe-")
+	if err != nil {
+		return err
+	}
+	f.Close()
+
+	return os.Remove(f.Name())
+}
+
+// setupWritableDir checks that *dir is writable, switching it to
+// ociConfigFallbackPath otherwise, if set. The error returned when no
+// directory is writable tells that what cannot be written there.
+func setupWritableDir(dir *string, what string) error {
+	err := checkWritableDir(*dir)
+	if err == nil {
+		return nil
+	}
+
+	fieldLogger := agentLog.WithError(err).WithFields(logrus.Fields{
+		"path":     *dir,
+		"contents": what,
+	})
+
+	if ociConfigFallbackPath == "" {
+		fieldLogger.Error("Directory not writable and no fallback directory set")
+		return grpcStatus.Errorf(codes.FailedPrecondition, "Cannot write %s to %s: %v", what, *dir, err)
+	}
+
+	fieldLogger = fieldLogger.WithField("fallback-path", ociConfigFallbackPath)
+
+	if fallbackErr := checkWritableDir(ociConfigFallbackPath); fallbackErr != nil {
+		fieldLogger.WithField("fallback-error", fallbackErr).Error("Directory and fallback directory not writable")
+		return grpcStatus.Errorf(codes.FailedPrecondition, "Cannot write %s to %s: %v, nor to the fallback %s: %v",
+			what, *dir, err, ociConfigFallbackPath, fallbackErr)
+	}
+
+	fieldLogger.Warn("Directory not writable, using the fallback directory")
+	*dir = ociConfigFallbackPath
+
+	return nil
+}
+
+// setupOCIConfigBasePath checks that ociConfigBasePath is writable, switching
+// to ociConfigFallbackPath otherwise, if set. When no directory is writable,
+// writing the spec files fails with ociConfigBaseErr.
+func setupOCIConfigBasePath() {
+	ociConfigBaseErr = setupWritableDir(&ociConfigBasePath, "the OCI spec files")
+}
+
+// setupLibcontainerPath checks that libcontainerPath, holding the state of
+// the containers, is writable, switching to ociConfigFallbackPath otherwise,
+// if set. When no directory is writable, the creation of the containers
+// fails with libcontainerPathErr.
+func setupLibcontainerPath() {
+	libcontainerPathErr = setupWritableDir(&libcontainerPath, "the container states")
+}
+
 // writeSpecToFile writes the container's OCI spec to "/run/libcontainer/<container-id>/config.json"
 // Note that the OCI bundle (rootfs) is at a different path
+// The map keys are always sorted, so that a spec is always written the same
+// way, and the JSON is indented if indentSpecFile is set.
 func writeSpecToFile(spec *specs.Spec, containerId string) error {
+	if ociConfigBaseErr != nil {
+		return ociConfigBaseErr
+	}
+
 	configJsonDir := filepath.Join(ociConfigBasePath, containerId)
 	err := os.MkdirAll(configJsonDir, 0700)
 	if err != nil {
 		return err
 	}
 	configPath := filepath.Join(configJsonDir, ociConfigFile)
-	f, err := os.OpenFile(configPath, os.O_WRONLY|os.O_CREATE, ociConfigFileMode)
+	f, err := os.OpenFile(configPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, ociConfigFileMode)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
 
-	return json.NewEncoder(f).Encode(spec)
+	encoder := json.NewEncoder(f)
+	if indentSpecFile {
+		encoder.SetIndent("", "\t")
+	}
+
+	return encoder.Encode(spec)
 }
 
 // changeToBundlePath changes the cwd to the OCI bundle path defined as
@@ -67,6 +158,20 @@ func changeToBundlePath(spec *specs.Spec, containerId string) (string, error) {
 	return cwd, os.Chdir(bundlePath)
 }
 
+// How the hooks found in the guest which are not executable are handled.
+const (
+	// The hooks are skipped.
+	hookNonExecutableReject = "reject"
+	// The scripts starting with a shebang are run through its interpreter,
+	// the other hooks are skipped.
+	hookNonExecutableShebang = "shebang"
+)
+
+// Maximum length of a shebang line, as read by the kernel.
+const maxShebangLength = 256
+
+var errHookNotExecutable = errors.New("is not executable")
+
 func isValidHook(file os.FileInfo) (bool, error) {
 	if file.IsDir() {
 		return false, errors.New("is a directory")
@@ -79,12 +184,66 @@ func isValidHook(file os.FileInfo) (bool, error) {
 
 	perm := mode & os.ModePerm
 	if (perm & 0111) == 0 {
-		return false, errors.New("is not executable")
+		return false, errHookNotExecutable
 	}
 
 	return true, nil
 }
 
+// shebangInterpreter returns the interpreter and its optional argument named
+// by the shebang line of the script at hookPath. The interpreter must be an
+// absolute path to an executable file.
+func shebangInterpreter(hookPath string) ([]string, error) {
+	f, err := os.Open(hookPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	buf := make([]byte, maxShebangLength)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	buf = buf[:n]
+
+	if !bytes.HasPrefix(buf, []byte("#!")) {
+		return nil, errors.New("has no shebang")
+	}
+
+	line := buf[2:]
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	} else if n == maxShebangLength {
+		return nil, errors.New("shebang is too long")
+	}
+
+	// As the kernel does, anything following the interpreter is passed as
+	// a single argument.
+	fields := strings.SplitN(strings.TrimSpace(string(line)), " ", 2)
+	interpreter := fields[0]
+	if !filepath.IsAbs(interpreter) {
+		return nil, fmt.Errorf("shebang interpreter %q is not an absolute path", interpreter)
+	}
+
+	info, err := os.Stat(interpreter)
+	if err != nil {
+		return nil, fmt.Errorf("shebang interpreter %q: %v", interpreter, err)
+	}
+	if !info.Mode().IsRegular() || info.Mode().Perm()&0111 == 0 {
+		return nil, fmt.Errorf("shebang interpreter %q is not an executable file", interpreter)
+	}
+
+	cmd := []string{interpreter}
+	if len(fields) > 1 {
+		if arg := strings.TrimSpace(fields[1]); arg != "" {
+			cmd = append(cmd, arg)
+		}
+	}
+
+	return cmd, nil
+}
+
 // findHooks searches guestHookPath for any OCI hooks for a given hookType
 func findHooks(guestHookPath, hookType string) (hooksFound []specs.Hook) {
 	hooksPath := path.Join(guestHookPath, hookType)
@@ -97,22 +256,54 @@ func findHooks(guestHookPath, hookType string) (hooksFound []specs.Hook) {
 
 	for _, file := range files {
 		name := file.Name()
+		hook := specs.Hook{
+			Path: path.Join(hooksPath, name),
+			Args: []string{name, hookType},
+		}
+
 		if ok, err := isValidHook(file); !ok {
-			agentLog.WithError(err).WithField("oci-hook-name", name).Warn("Skipping hook")
-			continue
+			if err != errHookNotExecutable || hookNonExecutablePolicy != hookNonExecutableShebang {
+				agentLog.WithError(err).WithField("oci-hook-name", name).Warn("Skipping hook")
+				continue
+			}
+
+			interpreter, err := shebangInterpreter(hook.Path)
+			if err != nil {
+				agentLog.WithError(err).WithField("oci-hook-name", name).Warn("Skipping non executable hook")
+				continue
+			}
+
+			hook = specs.Hook{
+				Path: interpreter[0],
+				Args: append(interpreter, hook.Path, hookType),
+			}
 		}
 
 		agentLog.WithFields(logrus.Fields{
 			"oci-hook-name": name,
 			"oci-hook-type": hookType,
 		}).Info("Adding hook")
-		hooksFound = append(hooksFound, specs.Hook{
-			Path: path.Join(hooksPath, name),
-			Args: []string{name, hookType},
-		})
+		hooksFound = append(hooksFound, hook)
 	}
 
 	agentLog.WithField("oci-hook-type", hookType).Infof("Added %d hooks", len(hooksFound))
 
 	return
 }
+
+// checkMountDestinations rejects the spec mounts whose destination is the
+// rootfs itself. The destinations are resolved inside the rootfs by
+// libcontainer when mounting, once the mounts before them are set up.
+func checkMountDestinations(spec *specs.Spec) error {
+	if spec == nil {
+		return nil
+	}
+
+	for _, m := range spec.Mounts {
+		if filepath.Clean("/"+m.Destination) == "/" {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid mount destination %q", m.Destination)
+		}
+	}
+
+	return nil
+}
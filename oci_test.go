@@ -7,13 +7,23 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
 	"testing"
 
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 func TestChangeToBundlePath(t *testing.T) {
@@ -98,3 +108,345 @@ func TestWriteSpecToFile(t *testing.T) {
 
 	assert.True(stat.Size() > 0)
 }
+
+func TestCheckMountDestinations(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		destination string
+		valid       bool
+	}
+
+	data := []testData{
+		{"/proc", true},
+		{"/etc/resolv.conf", true},
+		{"mnt/relative", true},
+		{"/mnt/../../tmp/foo", true},
+		{"/", false},
+		{"/..", false},
+		{"/mnt/..", false},
+		{"", false},
+	}
+
+	for i, d := range data {
+		spec := &specs.Spec{Mounts: []specs.Mount{{Destination: "/dev"}, {Destination: d.destination}}}
+
+		err := checkMountDestinations(spec)
+		if d.valid {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		} else {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		}
+
+		// The spec is left untouched.
+		assert.Equal(d.destination, spec.Mounts[1].Destination, "test %d (%+v)", i, d)
+	}
+
+	assert.NoError(checkMountDestinations(nil))
+}
+
+func TestSetupOCIConfigBasePath(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "oci-config")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedOCIConfigBasePath, savedFallbackPath := ociConfigBasePath, ociConfigFallbackPath
+	defer func() {
+		ociConfigBasePath, ociConfigFallbackPath, ociConfigBaseErr = savedOCIConfigBasePath, savedFallbackPath, nil
+	}()
+
+	// No directory can be created below a regular file.
+	file := filepath.Join(tmpDir, "file")
+	err = ioutil.WriteFile(file, nil, 0644)
+	assert.NoError(err)
+
+	writable := filepath.Join(tmpDir, "writable")
+	fallback := filepath.Join(tmpDir, "fallback")
+	unwritable := filepath.Join(file, "libcontainer")
+
+	type testData struct {
+		base         string
+		fallback     string
+		expectedBase string
+		expectError  bool
+	}
+
+	data := []testData{
+		{writable, "", writable, false},
+		{writable, fallback, writable, false},
+		{unwritable, fallback, fallback, false},
+		{unwritable, "", unwritable, true},
+		{unwritable, filepath.Join(file, "fallback"), unwritable, true},
+	}
+
+	spec := &specs.Spec{Root: &specs.Root{Path: "/rootfs"}}
+
+	for i, d := range data {
+		ociConfigBasePath, ociConfigFallbackPath = d.base, d.fallback
+
+		setupOCIConfigBasePath()
+		assert.Equal(d.expectedBase, ociConfigBasePath, "test %d (%+v)", i, d)
+
+		err := writeSpecToFile(spec, "ctr")
+		if d.expectError {
+			assert.Equal(ociConfigBaseErr, err, "test %d (%+v)", i, d)
+			assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			assert.Contains(err.Error(), d.base, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(ociConfigBaseErr, "test %d (%+v)", i, d)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		// Only the spec is left.
+		files, err := ioutil.ReadDir(d.expectedBase)
+		assert.NoError(err)
+		assert.Len(files, 1, "test %d (%+v)", i, d)
+		_, err = os.Stat(filepath.Join(d.expectedBase, "ctr", ociConfigFile))
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		os.RemoveAll(d.expectedBase)
+	}
+
+	// The containers using hooks are rejected before being set up.
+	ociConfigBasePath, ociConfigFallbackPath = unwritable, ""
+	setupOCIConfigBasePath()
+
+	a := &agentGRPC{sandbox: &sandbox{running: true, guestHooksPresent: true}}
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "ctr", OCI: &pb.Spec{}})
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	a.sandbox.guestHooksPresent = false
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "ctr", OCI: &pb.Spec{}})
+	assert.NoError(err)
+}
+
+func TestSetupLibcontainerPath(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "libcontainer")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedLibcontainerPath, savedFallbackPath := libcontainerPath, ociConfigFallbackPath
+	defer func() {
+		libcontainerPath, ociConfigFallbackPath, libcontainerPathErr = savedLibcontainerPath, savedFallbackPath, nil
+	}()
+
+	// No directory can be created below a regular file.
+	file := filepath.Join(tmpDir, "file")
+	err = ioutil.WriteFile(file, nil, 0644)
+	assert.NoError(err)
+
+	writable := filepath.Join(tmpDir, "writable")
+	fallback := filepath.Join(tmpDir, "fallback")
+	unwritable := filepath.Join(file, "libcontainer")
+
+	type testData struct {
+		path         string
+		fallback     string
+		expectedPath string
+		expectError  bool
+	}
+
+	data := []testData{
+		{writable, "", writable, false},
+		{unwritable, fallback, fallback, false},
+		{unwritable, "", unwritable, true},
+		{unwritable, filepath.Join(file, "fallback"), unwritable, true},
+	}
+
+	a := &agentGRPC{sandbox: &sandbox{running: true}}
+	req := &pb.CreateContainerRequest{ContainerId: "ctr", OCI: &pb.Spec{}}
+
+	for i, d := range data {
+		libcontainerPath, ociConfigFallbackPath = d.path, d.fallback
+
+		setupLibcontainerPath()
+		assert.Equal(d.expectedPath, libcontainerPath, "test %d (%+v)", i, d)
+
+		// The containers are rejected before being set up.
+		err := a.createContainerChecks(req)
+		if d.expectError {
+			assert.Equal(libcontainerPathErr, err, "test %d (%+v)", i, d)
+			assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			assert.Contains(err.Error(), d.path, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(libcontainerPathErr, "test %d (%+v)", i, d)
+		assert.NoError(err, "test %d (%+v)", i, d)
+	}
+}
+
+func TestSetupOCIConfigBasePathReadonly(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "oci-config")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	readonly := filepath.Join(tmpDir, "run")
+	err = os.Mkdir(readonly, 0755)
+	assert.NoError(err)
+	err = syscall.Mount("tmpfs", readonly, "tmpfs", syscall.MS_RDONLY, "")
+	assert.NoError(err)
+	defer syscall.Unmount(readonly, 0)
+
+	savedOCIConfigBasePath, savedFallbackPath := ociConfigBasePath, ociConfigFallbackPath
+	defer func() {
+		ociConfigBasePath, ociConfigFallbackPath, ociConfigBaseErr = savedOCIConfigBasePath, savedFallbackPath, nil
+	}()
+
+	ociConfigBasePath, ociConfigFallbackPath = filepath.Join(readonly, "libcontainer"), ""
+	setupOCIConfigBasePath()
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(ociConfigBaseErr))
+	assert.Contains(ociConfigBaseErr.Error(), "read-only file system")
+
+	// The existing directory is probed too.
+	ociConfigBasePath, ociConfigFallbackPath = readonly, filepath.Join(tmpDir, "fallback")
+	setupOCIConfigBasePath()
+	assert.NoError(ociConfigBaseErr)
+	assert.Equal(filepath.Join(tmpDir, "fallback"), ociConfigBasePath)
+}
+
+func TestWriteSpecToFileIndent(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "spec")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedOCIConfigBasePath, savedIndentSpecFile := ociConfigBasePath, indentSpecFile
+	ociConfigBasePath = tmpDir
+	defer func() {
+		ociConfigBasePath, indentSpecFile = savedOCIConfigBasePath, savedIndentSpecFile
+	}()
+
+	spec := &specs.Spec{
+		Version:     "1.0.0",
+		Root:        &specs.Root{Path: "/rootfs"},
+		Hostname:    "ctr",
+		Annotations: make(map[string]string),
+		Linux: &specs.Linux{
+			Sysctl: map[string]string{"net.ipv4.ip_forward": "1", "kernel.msgmax": "8192"},
+		},
+	}
+	for i := 0; i < 20; i++ {
+		spec.Annotations[fmt.Sprintf("annotation-%d", i)] = fmt.Sprintf("%d", i)
+	}
+
+	readSpec := func(cid string) []byte {
+		data, err := ioutil.ReadFile(filepath.Join(tmpDir, cid, ociConfigFile))
+		assert.NoError(err)
+		return data
+	}
+
+	for i, indent := range []bool{false, true} {
+		indentSpecFile = indent
+
+		// The same spec is always written the same way.
+		var written [][]byte
+		for j := 0; j < 3; j++ {
+			cid := fmt.Sprintf("ctr-%d-%d", i, j)
+			err = writeSpecToFile(spec, cid)
+			assert.NoError(err, "test %d", i)
+			written = append(written, readSpec(cid))
+		}
+		assert.Equal(written[0], written[1], "test %d", i)
+		assert.Equal(written[0], written[2], "test %d", i)
+
+		// A compact spec holds on a single line.
+		assert.Equal(indent, bytes.Count(written[0], []byte("\n")) > 1, "test %d", i)
+		assert.Equal(indent, bytes.Contains(written[0], []byte("\n\t")), "test %d", i)
+
+		var parsed specs.Spec
+		err = json.Unmarshal(written[0], &parsed)
+		assert.NoError(err, "test %d", i)
+		assert.Equal(*spec, parsed, "test %d", i)
+	}
+
+	// The map keys are sorted.
+	content := string(readSpec("ctr-1-0"))
+	assert.True(strings.Index(content, "kernel.msgmax") < strings.Index(content, "net.ipv4.ip_forward"))
+	assert.True(strings.Index(content, "annotation-0") < strings.Index(content, "annotation-1"))
+}
+
+func TestFindHooksNonExecutable(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "hooks")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedPolicy := hookNonExecutablePolicy
+	defer func() {
+		hookNonExecutablePolicy = savedPolicy
+	}()
+
+	hooksPath := filepath.Join(tmpDir, "prestart")
+	err = os.Mkdir(hooksPath, 0755)
+	assert.NoError(err)
+
+	output := filepath.Join(tmpDir, "output")
+
+	type hookFile struct {
+		name    string
+		content string
+		mode    os.FileMode
+	}
+
+	files := []hookFile{
+		{"a-executable", "#!/bin/sh\n", 0755},
+		{"b-shebang", "#!/bin/sh\necho \"$0 $1\" > " + output + "\n", 0644},
+		{"c-shebang-argument", "#!/bin/sh -e\n", 0644},
+		{"d-opaque", "\x7fELF\x02\x01\x01", 0644},
+		{"e-missing-interpreter", "#!/nonexistent/sh\n", 0644},
+		{"f-relative-interpreter", "#!sh\n", 0644},
+		{"g-empty", "", 0644},
+	}
+
+	for _, f := range files {
+		err = ioutil.WriteFile(filepath.Join(hooksPath, f.name), []byte(f.content), f.mode)
+		assert.NoError(err)
+	}
+
+	executable := specs.Hook{
+		Path: filepath.Join(hooksPath, "a-executable"),
+		Args: []string{"a-executable", "prestart"},
+	}
+
+	// Non executable hooks are skipped by default.
+	hookNonExecutablePolicy = hookNonExecutableReject
+	hooks := findHooks(tmpDir, "prestart")
+	assert.Equal([]specs.Hook{executable}, hooks)
+
+	// Only the scripts with a valid shebang are run through their
+	// interpreter.
+	hookNonExecutablePolicy = hookNonExecutableShebang
+	hooks = findHooks(tmpDir, "prestart")
+	assert.Equal([]specs.Hook{
+		executable,
+		{
+			Path: "/bin/sh",
+			Args: []string{"/bin/sh", filepath.Join(hooksPath, "b-shebang"), "prestart"},
+		},
+		{
+			Path: "/bin/sh",
+			Args: []string{"/bin/sh", "-e", filepath.Join(hooksPath, "c-shebang-argument"), "prestart"},
+		},
+	}, hooks)
+
+	// The hook gets the same arguments as if it was executable.
+	cmd := configs.Command{Path: hooks[1].Path, Args: hooks[1].Args}
+	err = cmd.Run(&specs.State{ID: "ctr"})
+	assert.NoError(err)
+
+	content, err := ioutil.ReadFile(output)
+	assert.NoError(err)
+	assert.Equal(filepath.Join(hooksPath, "b-shebang")+" prestart\n", string(content))
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Argument of personality() querying the current personality.
+const personalityQuery = 0xffffffff
+
+// Linux execution domains, see include/uapi/linux/personality.h.
+var personalityDomains = map[string]uint32{
+	"LINUX":   0x0000,
+	"LINUX32": 0x0008,
+}
+
+// Linux personality flags, see include/uapi/linux/personality.h.
+var personalityFlags = map[string]uint32{
+	"UNAME26":            0x0020000,
+	"ADDR_NO_RANDOMIZE":  0x0040000,
+	"FDPIC_FUNCPTRS":     0x0080000,
+	"MMAP_PAGE_ZERO":     0x0100000,
+	"ADDR_COMPAT_LAYOUT": 0x0200000,
+	"READ_IMPLIES_EXEC":  0x0400000,
+	"ADDR_LIMIT_32BIT":   0x0800000,
+	"SHORT_INODE":        0x1000000,
+	"WHOLE_SECONDS":      0x2000000,
+	"STICKY_TIMEOUTS":    0x4000000,
+	"ADDR_LIMIT_3GB":     0x8000000,
+}
+
+// personalityArg returns the argument of personality() matching the
+// personality requested by spec, and whether one is requested at all.
+func personalityArg(spec *pb.Spec) (uint32, bool, error) {
+	if spec == nil || spec.Linux == nil || spec.Linux.Personality == nil {
+		return 0, false, nil
+	}
+
+	p := spec.Linux.Personality
+
+	persona, ok := personalityDomains[p.Domain]
+	if !ok {
+		return 0, false, grpcStatus.Errorf(codes.InvalidArgument, "Invalid personality domain %q", p.Domain)
+	}
+
+	for _, flag := range p.Flags {
+		value, ok := personalityFlags[flag]
+		if !ok {
+			return 0, false, grpcStatus.Errorf(codes.InvalidArgument, "Invalid personality flag %q", flag)
+		}
+		persona |= value
+	}
+
+	return persona, true, nil
+}
+
+func personality(persona uint32) (uint32, error) {
+	old, _, errno := unix.RawSyscall(unix.SYS_PERSONALITY, uintptr(persona), 0, 0)
+	if errno != 0 {
+		return 0, errno
+	}
+
+	return uint32(old), nil
+}
+
+// runWithPersonality runs fn, which starts the container init, from the
+// current thread switched to the personality persona, inherited by the
+// processes it spawns. The previous personality is restored afterwards, or
+// the thread is left locked so that it gets terminated with its goroutine.
+func runWithPersonality(persona uint32, fn func() error) error {
+	runtime.LockOSThread()
+
+	old, err := personality(personalityQuery)
+	if err != nil {
+		runtime.UnlockOSThread()
+		return fmt.Errorf("failed to get personality: %v", err)
+	}
+
+	if _, err := personality(persona); err != nil {
+		runtime.UnlockOSThread()
+		return fmt.Errorf("failed to set personality %#x: %v", persona, err)
+	}
+
+	fnErr := fn()
+
+	if _, err := personality(old); err != nil {
+		agentLog.WithError(err).Warn("failed to restore personality")
+		return fnErr
+	}
+
+	runtime.UnlockOSThread()
+
+	return fnErr
+}
+
+// startWithPersonality runs fn, which starts the container init, with the
+// personality requested by spec, if any.
+func startWithPersonality(spec *pb.Spec, fn func() error) error {
+	persona, requested, err := personalityArg(spec)
+	if err != nil {
+		return err
+	}
+
+	if !requested {
+		return fn()
+	}
+
+	return runWithPersonality(persona, fn)
+}
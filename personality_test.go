@@ -0,0 +1,92 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestPersonalityArg(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		personality *pb.LinuxPersonality
+		expected    uint32
+		requested   bool
+		shouldErr   bool
+	}
+
+	data := []testData{
+		{nil, 0, false, false},
+		{&pb.LinuxPersonality{Domain: "LINUX"}, 0, true, false},
+		{&pb.LinuxPersonality{Domain: "LINUX32"}, 0x0008, true, false},
+		{&pb.LinuxPersonality{Domain: "LINUX", Flags: []string{"ADDR_NO_RANDOMIZE"}}, 0x0040000, true, false},
+		{&pb.LinuxPersonality{Domain: "LINUX32", Flags: []string{"ADDR_NO_RANDOMIZE", "ADDR_LIMIT_3GB"}}, 0x8040008, true, false},
+		{&pb.LinuxPersonality{}, 0, false, true},
+		{&pb.LinuxPersonality{Domain: "SVR4"}, 0, false, true},
+		{&pb.LinuxPersonality{Domain: "LINUX", Flags: []string{"addr_no_randomize"}}, 0, false, true},
+	}
+
+	for i, d := range data {
+		spec := &pb.Spec{Linux: &pb.Linux{Personality: d.personality}}
+
+		persona, requested, err := personalityArg(spec)
+		if d.shouldErr {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expected, persona, "test %d (%+v)", i, d)
+		assert.Equal(d.requested, requested, "test %d (%+v)", i, d)
+	}
+
+	_, requested, err := personalityArg(&pb.Spec{})
+	assert.NoError(err)
+	assert.False(requested)
+}
+
+func TestStartWithPersonality(t *testing.T) {
+	assert := assert.New(t)
+
+	spec := &pb.Spec{
+		Linux: &pb.Linux{
+			Personality: &pb.LinuxPersonality{
+				Domain: "LINUX",
+				Flags:  []string{"ADDR_NO_RANDOMIZE"},
+			},
+		},
+	}
+
+	var current, before uint32
+	var err error
+
+	err = startWithPersonality(spec, func() error {
+		current, err = personality(personalityQuery)
+		return err
+	})
+	assert.NoError(err)
+	assert.Equal(uint32(0x0040000), current&0x0040000)
+
+	err = startWithPersonality(&pb.Spec{}, func() error {
+		before, err = personality(personalityQuery)
+		return err
+	})
+	assert.NoError(err)
+	assert.Zero(before & 0x0040000)
+
+	spec.Linux.Personality.Flags = []string{"FOO"}
+	err = startWithPersonality(spec, func() error {
+		return nil
+	})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
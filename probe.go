@@ -0,0 +1,161 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	defaultProbeTimeout = 30 * time.Second
+
+	probeInitialInterval = 10 * time.Millisecond
+	probeMaxInterval     = 500 * time.Millisecond
+	probeDialTimeout     = time.Second
+)
+
+// set function in variable to overwrite for testing.
+var getContainerNsPath = getContainerNsPathImpl
+
+// getContainerNsPathImpl returns the path of the namespace of type nType
+// the container init process belongs to.
+func getContainerNsPathImpl(ctr *container, nType nsType) (string, error) {
+	if ctr.initProcess == nil {
+		return "", grpcStatus.Errorf(codes.FailedPrecondition, "Container %s has no init process", ctr.id)
+	}
+
+	pid, err := ctr.initProcess.pid()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("/proc/%d/ns/%s", pid, nType), nil
+}
+
+// probeFile checks whether path exists inside the container.
+func probeFile(ctr *container, path string) error {
+	root, err := getContainerRoot(ctr)
+	if err != nil {
+		return err
+	}
+
+	fullPath, err := securejoin.SecureJoin(root, path)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stat(fullPath)
+	return err
+}
+
+// probeTCP checks whether a TCP connection to address can be established
+// from the network namespace of the container.
+func probeTCP(ctr *container, address string) error {
+	nsPath, err := getContainerNsPath(ctr, nsTypeNet)
+	if err != nil {
+		return err
+	}
+
+	return runInNamespace(nsPath, nsTypeNet, func() error {
+		conn, err := net.DialTimeout("tcp", address, probeDialTimeout)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	})
+}
+
+// checkReadinessProbe validates probe, which may be nil.
+func checkReadinessProbe(probe *pb.ReadinessProbe) error {
+	if probe == nil {
+		return nil
+	}
+
+	switch {
+	case probe.FilePath != "" && probe.TcpAddress != "":
+		return grpcStatus.Error(codes.InvalidArgument, "Readiness probe cannot check both a file and a TCP address")
+	case probe.FilePath != "":
+		return nil
+	case probe.TcpAddress != "":
+		if _, _, err := net.SplitHostPort(probe.TcpAddress); err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid readiness probe address %q: %v", probe.TcpAddress, err)
+		}
+		return nil
+	default:
+		return grpcStatus.Error(codes.InvalidArgument, "Readiness probe has no file path nor TCP address")
+	}
+}
+
+// waitForReadiness polls the condition described by probe until it is met,
+// the probe times out or ctx is cancelled.
+func waitForReadiness(ctx context.Context, ctr *container, probe *pb.ReadinessProbe) error {
+	if probe == nil {
+		return nil
+	}
+
+	if err := checkReadinessProbe(probe); err != nil {
+		return err
+	}
+
+	target := probe.FilePath
+	check := func() error { return probeFile(ctr, probe.FilePath) }
+
+	if probe.TcpAddress != "" {
+		target = probe.TcpAddress
+		check = func() error { return probeTCP(ctr, probe.TcpAddress) }
+	}
+
+	timeout := defaultProbeTimeout
+	if probe.Timeout > 0 {
+		timeout = time.Duration(probe.Timeout) * time.Second
+	}
+
+	fieldLogger := agentLog.WithFields(logrus.Fields{
+		"container":     ctr.id,
+		"probe-target":  target,
+		"probe-timeout": timeout,
+	})
+	fieldLogger.Debug("waiting for container readiness")
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	b := newBackoff(probeInitialInterval, probeMaxInterval)
+
+	for {
+		err := check()
+		if err == nil {
+			fieldLogger.Info("container ready")
+			return nil
+		}
+
+		poll := time.NewTimer(b.next())
+
+		select {
+		case <-poll.C:
+		case <-ctx.Done():
+			poll.Stop()
+			return grpcStatus.Errorf(codes.Canceled,
+				"Stopped waiting for container %s readiness: %v", ctr.id, ctx.Err())
+		case <-deadline.C:
+			poll.Stop()
+			return grpcStatus.Errorf(codes.DeadlineExceeded,
+				"Container %s not ready after %s (%s): %v", ctr.id, timeout, target, err)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestCheckReadinessProbe(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(checkReadinessProbe(nil))
+	assert.NoError(checkReadinessProbe(&pb.ReadinessProbe{FilePath: "/ready"}))
+	assert.NoError(checkReadinessProbe(&pb.ReadinessProbe{TcpAddress: "127.0.0.1:8080"}))
+
+	invalid := []*pb.ReadinessProbe{
+		{},
+		{Timeout: 3},
+		{FilePath: "/ready", TcpAddress: "127.0.0.1:8080"},
+		{TcpAddress: "127.0.0.1"},
+	}
+
+	for i, probe := range invalid {
+		err := checkReadinessProbe(probe)
+		assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, probe)
+	}
+}
+
+func TestWaitForReadinessFile(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "probe")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	savedGetContainerRoot := getContainerRoot
+	getContainerRoot = func(ctr *container) (string, error) {
+		return root, nil
+	}
+	defer func() {
+		getContainerRoot = savedGetContainerRoot
+	}()
+
+	ctr := &container{id: testContainerID}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		os.MkdirAll(filepath.Join(root, "run"), testDirMode)
+		ioutil.WriteFile(filepath.Join(root, "run", "ready"), nil, testFileMode)
+	}()
+
+	err = waitForReadiness(context.Background(), ctr, &pb.ReadinessProbe{FilePath: "/run/ready", Timeout: 5})
+	assert.NoError(err)
+
+	start := time.Now()
+	err = waitForReadiness(context.Background(), ctr, &pb.ReadinessProbe{FilePath: "/run/never", Timeout: 1})
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+	assert.True(time.Since(start) >= time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	err = waitForReadiness(ctx, ctr, &pb.ReadinessProbe{FilePath: "/run/never", Timeout: 60})
+	assert.Equal(codes.Canceled, grpcStatus.Code(err))
+}
+
+func TestWaitForReadinessTCP(t *testing.T) {
+	tearDown := setupNetworkTest(t)
+	defer tearDown()
+
+	assert := assert.New(t)
+
+	lo, err := netlink.LinkByName("lo")
+	assert.NoError(err)
+	err = netlink.LinkSetUp(lo)
+	assert.NoError(err)
+
+	// The probe must join the namespace the listener is created in.
+	nsPath := getCurrentThreadNSPath(nsTypeNet)
+
+	savedGetContainerNsPath := getContainerNsPath
+	getContainerNsPath = func(ctr *container, nType nsType) (string, error) {
+		return nsPath, nil
+	}
+	defer func() {
+		getContainerNsPath = savedGetContainerNsPath
+	}()
+
+	ctr := &container{id: testContainerID}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+	address := l.Addr().String()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	err = waitForReadiness(context.Background(), ctr, &pb.ReadinessProbe{TcpAddress: address, Timeout: 5})
+	assert.NoError(err)
+
+	// Nothing listens anymore.
+	l.Close()
+
+	err = waitForReadiness(context.Background(), ctr, &pb.ReadinessProbe{TcpAddress: address, Timeout: 1})
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+}
+
+func TestStartContainerInvalidReadinessProbe(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				testContainerID: {
+					id: testContainerID,
+					container: &mockContainer{
+						status: libcontainer.Created,
+					},
+				},
+			},
+			running: true,
+		},
+	}
+
+	_, err := a.StartContainer(context.Background(), &pb.StartContainerRequest{
+		ContainerId:    testContainerID,
+		ReadinessProbe: &pb.ReadinessProbe{},
+	})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
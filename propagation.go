@@ -0,0 +1,71 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"path/filepath"
+
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Propagation applied to the container rootfs when the spec does not specify
+// one.
+const defaultRootfsPropagation = "rprivate"
+
+// rootfsPropagationFlags maps the rootfsPropagation values of the spec to
+// the flags of the recursive propagation mount applied to the rootfs.
+var rootfsPropagationFlags = map[string]uintptr{
+	"shared":      unix.MS_SHARED | unix.MS_REC,
+	"rshared":     unix.MS_SHARED | unix.MS_REC,
+	"slave":       unix.MS_SLAVE | unix.MS_REC,
+	"rslave":      unix.MS_SLAVE | unix.MS_REC,
+	"private":     unix.MS_PRIVATE | unix.MS_REC,
+	"rprivate":    unix.MS_PRIVATE | unix.MS_REC,
+	"unbindable":  unix.MS_UNBINDABLE | unix.MS_REC,
+	"runbindable": unix.MS_UNBINDABLE | unix.MS_REC,
+}
+
+// mountPropagation changes the propagation of the mount at target, overridden
+// in unit tests.
+var mountPropagation = func(target string, flags uintptr) error {
+	return unix.Mount("", target, "", flags, "")
+}
+
+// setupRootfsPropagation applies the rootfsPropagation of spec, or
+// defaultRootfsPropagation, to the mounted rootfs of the container. Nothing
+// is done if the rootfs is not a mount point.
+func setupRootfsPropagation(spec *specs.Spec) error {
+	propagation := defaultRootfsPropagation
+	if spec.Linux != nil && spec.Linux.RootfsPropagation != "" {
+		propagation = spec.Linux.RootfsPropagation
+	}
+
+	flags, ok := rootfsPropagationFlags[propagation]
+	if !ok {
+		return grpcStatus.Errorf(codes.InvalidArgument, "rootfsPropagation %q is not supported", propagation)
+	}
+
+	if spec.Root == nil || spec.Root.Path == "" {
+		return nil
+	}
+
+	rootfs := filepath.Clean(spec.Root.Path)
+	mounted, err := mountinfo.Mounted(rootfs)
+	if err != nil {
+		return err
+	}
+	if !mounted {
+		agentLog.WithField("rootfs", rootfs).Debug("rootfs is not a mount point, not changing its propagation")
+		return nil
+	}
+
+	return mountPropagation(rootfs, flags)
+}
@@ -0,0 +1,117 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestSetupRootfsPropagation(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	rootfs, err := ioutil.TempDir("", "rootfs")
+	assert.NoError(err)
+	defer os.RemoveAll(rootfs)
+
+	err = unix.Mount("tmpfs", rootfs, "tmpfs", 0, "")
+	assert.NoError(err)
+	defer unix.Unmount(rootfs, unix.MNT_DETACH)
+
+	savedMountPropagation := mountPropagation
+	defer func() {
+		mountPropagation = savedMountPropagation
+	}()
+
+	var target string
+	var flags uintptr
+	mountPropagation = func(t string, f uintptr) error {
+		target, flags = t, f
+		return nil
+	}
+
+	type testData struct {
+		propagation   string
+		expectedFlags uintptr
+		expectedCode  codes.Code
+	}
+
+	data := []testData{
+		{"", unix.MS_PRIVATE | unix.MS_REC, codes.OK},
+		{"private", unix.MS_PRIVATE | unix.MS_REC, codes.OK},
+		{"rprivate", unix.MS_PRIVATE | unix.MS_REC, codes.OK},
+		{"shared", unix.MS_SHARED | unix.MS_REC, codes.OK},
+		{"rshared", unix.MS_SHARED | unix.MS_REC, codes.OK},
+		{"slave", unix.MS_SLAVE | unix.MS_REC, codes.OK},
+		{"rslave", unix.MS_SLAVE | unix.MS_REC, codes.OK},
+		{"unbindable", unix.MS_UNBINDABLE | unix.MS_REC, codes.OK},
+		{"bogus", 0, codes.InvalidArgument},
+	}
+
+	for i, d := range data {
+		target, flags = "", 0
+
+		spec := &specs.Spec{
+			Root:  &specs.Root{Path: rootfs + "/"},
+			Linux: &specs.Linux{RootfsPropagation: d.propagation},
+		}
+
+		err := setupRootfsPropagation(spec)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.Equal(d.expectedFlags, flags, "test %d (%+v)", i, d)
+		if d.expectedCode == codes.OK {
+			assert.Equal(rootfs, target, "test %d (%+v)", i, d)
+		}
+	}
+
+	// The default propagation applies to a spec without Linux section.
+	target, flags = "", 0
+	err = setupRootfsPropagation(&specs.Spec{Root: &specs.Root{Path: rootfs}})
+	assert.NoError(err)
+	assert.Equal(uintptr(unix.MS_PRIVATE|unix.MS_REC), flags)
+
+	// Nothing is done if the rootfs is not a mount point.
+	dir, err := ioutil.TempDir("", "rootfs")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	target, flags = "", 0
+	err = setupRootfsPropagation(&specs.Spec{Root: &specs.Root{Path: dir}})
+	assert.NoError(err)
+	assert.Empty(target)
+
+	// The propagation is actually changed.
+	mountPropagation = savedMountPropagation
+
+	err = setupRootfsPropagation(&specs.Spec{
+		Root:  &specs.Root{Path: rootfs},
+		Linux: &specs.Linux{RootfsPropagation: "shared"},
+	})
+	assert.NoError(err)
+
+	mounts, err := mountinfo.GetMounts()
+	assert.NoError(err)
+
+	var optional string
+	for _, m := range mounts {
+		if m.Mountpoint == rootfs {
+			optional = m.Optional
+		}
+	}
+	assert.True(strings.HasPrefix(optional, "shared:"), "optional fields %q", optional)
+}
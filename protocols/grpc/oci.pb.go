@@ -7,7 +7,7 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
-import _ "github.com/gogo/protobuf/types"
+import google_protobuf1 "github.com/gogo/protobuf/types"
 
 import io "io"
 
@@ -37,6 +37,8 @@ type Spec struct {
 	Solaris *Solaris `protobuf:"bytes,9,opt,name=Solaris" json:"Solaris,omitempty"`
 	// Windows is platform-specific configuration for Windows based containers.
 	Windows *Windows `protobuf:"bytes,10,opt,name=Windows" json:"Windows,omitempty"`
+	// Domainname configures the container's domainname.
+	Domainname string `protobuf:"bytes,11,opt,name=Domainname,proto3" json:"Domainname,omitempty"`
 }
 
 func (m *Spec) Reset()                    { *m = Spec{} }
@@ -114,6 +116,13 @@ func (m *Spec) GetWindows() *Windows {
 	return nil
 }
 
+func (m *Spec) GetDomainname() string {
+	if m != nil {
+		return m.Domainname
+	}
+	return ""
+}
+
 type Process struct {
 	// Terminal creates an interactive terminal for the container.
 	Terminal bool `protobuf:"varint,1,opt,name=Terminal,proto3" json:"Terminal,omitempty"`
@@ -140,6 +149,9 @@ type Process struct {
 	OOMScoreAdj int64 `protobuf:"varint,11,opt,name=OOMScoreAdj,proto3" json:"OOMScoreAdj,omitempty"`
 	// SelinuxLabel specifies the selinux context that the container process is run as.
 	SelinuxLabel string `protobuf:"bytes,12,opt,name=SelinuxLabel,proto3" json:"SelinuxLabel,omitempty"`
+	// Path is the binary to execute, looked up like Args[0], which is then
+	// only used as argv[0]. Args[0] is executed if not set.
+	Path string `protobuf:"bytes,13,opt,name=Path,proto3" json:"Path,omitempty"`
 }
 
 func (m *Process) Reset()                    { *m = Process{} }
@@ -231,6 +243,13 @@ func (m *Process) GetSelinuxLabel() string {
 	return ""
 }
 
+func (m *Process) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 type Box struct {
 	// Height is the vertical dimension of a box.
 	Height uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
@@ -266,6 +285,9 @@ type User struct {
 	AdditionalGids []uint32 `protobuf:"varint,3,rep,packed,name=AdditionalGids" json:"AdditionalGids,omitempty"`
 	// Username is the user name.
 	Username string `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	// Umask is the umask of the init process, the agent default being
+	// used when unset.
+	Umask *google_protobuf1.UInt32Value `protobuf:"bytes,5,opt,name=Umask" json:"Umask,omitempty"`
 }
 
 func (m *User) Reset()                    { *m = User{} }
@@ -301,6 +323,13 @@ func (m *User) GetUsername() string {
 	return ""
 }
 
+func (m *User) GetUmask() *google_protobuf1.UInt32Value {
+	if m != nil {
+		return m.Umask
+	}
+	return nil
+}
+
 type LinuxCapabilities struct {
 	// Bounding is the set of capabilities checked by the kernel.
 	Bounding []string `protobuf:"bytes,1,rep,name=Bounding" json:"Bounding,omitempty"`
@@ -566,6 +595,12 @@ type Linux struct {
 	// IntelRdt contains Intel Resource Director Technology (RDT) information
 	// for handling resource constraints (e.g., L3 cache) for the container
 	IntelRdt *LinuxIntelRdt `protobuf:"bytes,13,opt,name=IntelRdt" json:"IntelRdt,omitempty"`
+	// TimeOffsets specifies the offsets of the clocks in the time
+	// namespace of the container, keyed by clock name.
+	TimeOffsets map[string]*LinuxTimeOffset `protobuf:"bytes,14,rep,name=TimeOffsets" json:"TimeOffsets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	// Personality contains the execution domain and flags of the
+	// container processes.
+	Personality *LinuxPersonality `protobuf:"bytes,15,opt,name=Personality" json:"Personality,omitempty"`
 }
 
 func (m *Linux) Reset()                    { *m = Linux{} }
@@ -664,6 +699,20 @@ func (m *Linux) GetIntelRdt() *LinuxIntelRdt {
 	return nil
 }
 
+func (m *Linux) GetTimeOffsets() map[string]*LinuxTimeOffset {
+	if m != nil {
+		return m.TimeOffsets
+	}
+	return nil
+}
+
+func (m *Linux) GetPersonality() *LinuxPersonality {
+	if m != nil {
+		return m.Personality
+	}
+	return nil
+}
+
 type Windows struct {
 	// Dummy string, never used.
 	Dummy string `protobuf:"bytes,1,opt,name=dummy,proto3" json:"dummy,omitempty"`
@@ -846,6 +895,9 @@ type LinuxResources struct {
 	HugepageLimits []LinuxHugepageLimit `protobuf:"bytes,6,rep,name=HugepageLimits" json:"HugepageLimits"`
 	// Network restriction configuration
 	Network *LinuxNetwork `protobuf:"bytes,7,opt,name=Network" json:"Network,omitempty"`
+	// Unified are the raw key/values written to the cgroup v2 files of the
+	// container, e.g. "memory.high".
+	Unified map[string]string `protobuf:"bytes,8,rep,name=Unified" json:"Unified,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *LinuxResources) Reset()                    { *m = LinuxResources{} }
@@ -902,6 +954,13 @@ func (m *LinuxResources) GetNetwork() *LinuxNetwork {
 	return nil
 }
 
+func (m *LinuxResources) GetUnified() map[string]string {
+	if m != nil {
+		return m.Unified
+	}
+	return nil
+}
+
 type LinuxMemory struct {
 	// Memory limit (in bytes).
 	Limit int64 `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
@@ -1450,6 +1509,11 @@ type LinuxIntelRdt struct {
 	// The schema for L3 cache id and capacity bitmask (CBM)
 	// Format: "L3:<cache_id0>=<cbm0>;<cache_id1>=<cbm1>;..."
 	L3CacheSchema string `protobuf:"bytes,1,opt,name=L3CacheSchema,proto3" json:"L3CacheSchema,omitempty"`
+	// The schema of memory bandwidth per L3 cache id
+	// Format: "MB:<cache_id0>=bandwidth0;<cache_id1>=bandwidth1;..."
+	MemBwSchema string `protobuf:"bytes,2,opt,name=MemBwSchema,proto3" json:"MemBwSchema,omitempty"`
+	// The identity for RDT Class of Service
+	ClosID string `protobuf:"bytes,3,opt,name=ClosID,proto3" json:"ClosID,omitempty"`
 }
 
 func (m *LinuxIntelRdt) Reset()                    { *m = LinuxIntelRdt{} }
@@ -1464,6 +1528,72 @@ func (m *LinuxIntelRdt) GetL3CacheSchema() string {
 	return ""
 }
 
+func (m *LinuxIntelRdt) GetMemBwSchema() string {
+	if m != nil {
+		return m.MemBwSchema
+	}
+	return ""
+}
+
+func (m *LinuxIntelRdt) GetClosID() string {
+	if m != nil {
+		return m.ClosID
+	}
+	return ""
+}
+
+type LinuxTimeOffset struct {
+	// Secs is the offset of the clock in seconds
+	Secs int64 `protobuf:"varint,1,opt,name=Secs,proto3" json:"Secs,omitempty"`
+	// Nanosecs is the additional offset of the clock in nanoseconds
+	Nanosecs uint32 `protobuf:"varint,2,opt,name=Nanosecs,proto3" json:"Nanosecs,omitempty"`
+}
+
+func (m *LinuxTimeOffset) Reset()                    { *m = LinuxTimeOffset{} }
+func (m *LinuxTimeOffset) String() string            { return proto.CompactTextString(m) }
+func (*LinuxTimeOffset) ProtoMessage()               {}
+func (*LinuxTimeOffset) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{31} }
+
+func (m *LinuxTimeOffset) GetSecs() int64 {
+	if m != nil {
+		return m.Secs
+	}
+	return 0
+}
+
+func (m *LinuxTimeOffset) GetNanosecs() uint32 {
+	if m != nil {
+		return m.Nanosecs
+	}
+	return 0
+}
+
+type LinuxPersonality struct {
+	// Domain is the execution domain, "LINUX" or "LINUX32"
+	Domain string `protobuf:"bytes,1,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	// Flags are the additional personality flags, like "ADDR_NO_RANDOMIZE"
+	Flags []string `protobuf:"bytes,2,rep,name=Flags" json:"Flags,omitempty"`
+}
+
+func (m *LinuxPersonality) Reset()                    { *m = LinuxPersonality{} }
+func (m *LinuxPersonality) String() string            { return proto.CompactTextString(m) }
+func (*LinuxPersonality) ProtoMessage()               {}
+func (*LinuxPersonality) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{32} }
+
+func (m *LinuxPersonality) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *LinuxPersonality) GetFlags() []string {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Spec)(nil), "grpc.Spec")
 	proto.RegisterType((*Process)(nil), "grpc.Process")
@@ -1496,6 +1626,8 @@ func init() {
 	proto.RegisterType((*LinuxSeccompArg)(nil), "grpc.LinuxSeccompArg")
 	proto.RegisterType((*LinuxSyscall)(nil), "grpc.LinuxSyscall")
 	proto.RegisterType((*LinuxIntelRdt)(nil), "grpc.LinuxIntelRdt")
+	proto.RegisterType((*LinuxTimeOffset)(nil), "grpc.LinuxTimeOffset")
+	proto.RegisterType((*LinuxPersonality)(nil), "grpc.LinuxPersonality")
 }
 func (this *Spec) Equal(that interface{}) bool {
 	if that == nil {
@@ -1556,6 +1688,9 @@ func (this *Spec) Equal(that interface{}) bool {
 	if !this.Windows.Equal(that1.Windows) {
 		return false
 	}
+	if this.Domainname != that1.Domainname {
+		return false
+	}
 	return true
 }
 func (this *Process) Equal(that interface{}) bool {
@@ -1628,6 +1763,9 @@ func (this *Process) Equal(that interface{}) bool {
 	if this.SelinuxLabel != that1.SelinuxLabel {
 		return false
 	}
+	if this.Path != that1.Path {
+		return false
+	}
 	return true
 }
 func (this *Box) Equal(that interface{}) bool {
@@ -1693,6 +1831,9 @@ func (this *User) Equal(that interface{}) bool {
 	if this.Username != that1.Username {
 		return false
 	}
+	if !this.Umask.Equal(that1.Umask) {
+		return false
+	}
 	return true
 }
 func (this *LinuxCapabilities) Equal(that interface{}) bool {
@@ -2032,6 +2173,17 @@ func (this *Linux) Equal(that interface{}) bool {
 	if !this.IntelRdt.Equal(that1.IntelRdt) {
 		return false
 	}
+	if len(this.TimeOffsets) != len(that1.TimeOffsets) {
+		return false
+	}
+	for i := range this.TimeOffsets {
+		if !this.TimeOffsets[i].Equal(that1.TimeOffsets[i]) {
+			return false
+		}
+	}
+	if !this.Personality.Equal(that1.Personality) {
+		return false
+	}
 	return true
 }
 func (this *Windows) Equal(that interface{}) bool {
@@ -2231,6 +2383,14 @@ func (this *LinuxResources) Equal(that interface{}) bool {
 	if !this.Network.Equal(that1.Network) {
 		return false
 	}
+	if len(this.Unified) != len(that1.Unified) {
+		return false
+	}
+	for i := range this.Unified {
+		if this.Unified[i] != that1.Unified[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *LinuxMemory) Equal(that interface{}) bool {
@@ -2728,6 +2888,71 @@ func (this *LinuxIntelRdt) Equal(that interface{}) bool {
 	if this.L3CacheSchema != that1.L3CacheSchema {
 		return false
 	}
+	if this.MemBwSchema != that1.MemBwSchema {
+		return false
+	}
+	if this.ClosID != that1.ClosID {
+		return false
+	}
+	return true
+}
+func (this *LinuxTimeOffset) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LinuxTimeOffset)
+	if !ok {
+		that2, ok := that.(LinuxTimeOffset)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Secs != that1.Secs {
+		return false
+	}
+	if this.Nanosecs != that1.Nanosecs {
+		return false
+	}
+	return true
+}
+func (this *LinuxPersonality) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LinuxPersonality)
+	if !ok {
+		that2, ok := that.(LinuxPersonality)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if len(this.Flags) != len(that1.Flags) {
+		return false
+	}
+	for i := range this.Flags {
+		if this.Flags[i] != that1.Flags[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Spec) Marshal() (dAtA []byte, err error) {
@@ -2846,6 +3071,12 @@ func (m *Spec) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n6
 	}
+	if len(m.Domainname) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(len(m.Domainname)))
+		i += copy(dAtA[i:], m.Domainname)
+	}
 	return i, nil
 }
 
@@ -2977,6 +3208,12 @@ func (m *Process) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOci(dAtA, i, uint64(len(m.SelinuxLabel)))
 		i += copy(dAtA[i:], m.SelinuxLabel)
 	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
 	return i, nil
 }
 
@@ -3056,6 +3293,16 @@ func (m *User) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOci(dAtA, i, uint64(len(m.Username)))
 		i += copy(dAtA[i:], m.Username)
 	}
+	if m.Umask != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(m.Umask.Size()))
+		n12, err := m.Umask.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
 	return i, nil
 }
 
@@ -3444,11 +3691,11 @@ func (m *Linux) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Resources.Size()))
-		n12, err := m.Resources.MarshalTo(dAtA[i:])
+		n13, err := m.Resources.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if len(m.CgroupsPath) > 0 {
 		dAtA[i] = 0x2a
@@ -3484,11 +3731,11 @@ func (m *Linux) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Seccomp.Size()))
-		n13, err := m.Seccomp.MarshalTo(dAtA[i:])
+		n14, err := m.Seccomp.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	if len(m.RootfsPropagation) > 0 {
 		dAtA[i] = 0x4a
@@ -3536,11 +3783,49 @@ func (m *Linux) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.IntelRdt.Size()))
-		n14, err := m.IntelRdt.MarshalTo(dAtA[i:])
+		n15, err := m.IntelRdt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
+	}
+	if len(m.TimeOffsets) > 0 {
+		for k, _ := range m.TimeOffsets {
+			dAtA[i] = 0x72
+			i++
+			v := m.TimeOffsets[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovOci(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovOci(uint64(len(k))) + msgSize
+			i = encodeVarintOci(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOci(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintOci(dAtA, i, uint64(v.Size()))
+				n16, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n16
+			}
+		}
+	}
+	if m.Personality != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(m.Personality.Size()))
+		n17, err := m.Personality.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
 	return i, nil
 }
@@ -3742,41 +4027,41 @@ func (m *LinuxResources) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Memory.Size()))
-		n15, err := m.Memory.MarshalTo(dAtA[i:])
+		n18, err := m.Memory.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	if m.CPU != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.CPU.Size()))
-		n16, err := m.CPU.MarshalTo(dAtA[i:])
+		n19, err := m.CPU.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n19
 	}
 	if m.Pids != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Pids.Size()))
-		n17, err := m.Pids.MarshalTo(dAtA[i:])
+		n20, err := m.Pids.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n20
 	}
 	if m.BlockIO != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.BlockIO.Size()))
-		n18, err := m.BlockIO.MarshalTo(dAtA[i:])
+		n21, err := m.BlockIO.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
 	}
 	if len(m.HugepageLimits) > 0 {
 		for _, msg := range m.HugepageLimits {
@@ -3794,11 +4079,28 @@ func (m *LinuxResources) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Network.Size()))
-		n19, err := m.Network.MarshalTo(dAtA[i:])
+		n22, err := m.Network.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n22
+	}
+	if len(m.Unified) > 0 {
+		for k, _ := range m.Unified {
+			dAtA[i] = 0x42
+			i++
+			v := m.Unified[k]
+			mapSize := 1 + len(k) + sovOci(uint64(len(k))) + 1 + len(v) + sovOci(uint64(len(v)))
+			i = encodeVarintOci(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOci(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOci(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
 	return i, nil
 }
@@ -4403,43 +4705,122 @@ func (m *LinuxIntelRdt) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOci(dAtA, i, uint64(len(m.L3CacheSchema)))
 		i += copy(dAtA[i:], m.L3CacheSchema)
 	}
+	if len(m.MemBwSchema) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(len(m.MemBwSchema)))
+		i += copy(dAtA[i:], m.MemBwSchema)
+	}
+	if len(m.ClosID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(len(m.ClosID)))
+		i += copy(dAtA[i:], m.ClosID)
+	}
 	return i, nil
 }
 
-func encodeVarintOci(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LinuxTimeOffset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func NewPopulatedSpec(r randyOci, easy bool) *Spec {
-	this := &Spec{}
-	this.Version = string(randStringOci(r))
-	if r.Intn(10) != 0 {
-		this.Process = NewPopulatedProcess(r, easy)
-	}
-	if r.Intn(10) != 0 {
-		this.Root = NewPopulatedRoot(r, easy)
+
+func (m *LinuxTimeOffset) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Secs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(m.Secs))
 	}
-	this.Hostname = string(randStringOci(r))
-	if r.Intn(10) != 0 {
-		v1 := r.Intn(5)
-		this.Mounts = make([]Mount, v1)
-		for i := 0; i < v1; i++ {
-			v2 := NewPopulatedMount(r, easy)
-			this.Mounts[i] = *v2
-		}
+	if m.Nanosecs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(m.Nanosecs))
 	}
-	if r.Intn(10) != 0 {
-		this.Hooks = NewPopulatedHooks(r, easy)
+	return i, nil
+}
+
+func (m *LinuxPersonality) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(10) != 0 {
-		v3 := r.Intn(10)
-		this.Annotations = make(map[string]string)
-		for i := 0; i < v3; i++ {
+	return dAtA[:n], nil
+}
+
+func (m *LinuxPersonality) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(len(m.Domain)))
+		i += copy(dAtA[i:], m.Domain)
+	}
+	if len(m.Flags) > 0 {
+		for _, s := range m.Flags {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintOci(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedSpec(r randyOci, easy bool) *Spec {
+	this := &Spec{}
+	this.Version = string(randStringOci(r))
+	if r.Intn(10) != 0 {
+		this.Process = NewPopulatedProcess(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Root = NewPopulatedRoot(r, easy)
+	}
+	this.Hostname = string(randStringOci(r))
+	if r.Intn(10) != 0 {
+		v1 := r.Intn(5)
+		this.Mounts = make([]Mount, v1)
+		for i := 0; i < v1; i++ {
+			v2 := NewPopulatedMount(r, easy)
+			this.Mounts[i] = *v2
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.Hooks = NewPopulatedHooks(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(10)
+		this.Annotations = make(map[string]string)
+		for i := 0; i < v3; i++ {
 			this.Annotations[randStringOci(r)] = randStringOci(r)
 		}
 	}
@@ -4452,6 +4833,7 @@ func NewPopulatedSpec(r randyOci, easy bool) *Spec {
 	if r.Intn(10) != 0 {
 		this.Windows = NewPopulatedWindows(r, easy)
 	}
+	this.Domainname = string(randStringOci(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4494,6 +4876,7 @@ func NewPopulatedProcess(r randyOci, easy bool) *Process {
 		this.OOMScoreAdj *= -1
 	}
 	this.SelinuxLabel = string(randStringOci(r))
+	this.Path = string(randStringOci(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4518,6 +4901,9 @@ func NewPopulatedUser(r randyOci, easy bool) *User {
 		this.AdditionalGids[i] = uint32(r.Uint32())
 	}
 	this.Username = string(randStringOci(r))
+	if r.Intn(10) != 0 {
+		this.Umask = google_protobuf1.NewPopulatedUInt32Value(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4705,6 +5091,16 @@ func NewPopulatedLinux(r randyOci, easy bool) *Linux {
 	if r.Intn(10) != 0 {
 		this.IntelRdt = NewPopulatedLinuxIntelRdt(r, easy)
 	}
+	if r.Intn(10) != 0 {
+		v35 := r.Intn(10)
+		this.TimeOffsets = make(map[string]*LinuxTimeOffset)
+		for i := 0; i < v35; i++ {
+			this.TimeOffsets[randStringOci(r)] = NewPopulatedLinuxTimeOffset(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.Personality = NewPopulatedLinuxPersonality(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4768,11 +5164,11 @@ func NewPopulatedLinuxDevice(r randyOci, easy bool) *LinuxDevice {
 func NewPopulatedLinuxResources(r randyOci, easy bool) *LinuxResources {
 	this := &LinuxResources{}
 	if r.Intn(10) != 0 {
-		v35 := r.Intn(5)
-		this.Devices = make([]LinuxDeviceCgroup, v35)
-		for i := 0; i < v35; i++ {
-			v36 := NewPopulatedLinuxDeviceCgroup(r, easy)
-			this.Devices[i] = *v36
+		v36 := r.Intn(5)
+		this.Devices = make([]LinuxDeviceCgroup, v36)
+		for i := 0; i < v36; i++ {
+			v37 := NewPopulatedLinuxDeviceCgroup(r, easy)
+			this.Devices[i] = *v37
 		}
 	}
 	if r.Intn(10) != 0 {
@@ -4788,16 +5184,23 @@ func NewPopulatedLinuxResources(r randyOci, easy bool) *LinuxResources {
 		this.BlockIO = NewPopulatedLinuxBlockIO(r, easy)
 	}
 	if r.Intn(10) != 0 {
-		v37 := r.Intn(5)
-		this.HugepageLimits = make([]LinuxHugepageLimit, v37)
-		for i := 0; i < v37; i++ {
-			v38 := NewPopulatedLinuxHugepageLimit(r, easy)
-			this.HugepageLimits[i] = *v38
+		v38 := r.Intn(5)
+		this.HugepageLimits = make([]LinuxHugepageLimit, v38)
+		for i := 0; i < v38; i++ {
+			v39 := NewPopulatedLinuxHugepageLimit(r, easy)
+			this.HugepageLimits[i] = *v39
 		}
 	}
 	if r.Intn(10) != 0 {
 		this.Network = NewPopulatedLinuxNetwork(r, easy)
 	}
+	if r.Intn(10) != 0 {
+		v40 := r.Intn(10)
+		this.Unified = make(map[string]string)
+		for i := 0; i < v40; i++ {
+			this.Unified[randStringOci(r)] = randStringOci(r)
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4889,44 +5292,44 @@ func NewPopulatedLinuxBlockIO(r randyOci, easy bool) *LinuxBlockIO {
 	this := &LinuxBlockIO{}
 	this.Weight = uint32(r.Uint32())
 	this.LeafWeight = uint32(r.Uint32())
-	if r.Intn(10) != 0 {
-		v39 := r.Intn(5)
-		this.WeightDevice = make([]LinuxWeightDevice, v39)
-		for i := 0; i < v39; i++ {
-			v40 := NewPopulatedLinuxWeightDevice(r, easy)
-			this.WeightDevice[i] = *v40
-		}
-	}
 	if r.Intn(10) != 0 {
 		v41 := r.Intn(5)
-		this.ThrottleReadBpsDevice = make([]LinuxThrottleDevice, v41)
+		this.WeightDevice = make([]LinuxWeightDevice, v41)
 		for i := 0; i < v41; i++ {
-			v42 := NewPopulatedLinuxThrottleDevice(r, easy)
-			this.ThrottleReadBpsDevice[i] = *v42
+			v42 := NewPopulatedLinuxWeightDevice(r, easy)
+			this.WeightDevice[i] = *v42
 		}
 	}
 	if r.Intn(10) != 0 {
 		v43 := r.Intn(5)
-		this.ThrottleWriteBpsDevice = make([]LinuxThrottleDevice, v43)
+		this.ThrottleReadBpsDevice = make([]LinuxThrottleDevice, v43)
 		for i := 0; i < v43; i++ {
 			v44 := NewPopulatedLinuxThrottleDevice(r, easy)
-			this.ThrottleWriteBpsDevice[i] = *v44
+			this.ThrottleReadBpsDevice[i] = *v44
 		}
 	}
 	if r.Intn(10) != 0 {
 		v45 := r.Intn(5)
-		this.ThrottleReadIOPSDevice = make([]LinuxThrottleDevice, v45)
+		this.ThrottleWriteBpsDevice = make([]LinuxThrottleDevice, v45)
 		for i := 0; i < v45; i++ {
 			v46 := NewPopulatedLinuxThrottleDevice(r, easy)
-			this.ThrottleReadIOPSDevice[i] = *v46
+			this.ThrottleWriteBpsDevice[i] = *v46
 		}
 	}
 	if r.Intn(10) != 0 {
 		v47 := r.Intn(5)
-		this.ThrottleWriteIOPSDevice = make([]LinuxThrottleDevice, v47)
+		this.ThrottleReadIOPSDevice = make([]LinuxThrottleDevice, v47)
 		for i := 0; i < v47; i++ {
 			v48 := NewPopulatedLinuxThrottleDevice(r, easy)
-			this.ThrottleWriteIOPSDevice[i] = *v48
+			this.ThrottleReadIOPSDevice[i] = *v48
+		}
+	}
+	if r.Intn(10) != 0 {
+		v49 := r.Intn(5)
+		this.ThrottleWriteIOPSDevice = make([]LinuxThrottleDevice, v49)
+		for i := 0; i < v49; i++ {
+			v50 := NewPopulatedLinuxThrottleDevice(r, easy)
+			this.ThrottleWriteIOPSDevice[i] = *v50
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -4967,11 +5370,11 @@ func NewPopulatedLinuxNetwork(r randyOci, easy bool) *LinuxNetwork {
 	this := &LinuxNetwork{}
 	this.ClassID = uint32(r.Uint32())
 	if r.Intn(10) != 0 {
-		v49 := r.Intn(5)
-		this.Priorities = make([]LinuxInterfacePriority, v49)
-		for i := 0; i < v49; i++ {
-			v50 := NewPopulatedLinuxInterfacePriority(r, easy)
-			this.Priorities[i] = *v50
+		v51 := r.Intn(5)
+		this.Priorities = make([]LinuxInterfacePriority, v51)
+		for i := 0; i < v51; i++ {
+			v52 := NewPopulatedLinuxInterfacePriority(r, easy)
+			this.Priorities[i] = *v52
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -5000,17 +5403,17 @@ func NewPopulatedLinuxInterfacePriority(r randyOci, easy bool) *LinuxInterfacePr
 func NewPopulatedLinuxSeccomp(r randyOci, easy bool) *LinuxSeccomp {
 	this := &LinuxSeccomp{}
 	this.DefaultAction = string(randStringOci(r))
-	v51 := r.Intn(10)
-	this.Architectures = make([]string, v51)
-	for i := 0; i < v51; i++ {
+	v53 := r.Intn(10)
+	this.Architectures = make([]string, v53)
+	for i := 0; i < v53; i++ {
 		this.Architectures[i] = string(randStringOci(r))
 	}
 	if r.Intn(10) != 0 {
-		v52 := r.Intn(5)
-		this.Syscalls = make([]LinuxSyscall, v52)
-		for i := 0; i < v52; i++ {
-			v53 := NewPopulatedLinuxSyscall(r, easy)
-			this.Syscalls[i] = *v53
+		v54 := r.Intn(5)
+		this.Syscalls = make([]LinuxSyscall, v54)
+		for i := 0; i < v54; i++ {
+			v55 := NewPopulatedLinuxSyscall(r, easy)
+			this.Syscalls[i] = *v55
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -5031,18 +5434,18 @@ func NewPopulatedLinuxSeccompArg(r randyOci, easy bool) *LinuxSeccompArg {
 
 func NewPopulatedLinuxSyscall(r randyOci, easy bool) *LinuxSyscall {
 	this := &LinuxSyscall{}
-	v54 := r.Intn(10)
-	this.Names = make([]string, v54)
-	for i := 0; i < v54; i++ {
+	v56 := r.Intn(10)
+	this.Names = make([]string, v56)
+	for i := 0; i < v56; i++ {
 		this.Names[i] = string(randStringOci(r))
 	}
 	this.Action = string(randStringOci(r))
 	if r.Intn(10) != 0 {
-		v55 := r.Intn(5)
-		this.Args = make([]LinuxSeccompArg, v55)
-		for i := 0; i < v55; i++ {
-			v56 := NewPopulatedLinuxSeccompArg(r, easy)
-			this.Args[i] = *v56
+		v57 := r.Intn(5)
+		this.Args = make([]LinuxSeccompArg, v57)
+		for i := 0; i < v57; i++ {
+			v58 := NewPopulatedLinuxSeccompArg(r, easy)
+			this.Args[i] = *v58
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -5053,6 +5456,33 @@ func NewPopulatedLinuxSyscall(r randyOci, easy bool) *LinuxSyscall {
 func NewPopulatedLinuxIntelRdt(r randyOci, easy bool) *LinuxIntelRdt {
 	this := &LinuxIntelRdt{}
 	this.L3CacheSchema = string(randStringOci(r))
+	this.MemBwSchema = string(randStringOci(r))
+	this.ClosID = string(randStringOci(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedLinuxTimeOffset(r randyOci, easy bool) *LinuxTimeOffset {
+	this := &LinuxTimeOffset{}
+	this.Secs = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Secs *= -1
+	}
+	this.Nanosecs = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedLinuxPersonality(r randyOci, easy bool) *LinuxPersonality {
+	this := &LinuxPersonality{}
+	this.Domain = string(randStringOci(r))
+	v59 := r.Intn(10)
+	this.Flags = make([]string, v59)
+	for i := 0; i < v59; i++ {
+		this.Flags[i] = string(randStringOci(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -5077,9 +5507,9 @@ func randUTF8RuneOci(r randyOci) rune {
 	return rune(ru + 61)
 }
 func randStringOci(r randyOci) string {
-	v57 := r.Intn(100)
-	tmps := make([]rune, v57)
-	for i := 0; i < v57; i++ {
+	v60 := r.Intn(100)
+	tmps := make([]rune, v60)
+	for i := 0; i < v60; i++ {
 		tmps[i] = randUTF8RuneOci(r)
 	}
 	return string(tmps)
@@ -5101,11 +5531,11 @@ func randFieldOci(dAtA []byte, r randyOci, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOci(dAtA, uint64(key))
-		v58 := r.Int63()
+		v61 := r.Int63()
 		if r.Intn(2) == 0 {
-			v58 *= -1
+			v61 *= -1
 		}
-		dAtA = encodeVarintPopulateOci(dAtA, uint64(v58))
+		dAtA = encodeVarintPopulateOci(dAtA, uint64(v61))
 	case 1:
 		dAtA = encodeVarintPopulateOci(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -5179,6 +5609,10 @@ func (m *Spec) Size() (n int) {
 		l = m.Windows.Size()
 		n += 1 + l + sovOci(uint64(l))
 	}
+	l = len(m.Domainname)
+	if l > 0 {
+		n += 1 + l + sovOci(uint64(l))
+	}
 	return n
 }
 
@@ -5234,6 +5668,10 @@ func (m *Process) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOci(uint64(l))
 	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovOci(uint64(l))
+	}
 	return n
 }
 
@@ -5269,6 +5707,10 @@ func (m *User) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOci(uint64(l))
 	}
+	if m.Umask != nil {
+		l = m.Umask.Size()
+		n += 1 + l + sovOci(uint64(l))
+	}
 	return n
 }
 
@@ -5481,6 +5923,23 @@ func (m *Linux) Size() (n int) {
 		l = m.IntelRdt.Size()
 		n += 1 + l + sovOci(uint64(l))
 	}
+	if len(m.TimeOffsets) > 0 {
+		for k, v := range m.TimeOffsets {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovOci(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovOci(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovOci(uint64(mapEntrySize))
+		}
+	}
+	if m.Personality != nil {
+		l = m.Personality.Size()
+		n += 1 + l + sovOci(uint64(l))
+	}
 	return n
 }
 
@@ -5597,6 +6056,14 @@ func (m *LinuxResources) Size() (n int) {
 		l = m.Network.Size()
 		n += 1 + l + sovOci(uint64(l))
 	}
+	if len(m.Unified) > 0 {
+		for k, v := range m.Unified {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOci(uint64(len(k))) + 1 + len(v) + sovOci(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOci(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -5874,6 +6341,42 @@ func (m *LinuxIntelRdt) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOci(uint64(l))
 	}
+	l = len(m.MemBwSchema)
+	if l > 0 {
+		n += 1 + l + sovOci(uint64(l))
+	}
+	l = len(m.ClosID)
+	if l > 0 {
+		n += 1 + l + sovOci(uint64(l))
+	}
+	return n
+}
+
+func (m *LinuxTimeOffset) Size() (n int) {
+	var l int
+	_ = l
+	if m.Secs != 0 {
+		n += 1 + sovOci(uint64(m.Secs))
+	}
+	if m.Nanosecs != 0 {
+		n += 1 + sovOci(uint64(m.Nanosecs))
+	}
+	return n
+}
+
+func (m *LinuxPersonality) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovOci(uint64(l))
+	}
+	if len(m.Flags) > 0 {
+		for _, s := range m.Flags {
+			l = len(s)
+			n += 1 + l + sovOci(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6324,6 +6827,35 @@ func (m *Spec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domainname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domainname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -6705,6 +7237,35 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			}
 			m.SelinuxLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -6972,6 +7533,39 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			}
 			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Umask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Umask == nil {
+				m.Umask = &google_protobuf1.UInt32Value{}
+			}
+			if err := m.Umask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -8384,19 +8978,175 @@ func (m *Linux) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOci(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeOffsets", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthOci
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
+			if m.TimeOffsets == nil {
+				m.TimeOffsets = make(map[string]*LinuxTimeOffset)
+			}
+			var mapkey string
+			var mapvalue *LinuxTimeOffset
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOci
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOci
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOci
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOci
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOci
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOci
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LinuxTimeOffset{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOci(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthOci
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TimeOffsets[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Personality", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Personality == nil {
+				m.Personality = &LinuxPersonality{}
+			}
+			if err := m.Personality.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -9237,6 +9987,124 @@ func (m *LinuxResources) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Unified == nil {
+				m.Unified = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOci
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOci
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOci
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOci
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOci
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOci(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthOci
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Unified[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -11124,6 +11992,260 @@ func (m *LinuxIntelRdt) Unmarshal(dAtA []byte) error {
 			}
 			m.L3CacheSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemBwSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemBwSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinuxTimeOffset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinuxTimeOffset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinuxTimeOffset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secs", wireType)
+			}
+			m.Secs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Secs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nanosecs", wireType)
+			}
+			m.Nanosecs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nanosecs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinuxPersonality) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinuxPersonality: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinuxPersonality: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -11253,133 +12375,146 @@ var (
 func init() { proto.RegisterFile("oci.proto", fileDescriptorOci) }
 
 var fileDescriptorOci = []byte{
-	// 2035 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x18, 0x4b, 0x6f, 0x23, 0x49,
-	0x99, 0xb6, 0x1d, 0xc7, 0x2e, 0x27, 0x99, 0x99, 0xda, 0xd9, 0x6c, 0x13, 0x46, 0xde, 0x6c, 0x33,
-	0x82, 0x00, 0x43, 0x22, 0x66, 0x78, 0x2c, 0xcb, 0x43, 0x72, 0x92, 0x99, 0x89, 0xb5, 0xc9, 0xc4,
-	0x94, 0x93, 0x0d, 0x70, 0x40, 0xea, 0xb4, 0x2b, 0x76, 0x6d, 0xda, 0x5d, 0xad, 0xea, 0x72, 0x32,
-	0xe1, 0xc6, 0x3f, 0x40, 0xe2, 0x17, 0x70, 0x02, 0xfe, 0x01, 0xe2, 0xc4, 0x8d, 0x15, 0x27, 0xee,
-	0x48, 0x3c, 0x72, 0xe7, 0xce, 0x11, 0x7d, 0x55, 0x5f, 0xb5, 0xcb, 0x4e, 0x02, 0x3b, 0xec, 0xc9,
-	0xf5, 0x3d, 0xab, 0xbe, 0xf7, 0xd7, 0x26, 0x4d, 0x99, 0x88, 0xcd, 0x5c, 0x49, 0x2d, 0x69, 0x6d,
-	0xa8, 0xf2, 0x64, 0xed, 0xeb, 0x43, 0xa1, 0x47, 0x93, 0xd3, 0xcd, 0x44, 0x8e, 0xb7, 0x86, 0x72,
-	0x28, 0xb7, 0x0c, 0xf1, 0x74, 0x72, 0x66, 0x20, 0x03, 0x98, 0x93, 0x15, 0x5a, 0x6b, 0x0f, 0xa5,
-	0x1c, 0xa6, 0x7c, 0xca, 0x75, 0xa9, 0xe2, 0x3c, 0xe7, 0xaa, 0xb0, 0xf4, 0xe8, 0x4f, 0x55, 0x52,
-	0xeb, 0xe7, 0x3c, 0xa1, 0x21, 0x59, 0xfc, 0x88, 0xab, 0x42, 0xc8, 0x2c, 0x0c, 0xd6, 0x83, 0x8d,
-	0x26, 0x73, 0x20, 0xfd, 0x32, 0x59, 0xec, 0x29, 0x99, 0xf0, 0xa2, 0x08, 0x2b, 0xeb, 0xc1, 0x46,
-	0xeb, 0xe9, 0xf2, 0x26, 0xbc, 0x64, 0x13, 0x91, 0xcc, 0x51, 0x69, 0x9b, 0xd4, 0x98, 0x94, 0x3a,
-	0xac, 0x1a, 0x2e, 0x62, 0xb9, 0x00, 0xc3, 0x0c, 0x9e, 0xae, 0x91, 0xc6, 0x9e, 0x2c, 0x74, 0x16,
-	0x8f, 0x79, 0x58, 0x33, 0x77, 0x94, 0x30, 0xfd, 0x0a, 0xa9, 0x1f, 0xc8, 0x49, 0xa6, 0x8b, 0x70,
-	0x61, 0xbd, 0xba, 0xd1, 0x7a, 0xda, 0xb2, 0xd2, 0x06, 0xb7, 0x5d, 0xfb, 0xe4, 0x6f, 0xef, 0x7e,
-	0x8e, 0x21, 0x03, 0x7d, 0x8f, 0x2c, 0xec, 0x49, 0x79, 0x5e, 0x84, 0x75, 0x73, 0x0f, 0x72, 0x1a,
-	0x14, 0xb3, 0x14, 0xfa, 0x03, 0xd2, 0xea, 0x64, 0x99, 0xd4, 0xb1, 0x16, 0x32, 0x2b, 0xc2, 0x45,
-	0xa3, 0xf2, 0x0b, 0x96, 0x11, 0xac, 0xdd, 0xf4, 0xa8, 0xcf, 0x33, 0xad, 0xae, 0x98, 0xcf, 0x0f,
-	0x37, 0xec, 0x8b, 0x6c, 0xf2, 0x3a, 0x6c, 0xf8, 0x37, 0x18, 0x14, 0xb3, 0x14, 0x70, 0x4a, 0x5f,
-	0xa6, 0xb1, 0x12, 0x45, 0xd8, 0xf4, 0x9d, 0x82, 0x48, 0xe6, 0xa8, 0xc0, 0x78, 0x22, 0xb2, 0x81,
-	0xbc, 0x2c, 0x42, 0xe2, 0x33, 0x22, 0x92, 0x39, 0xea, 0xda, 0x0f, 0xc9, 0xfd, 0xf9, 0x57, 0xd1,
-	0xfb, 0xa4, 0x7a, 0xce, 0xaf, 0x30, 0x20, 0x70, 0xa4, 0x0f, 0xc9, 0xc2, 0x45, 0x9c, 0x4e, 0xb8,
-	0x09, 0x45, 0x93, 0x59, 0xe0, 0x83, 0xca, 0xfb, 0x41, 0xf4, 0x87, 0x6a, 0x19, 0x27, 0xf0, 0xf4,
-	0x11, 0x57, 0x63, 0x91, 0xc5, 0xa9, 0x11, 0x6e, 0xb0, 0x12, 0xa6, 0x5f, 0x23, 0xad, 0x1d, 0x99,
-	0x15, 0x32, 0xe5, 0x7d, 0xf1, 0x73, 0x8e, 0x21, 0x6d, 0xda, 0x47, 0x6d, 0xcb, 0xd7, 0xcc, 0xa7,
-	0xd2, 0xc7, 0xa4, 0x76, 0x5c, 0x70, 0x35, 0x1b, 0x52, 0xc0, 0x60, 0x4c, 0x0c, 0x95, 0x52, 0x52,
-	0xeb, 0xa8, 0x61, 0x11, 0xd6, 0xd6, 0xab, 0x1b, 0x4d, 0x66, 0xce, 0xf0, 0xf4, 0xe7, 0xd9, 0x85,
-	0x89, 0x66, 0x93, 0xc1, 0x11, 0x30, 0x3b, 0x97, 0x03, 0x13, 0xb5, 0x26, 0x83, 0x23, 0xfd, 0x1e,
-	0x59, 0xda, 0x89, 0xf3, 0xf8, 0x54, 0xa4, 0x42, 0x0b, 0x0e, 0x71, 0x82, 0x5b, 0xde, 0xf1, 0xdc,
-	0xed, 0x93, 0xd9, 0x0c, 0x33, 0xfd, 0x06, 0x59, 0x64, 0xa9, 0x18, 0x0b, 0x5d, 0x84, 0x0d, 0x13,
-	0xdf, 0x07, 0x98, 0x96, 0x87, 0xfd, 0xee, 0x8f, 0x2d, 0x05, 0x1f, 0xe9, 0xf8, 0xe8, 0x06, 0xb9,
-	0xf7, 0x4a, 0xbe, 0xe2, 0x97, 0x3d, 0x25, 0x2e, 0x44, 0xca, 0x87, 0xdc, 0x06, 0xaf, 0xc1, 0xe6,
-	0xd1, 0xc0, 0xd9, 0xc9, 0xf3, 0x58, 0x8d, 0xa5, 0xea, 0x29, 0x79, 0x26, 0x52, 0x6e, 0xa2, 0xd7,
-	0x64, 0xf3, 0x68, 0xba, 0x4e, 0x5a, 0x87, 0x87, 0x07, 0xfd, 0x44, 0x2a, 0xde, 0x19, 0x7c, 0x1c,
-	0xb6, 0xd6, 0x83, 0x8d, 0x2a, 0xf3, 0x51, 0x34, 0x22, 0x4b, 0x7d, 0x9e, 0x82, 0x35, 0xfb, 0xf1,
-	0x29, 0x4f, 0xc3, 0x25, 0xa3, 0x68, 0x06, 0x17, 0x3d, 0x23, 0xd5, 0x6d, 0xf9, 0x9a, 0xae, 0x92,
-	0xfa, 0x1e, 0x17, 0xc3, 0x91, 0x36, 0x51, 0x5b, 0x66, 0x08, 0x41, 0xd4, 0x4f, 0xc4, 0x40, 0x8f,
-	0x4c, 0xb4, 0x96, 0x99, 0x05, 0xa2, 0xcc, 0x06, 0x07, 0x1c, 0x7b, 0xdc, 0xdd, 0x45, 0x11, 0x38,
-	0x02, 0xe6, 0x65, 0x77, 0x17, 0xb9, 0xe1, 0x48, 0xbf, 0x44, 0x56, 0x3a, 0x83, 0x81, 0x80, 0xdc,
-	0x8a, 0xd3, 0x97, 0x62, 0x50, 0x84, 0xd5, 0xf5, 0xea, 0xc6, 0x32, 0x9b, 0xc3, 0x42, 0xe6, 0x80,
-	0x4e, 0xbf, 0x46, 0x1d, 0x1c, 0xfd, 0x26, 0x20, 0x0f, 0x6e, 0x44, 0x05, 0x24, 0xb6, 0xe5, 0x24,
-	0x1b, 0x88, 0x6c, 0x18, 0x06, 0x26, 0xda, 0x25, 0x4c, 0x1f, 0x91, 0xe6, 0xf3, 0xb3, 0x33, 0x9e,
-	0x68, 0x71, 0x01, 0x99, 0x06, 0xc4, 0x29, 0x02, 0x5c, 0xd7, 0xcd, 0x46, 0x5c, 0x09, 0x1d, 0x9f,
-	0xa6, 0xdc, 0x3c, 0xa8, 0xc9, 0x7c, 0x14, 0xc8, 0xf7, 0x20, 0x6f, 0xb5, 0xe6, 0x03, 0xcc, 0xae,
-	0x29, 0x02, 0x5a, 0x56, 0x67, 0x7c, 0x2a, 0x78, 0xa6, 0x31, 0xcd, 0x1c, 0x18, 0x75, 0x49, 0xcb,
-	0x4b, 0x03, 0xc8, 0xcf, 0xa3, 0xab, 0x9c, 0x63, 0x1d, 0x99, 0x33, 0xe0, 0xf6, 0x62, 0x35, 0x30,
-	0x3e, 0xaa, 0x31, 0x73, 0x06, 0x5c, 0x5f, 0x9e, 0xd9, 0x06, 0x56, 0x63, 0xe6, 0x1c, 0x49, 0xb2,
-	0x60, 0xfa, 0x0e, 0xbc, 0x76, 0xc0, 0x0b, 0x2d, 0x32, 0x53, 0xa0, 0xa8, 0xcb, 0x47, 0x41, 0xf4,
-	0x0a, 0x39, 0x51, 0x89, 0x2b, 0x4e, 0x84, 0x40, 0xad, 0x86, 0xeb, 0xab, 0xf6, 0x7a, 0x38, 0xc3,
-	0xdb, 0x65, 0x6e, 0xbb, 0x93, 0xb5, 0xcb, 0x81, 0xd1, 0xb7, 0x6d, 0x17, 0x05, 0xa9, 0x5e, 0xac,
-	0x47, 0xee, 0xd1, 0x70, 0x06, 0x5f, 0x33, 0x1e, 0x0f, 0x64, 0x96, 0x5e, 0x99, 0x3b, 0x1a, 0xac,
-	0x84, 0xa3, 0x5f, 0x05, 0xd8, 0x17, 0xe9, 0x13, 0xd2, 0xe8, 0x29, 0x5e, 0xe8, 0x58, 0x69, 0x13,
-	0x91, 0xb2, 0x70, 0x81, 0x8c, 0x35, 0x51, 0x72, 0xd0, 0x4d, 0xd2, 0xec, 0xc9, 0x42, 0x5b, 0xf6,
-	0xca, 0x1d, 0xec, 0x53, 0x16, 0xa3, 0xdd, 0x00, 0x32, 0x37, 0x21, 0xbb, 0x5d, 0x3b, 0x72, 0x44,
-	0x3f, 0x25, 0x35, 0xc0, 0xdf, 0x6a, 0x8d, 0x6b, 0x1b, 0x95, 0x9b, 0x6d, 0xa3, 0x3a, 0x6d, 0x1b,
-	0x21, 0x59, 0x3c, 0x12, 0x63, 0x2e, 0x27, 0xda, 0x24, 0x64, 0x95, 0x39, 0x30, 0xfa, 0xdd, 0x02,
-	0xf6, 0x69, 0xfa, 0x7d, 0xd2, 0x3a, 0xee, 0xee, 0x1e, 0xc4, 0x79, 0x2e, 0xb2, 0x61, 0x81, 0x46,
-	0x3f, 0xf4, 0xfa, 0x48, 0x49, 0xc4, 0x07, 0xfa, 0xec, 0x20, 0xfd, 0xd2, 0x93, 0xae, 0xfc, 0x6f,
-	0x69, 0x8f, 0x9d, 0x6e, 0x91, 0x7a, 0xff, 0xaa, 0x48, 0x74, 0x8a, 0xde, 0xf0, 0xdb, 0xd7, 0xa6,
-	0xa5, 0xd8, 0x11, 0x83, 0x6c, 0xf4, 0x29, 0x69, 0x32, 0x6e, 0x53, 0xa3, 0x30, 0x26, 0xcd, 0x5e,
-	0x56, 0xd2, 0xd8, 0x94, 0x0d, 0x92, 0x6f, 0x67, 0xa8, 0xe4, 0x24, 0x2f, 0x8c, 0x17, 0x17, 0x6c,
-	0xf2, 0x79, 0x28, 0xfa, 0x01, 0x21, 0xaf, 0xe2, 0x31, 0x2f, 0xf2, 0x18, 0xd4, 0xd6, 0x6f, 0xd8,
-	0x50, 0x12, 0xd1, 0x06, 0x8f, 0x1b, 0x5a, 0xe9, 0x2e, 0xbf, 0x10, 0x09, 0x77, 0xa3, 0xf2, 0x81,
-	0x27, 0x68, 0x29, 0xae, 0x95, 0x22, 0x1f, 0x7d, 0x42, 0x16, 0xfb, 0x3c, 0x49, 0xe4, 0x38, 0xc7,
-	0x21, 0x49, 0x3d, 0x11, 0xa4, 0x30, 0xc7, 0x42, 0x9f, 0x90, 0x07, 0x90, 0xd3, 0x67, 0x45, 0x4f,
-	0xc9, 0x3c, 0x1e, 0xda, 0x0a, 0x6a, 0x1a, 0x23, 0x6e, 0x12, 0xc0, 0xd8, 0x83, 0xb8, 0x38, 0xe7,
-	0x03, 0x30, 0x0c, 0xc6, 0xa6, 0xe9, 0x0b, 0x1e, 0x8a, 0x3e, 0x26, 0xcb, 0x2e, 0xef, 0x2d, 0x4f,
-	0xcb, 0xf0, 0xcc, 0x22, 0x69, 0x9b, 0x10, 0x53, 0xba, 0x7e, 0xdb, 0xf5, 0x30, 0x74, 0x8b, 0x34,
-	0xba, 0x99, 0xe6, 0x29, 0x1b, 0xe8, 0x70, 0xd9, 0x18, 0xf1, 0x96, 0x1f, 0x74, 0x24, 0xb1, 0x92,
-	0x69, 0xed, 0xbb, 0xa4, 0xe5, 0x05, 0xf4, 0x8d, 0xa6, 0xf3, 0xbb, 0xe5, 0x1a, 0x00, 0x4c, 0x83,
-	0xc9, 0x78, 0xec, 0x04, 0x2d, 0x00, 0x0c, 0x6e, 0x65, 0xb8, 0x9d, 0xe1, 0x67, 0x64, 0x65, 0x36,
-	0x19, 0xcd, 0xb4, 0x90, 0x85, 0x2e, 0x5b, 0x3f, 0x42, 0x26, 0x59, 0x64, 0xa6, 0x63, 0x91, 0x71,
-	0x55, 0x4e, 0x01, 0x1f, 0x65, 0x1a, 0x1d, 0x0c, 0xff, 0xaa, 0x21, 0x99, 0x73, 0xf4, 0x3e, 0xea,
-	0x2f, 0xf3, 0xe2, 0xae, 0xb6, 0x69, 0x32, 0xb0, 0x32, 0xad, 0xe3, 0xe8, 0xd7, 0x01, 0x69, 0x79,
-	0xa9, 0x72, 0x57, 0xad, 0x1b, 0x5d, 0x15, 0x4f, 0xd7, 0x43, 0xb2, 0x70, 0x10, 0x7f, 0x2c, 0xed,
-	0x76, 0x51, 0x65, 0x16, 0x30, 0x58, 0x91, 0x49, 0x85, 0xd5, 0x6e, 0x01, 0xe8, 0x7c, 0x2f, 0x44,
-	0xca, 0x0f, 0xe4, 0x80, 0x9b, 0xec, 0x5f, 0x66, 0x25, 0xec, 0xe6, 0x5f, 0xfd, 0xc6, 0xfc, 0x5b,
-	0x2c, 0xe7, 0x5f, 0xf4, 0xf7, 0x0a, 0x9a, 0x37, 0xad, 0xa9, 0xef, 0x4c, 0xb3, 0x3e, 0xb8, 0x51,
-	0xb9, 0x96, 0x62, 0x0b, 0x6c, 0x3e, 0xf7, 0x61, 0x57, 0xe5, 0x63, 0xa9, 0xae, 0x70, 0x79, 0xf2,
-	0xab, 0xc5, 0x12, 0x18, 0x32, 0xd0, 0x75, 0x52, 0xdd, 0xe9, 0x1d, 0xe3, 0xfa, 0xb4, 0xe2, 0x2f,
-	0x36, 0xbd, 0x63, 0x06, 0x24, 0xfa, 0x45, 0x52, 0xeb, 0xc1, 0x38, 0xb6, 0x8d, 0xe0, 0x9e, 0xc7,
-	0x02, 0x68, 0x66, 0x88, 0x50, 0x6d, 0xdb, 0xa9, 0x4c, 0xce, 0xbb, 0x87, 0xc6, 0xf8, 0xd9, 0x6a,
-	0x43, 0x0a, 0x73, 0x2c, 0xf4, 0x05, 0x59, 0xd9, 0x9b, 0x0c, 0x79, 0x1e, 0x0f, 0xf9, 0xbe, 0x5d,
-	0x90, 0x6c, 0x3b, 0x08, 0x3d, 0xa1, 0x19, 0x06, 0x34, 0x70, 0x4e, 0x0a, 0x6e, 0x7d, 0xc5, 0xf5,
-	0xa5, 0x54, 0xe7, 0xb8, 0x99, 0xf9, 0xb7, 0x22, 0x85, 0x39, 0x96, 0xe8, 0xaf, 0x2e, 0x0b, 0xd0,
-	0xf4, 0x87, 0xd0, 0x9c, 0xc7, 0xc2, 0xae, 0x32, 0x55, 0x66, 0x01, 0xc8, 0x4d, 0xc6, 0x0b, 0xae,
-	0x2e, 0x6c, 0x0f, 0xa8, 0xd8, 0x75, 0xc9, 0x43, 0x99, 0xdc, 0xbc, 0x8c, 0x73, 0x4c, 0x0a, 0x73,
-	0x86, 0x4c, 0xff, 0x90, 0xab, 0x8c, 0xa7, 0x98, 0x14, 0x08, 0xc1, 0x7e, 0x60, 0x4f, 0x47, 0x3b,
-	0x3d, 0xe3, 0x99, 0x2a, 0x9b, 0x22, 0xa0, 0xfe, 0x41, 0x3a, 0x17, 0x19, 0x7c, 0xbb, 0xd4, 0xcd,
-	0x50, 0xf7, 0x30, 0xf4, 0xab, 0xe4, 0xfe, 0xae, 0x28, 0x60, 0xd1, 0x38, 0x3c, 0x3c, 0xf8, 0x50,
-	0xa4, 0x29, 0x57, 0xc6, 0xd0, 0x06, 0xbb, 0x81, 0x8f, 0xfe, 0x1c, 0x90, 0x86, 0x0b, 0x1c, 0x3c,
-	0xa7, 0x3f, 0x8a, 0x95, 0x49, 0x1c, 0x50, 0x8a, 0x10, 0x98, 0xfc, 0xa3, 0x89, 0xd4, 0x31, 0x9a,
-	0x65, 0x01, 0xe0, 0xee, 0x71, 0x25, 0xe4, 0x00, 0xf7, 0x0a, 0x84, 0x60, 0xc7, 0x64, 0x3c, 0x4e,
-	0xb5, 0x18, 0x73, 0x36, 0xc9, 0xe0, 0x07, 0xad, 0x9b, 0x47, 0xc3, 0xf2, 0xe6, 0x50, 0xa8, 0x69,
-	0xc1, 0x68, 0x9a, 0xc3, 0x82, 0xeb, 0x76, 0xf2, 0x49, 0x81, 0x2b, 0xb6, 0x39, 0x03, 0xee, 0x80,
-	0x8f, 0xed, 0x6e, 0xdd, 0x64, 0xe6, 0x1c, 0x5d, 0xe2, 0x1e, 0x77, 0x62, 0xb6, 0x4b, 0xac, 0xda,
-	0xb2, 0x1a, 0x83, 0x5b, 0xab, 0xb1, 0xe2, 0x57, 0xe3, 0x2a, 0xa9, 0x5b, 0x59, 0xec, 0x20, 0x08,
-	0x81, 0xc7, 0xf7, 0x79, 0x7c, 0x86, 0xb4, 0x9a, 0xa1, 0x79, 0x98, 0xe8, 0x98, 0xbc, 0x65, 0x2e,
-	0x3e, 0x1a, 0x29, 0xa9, 0x75, 0xca, 0xff, 0x8f, 0xab, 0x29, 0xa9, 0xb1, 0x58, 0x73, 0xb7, 0xa3,
-	0xc1, 0x39, 0xfa, 0x57, 0x95, 0x2c, 0xf9, 0xa5, 0xe0, 0xbd, 0x2f, 0xf8, 0x2f, 0xef, 0xab, 0xcc,
-	0xbf, 0x8f, 0x76, 0xc8, 0x92, 0xef, 0x93, 0x5b, 0x26, 0xba, 0x4f, 0xc6, 0xb2, 0x99, 0x11, 0xa1,
-	0xc7, 0xe4, 0x6d, 0x67, 0x1d, 0x4c, 0xa3, 0xed, 0xbc, 0x40, 0x5d, 0x35, 0xa3, 0xeb, 0xf3, 0x9e,
-	0xae, 0x59, 0x2f, 0xa0, 0xb6, 0xdb, 0xa5, 0xe9, 0x09, 0x59, 0x75, 0x84, 0x13, 0x25, 0x34, 0x9f,
-	0xea, 0x5d, 0xf8, 0x74, 0x7a, 0xef, 0x10, 0xf7, 0x15, 0xc3, 0x8d, 0xdd, 0xc3, 0x5e, 0x1f, 0x15,
-	0xd7, 0xdf, 0x50, 0xf1, 0xac, 0x38, 0xfd, 0x09, 0x79, 0x67, 0xe6, 0x4a, 0x4f, 0xf3, 0xe2, 0xa7,
-	0xd3, 0x7c, 0x97, 0x7c, 0xf4, 0x1e, 0x69, 0x96, 0x1d, 0xf2, 0xf6, 0x3e, 0x13, 0xfd, 0xc2, 0x7d,
-	0xab, 0xf8, 0x8d, 0x1c, 0x78, 0x3b, 0x69, 0x2a, 0x2f, 0xf1, 0xa3, 0xd8, 0x02, 0x9f, 0x79, 0x36,
-	0xad, 0x92, 0x7a, 0x27, 0x31, 0xff, 0x8f, 0xd8, 0xbd, 0x0c, 0xa1, 0x28, 0xc5, 0xac, 0xc4, 0x0e,
-	0x09, 0x9b, 0xec, 0x4e, 0x1a, 0x17, 0x45, 0x39, 0xb0, 0x1d, 0x48, 0xb7, 0x09, 0xe9, 0x29, 0x21,
-	0x95, 0xfd, 0x0c, 0xb6, 0x0b, 0xe8, 0xa3, 0xb9, 0x5d, 0x44, 0x9d, 0xc5, 0x09, 0x47, 0xae, 0x2b,
-	0xb7, 0xc4, 0x4d, 0xa5, 0xa2, 0x17, 0x84, 0xde, 0xec, 0xec, 0x30, 0x37, 0x7b, 0xf1, 0x90, 0x17,
-	0x30, 0xed, 0xed, 0x3c, 0x2e, 0xe1, 0xa9, 0xe7, 0xec, 0x37, 0x10, 0x7a, 0x6e, 0x8f, 0xac, 0xde,
-	0x7e, 0x27, 0xf8, 0x09, 0x96, 0x03, 0x37, 0xd7, 0xe1, 0x6c, 0xf4, 0x23, 0x1d, 0xeb, 0xa9, 0x84,
-	0xa3, 0x5f, 0x06, 0xe8, 0x00, 0xb7, 0x06, 0x3e, 0x26, 0xcb, 0xbb, 0xfc, 0x2c, 0x9e, 0xa4, 0xba,
-	0x93, 0x78, 0x1f, 0x51, 0xb3, 0x48, 0xe0, 0xea, 0xa8, 0x64, 0x24, 0x34, 0x4f, 0xf4, 0x44, 0x71,
-	0xf7, 0x7d, 0x30, 0x8b, 0xa4, 0xdf, 0x24, 0x0d, 0xd8, 0xc5, 0xe2, 0x34, 0x2d, 0xb0, 0x4c, 0x67,
-	0x36, 0x50, 0x4b, 0x72, 0x9f, 0x23, 0x8e, 0x33, 0x12, 0xe4, 0x9e, 0xff, 0xa2, 0x8e, 0x1a, 0x82,
-	0x17, 0xba, 0xd9, 0x80, 0xbf, 0xc6, 0x5e, 0x6e, 0x01, 0xc0, 0x7e, 0x54, 0x6e, 0x72, 0x35, 0x66,
-	0x01, 0xb0, 0xd6, 0x1c, 0x8e, 0x2e, 0x25, 0x36, 0xa0, 0x12, 0xa6, 0x2b, 0xa4, 0x72, 0x98, 0xe3,
-	0x37, 0x73, 0xe5, 0x30, 0x8f, 0xc6, 0xce, 0x78, 0x7b, 0x37, 0x68, 0x34, 0xab, 0x15, 0x7e, 0x24,
-	0x5b, 0xc0, 0xe6, 0x4e, 0x39, 0x0a, 0x4d, 0xee, 0x18, 0x27, 0x6c, 0xe1, 0xb7, 0x91, 0x35, 0xed,
-	0xed, 0x9b, 0xcb, 0x75, 0x47, 0xb9, 0xaf, 0x11, 0xc3, 0x18, 0x7d, 0x8b, 0x2c, 0xcf, 0xac, 0xad,
-	0xe0, 0xc6, 0xfd, 0x67, 0x3b, 0x71, 0x32, 0xe2, 0xfd, 0x64, 0xc4, 0xc7, 0xb1, 0x73, 0xf6, 0x0c,
-	0x72, 0xfb, 0xd1, 0xbf, 0xff, 0xd9, 0x0e, 0x7e, 0x7b, 0xdd, 0x0e, 0x7e, 0x7f, 0xdd, 0x0e, 0xfe,
-	0x78, 0xdd, 0x0e, 0x3e, 0xb9, 0x6e, 0x07, 0x7f, 0xb9, 0x6e, 0x07, 0xff, 0xb8, 0x6e, 0x07, 0xa7,
-	0x75, 0xf3, 0x27, 0xe1, 0xb3, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x40, 0x5d, 0x0f, 0x86,
-	0x14, 0x00, 0x00,
+	// 2256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0xa7, 0xe7, 0xff, 0xd4, 0xd8, 0x4e, 0x52, 0x9b, 0xf5, 0x36, 0x26, 0xf2, 0x3a, 0x4d, 0x04,
+	0x86, 0x0d, 0x13, 0xe1, 0x20, 0x08, 0x59, 0x58, 0x31, 0xb6, 0x93, 0x78, 0xb4, 0x71, 0x3c, 0xd4,
+	0x64, 0x12, 0xe0, 0x80, 0xd4, 0xee, 0xa9, 0x19, 0xd7, 0xba, 0xa7, 0xab, 0xd5, 0x55, 0x63, 0xc7,
+	0x7b, 0xe3, 0x1b, 0x20, 0xf1, 0x09, 0xe0, 0x02, 0x1f, 0x81, 0x23, 0xc7, 0x15, 0x07, 0xc4, 0x11,
+	0x09, 0x09, 0x81, 0x2f, 0x9c, 0xb8, 0x23, 0x4e, 0xe8, 0x55, 0xbd, 0xee, 0xa9, 0x99, 0xb1, 0x61,
+	0x03, 0xa7, 0xae, 0xf7, 0xb7, 0xaa, 0xde, 0x7b, 0xf5, 0xab, 0x57, 0x4d, 0x9a, 0x32, 0x12, 0xed,
+	0x34, 0x93, 0x5a, 0xd2, 0xca, 0x38, 0x4b, 0xa3, 0x8d, 0x6f, 0x8c, 0x85, 0x3e, 0x99, 0x1e, 0xb7,
+	0x23, 0x39, 0x79, 0x30, 0x96, 0x63, 0xf9, 0xc0, 0x08, 0x8f, 0xa7, 0x23, 0x43, 0x19, 0xc2, 0x8c,
+	0xac, 0xd1, 0xc6, 0xe6, 0x58, 0xca, 0x71, 0xcc, 0x67, 0x5a, 0xe7, 0x59, 0x98, 0xa6, 0x3c, 0x53,
+	0x56, 0x1e, 0xfc, 0xbd, 0x4c, 0x2a, 0xfd, 0x94, 0x47, 0xd4, 0x27, 0xf5, 0x57, 0x3c, 0x53, 0x42,
+	0x26, 0xbe, 0xb7, 0xe5, 0x6d, 0x37, 0x59, 0x4e, 0xd2, 0xaf, 0x92, 0x7a, 0x2f, 0x93, 0x11, 0x57,
+	0xca, 0x2f, 0x6d, 0x79, 0xdb, 0xad, 0x9d, 0xd5, 0x36, 0xac, 0xa4, 0x8d, 0x4c, 0x96, 0x4b, 0xe9,
+	0x26, 0xa9, 0x30, 0x29, 0xb5, 0x5f, 0x36, 0x5a, 0xc4, 0x6a, 0x01, 0x87, 0x19, 0x3e, 0xdd, 0x20,
+	0x8d, 0x03, 0xa9, 0x74, 0x12, 0x4e, 0xb8, 0x5f, 0x31, 0x73, 0x14, 0x34, 0xfd, 0x1a, 0xa9, 0x1d,
+	0xca, 0x69, 0xa2, 0x95, 0x5f, 0xdd, 0x2a, 0x6f, 0xb7, 0x76, 0x5a, 0xd6, 0xda, 0xf0, 0x76, 0x2b,
+	0x9f, 0xfd, 0xe5, 0xfd, 0x2f, 0x30, 0x54, 0xa0, 0x77, 0x49, 0xf5, 0x40, 0xca, 0x53, 0xe5, 0xd7,
+	0xb6, 0xbc, 0x99, 0xa6, 0x61, 0x31, 0x2b, 0xa1, 0xdf, 0x27, 0xad, 0x4e, 0x92, 0x48, 0x1d, 0x6a,
+	0x21, 0x13, 0xe5, 0xd7, 0x8d, 0xcb, 0x2f, 0x59, 0x45, 0xd8, 0x6d, 0xdb, 0x91, 0x3e, 0x49, 0x74,
+	0x76, 0xc1, 0x5c, 0x7d, 0x98, 0xe1, 0xb9, 0x48, 0xa6, 0x6f, 0xfc, 0x86, 0x3b, 0x83, 0x61, 0x31,
+	0x2b, 0x81, 0xa0, 0xf4, 0x65, 0x1c, 0x66, 0x42, 0xf9, 0x4d, 0x37, 0x28, 0xc8, 0x64, 0xb9, 0x14,
+	0x14, 0x5f, 0x8b, 0x64, 0x28, 0xcf, 0x95, 0x4f, 0x5c, 0x45, 0x64, 0xb2, 0x5c, 0x4a, 0x37, 0x09,
+	0xd9, 0x97, 0x93, 0x50, 0x24, 0x26, 0x3e, 0x2d, 0x13, 0x1f, 0x87, 0xb3, 0xf1, 0x11, 0xb9, 0xb9,
+	0xb8, 0x6a, 0x7a, 0x93, 0x94, 0x4f, 0xf9, 0x05, 0x26, 0x0c, 0x86, 0xf4, 0x36, 0xa9, 0x9e, 0x85,
+	0xf1, 0x94, 0x9b, 0x54, 0x35, 0x99, 0x25, 0x1e, 0x97, 0x1e, 0x79, 0xc1, 0x1f, 0xca, 0x45, 0x1e,
+	0x21, 0x13, 0x2f, 0x79, 0x36, 0x11, 0x49, 0x18, 0x1b, 0xe3, 0x06, 0x2b, 0x68, 0xfa, 0x01, 0x69,
+	0xed, 0xc9, 0x44, 0xc9, 0x98, 0xf7, 0xc5, 0xa7, 0x1c, 0x53, 0xde, 0xb4, 0x8b, 0xde, 0x95, 0x6f,
+	0x98, 0x2b, 0xa5, 0xf7, 0x48, 0x65, 0xa0, 0x78, 0x36, 0x9f, 0x72, 0xe0, 0x60, 0xce, 0x8c, 0x94,
+	0x52, 0x52, 0xe9, 0x64, 0x63, 0xe5, 0x57, 0xb6, 0xca, 0xdb, 0x4d, 0x66, 0xc6, 0xb0, 0xf4, 0x27,
+	0xc9, 0x99, 0xc9, 0x76, 0x93, 0xc1, 0x10, 0x38, 0x7b, 0xe7, 0x43, 0x93, 0xd5, 0x26, 0x83, 0x21,
+	0xfd, 0x90, 0xac, 0xec, 0x85, 0x69, 0x78, 0x2c, 0x62, 0xa1, 0x05, 0x87, 0x3c, 0xc2, 0x2c, 0xef,
+	0x39, 0xe9, 0x70, 0xc5, 0x6c, 0x4e, 0x99, 0x7e, 0x93, 0xd4, 0x59, 0x2c, 0x26, 0x42, 0x2b, 0xbf,
+	0x61, 0xf2, 0x7f, 0x0b, 0xcb, 0xf6, 0xa8, 0xdf, 0xfd, 0x91, 0x95, 0xe0, 0x22, 0x73, 0x3d, 0xba,
+	0x4d, 0x6e, 0xbc, 0x90, 0x2f, 0xf8, 0x79, 0x2f, 0x13, 0x67, 0x22, 0xe6, 0x63, 0x6e, 0x93, 0xdb,
+	0x60, 0x8b, 0x6c, 0xd0, 0xec, 0xa4, 0x69, 0x98, 0x4d, 0x64, 0xd6, 0xcb, 0xe4, 0x48, 0xc4, 0xdc,
+	0x64, 0xb7, 0xc9, 0x16, 0xd9, 0x74, 0x8b, 0xb4, 0x8e, 0x8e, 0x0e, 0xfb, 0x91, 0xcc, 0x78, 0x67,
+	0xf8, 0x89, 0xc9, 0x6b, 0x99, 0xb9, 0x2c, 0x1a, 0x90, 0x95, 0x3e, 0x8f, 0x61, 0x37, 0xcf, 0xc3,
+	0x63, 0x1e, 0xfb, 0x2b, 0xc6, 0xd1, 0x1c, 0x0f, 0x22, 0xd8, 0x0b, 0xf5, 0x89, 0xbf, 0x6a, 0x64,
+	0x66, 0x1c, 0x3c, 0x24, 0xe5, 0x5d, 0xf9, 0x86, 0xae, 0x93, 0xda, 0x01, 0x17, 0xe3, 0x13, 0x6d,
+	0x32, 0xb9, 0xca, 0x90, 0x82, 0x4a, 0x78, 0x2d, 0x86, 0xfa, 0xc4, 0x64, 0x70, 0x95, 0x59, 0x22,
+	0xf8, 0x95, 0x67, 0x33, 0x06, 0xd1, 0x1e, 0x74, 0xf7, 0xd1, 0x06, 0x86, 0xc0, 0x79, 0xd6, 0xdd,
+	0x47, 0x75, 0x18, 0xd2, 0xaf, 0x90, 0xb5, 0xce, 0x70, 0x28, 0xa0, 0xe0, 0xc2, 0xf8, 0x99, 0x18,
+	0x2a, 0xbf, 0xbc, 0x55, 0xde, 0x5e, 0x65, 0x0b, 0x5c, 0x28, 0x27, 0xf0, 0xe9, 0x1e, 0xec, 0x9c,
+	0xa6, 0x3b, 0xa4, 0x3a, 0x98, 0x84, 0xea, 0xd4, 0xaf, 0x9a, 0xe4, 0xdd, 0x69, 0x5b, 0x40, 0x6a,
+	0xe7, 0x80, 0xd4, 0x1e, 0x74, 0x13, 0xfd, 0x70, 0xe7, 0x15, 0xd4, 0x29, 0xb3, 0xaa, 0xc1, 0xaf,
+	0x3d, 0x72, 0x6b, 0x29, 0xbd, 0x30, 0xcb, 0xae, 0x9c, 0x26, 0x43, 0x91, 0x8c, 0x7d, 0xcf, 0x94,
+	0x4d, 0x41, 0xd3, 0x3b, 0xa4, 0xf9, 0x64, 0x34, 0xe2, 0x91, 0x16, 0x67, 0x50, 0xb2, 0x20, 0x9c,
+	0x31, 0x20, 0x07, 0xdd, 0xe4, 0x84, 0x67, 0x42, 0x87, 0xc7, 0x31, 0x37, 0x9b, 0x68, 0x32, 0x97,
+	0x05, 0xf6, 0x3d, 0x38, 0x00, 0x5a, 0xf3, 0x21, 0x96, 0xe9, 0x8c, 0x01, 0xd8, 0xd8, 0x99, 0x1c,
+	0x0b, 0x9e, 0x68, 0xac, 0xd7, 0x9c, 0x0c, 0xba, 0xa4, 0xe5, 0xd4, 0x13, 0xa4, 0xe9, 0xe5, 0x45,
+	0xca, 0xf1, 0x40, 0x9a, 0x31, 0xf0, 0x0e, 0xc2, 0x6c, 0x68, 0xe2, 0x5a, 0x61, 0x66, 0x0c, 0xbc,
+	0xbe, 0x1c, 0x59, 0xa4, 0xac, 0x30, 0x33, 0x0e, 0x24, 0xa9, 0x1a, 0x80, 0x83, 0xd5, 0x0e, 0xb9,
+	0xd2, 0x22, 0x31, 0x27, 0x1d, 0x7d, 0xb9, 0x2c, 0x48, 0xb9, 0x92, 0xd3, 0x2c, 0xca, 0x4f, 0x39,
+	0x52, 0xe0, 0x56, 0xc3, 0xf4, 0x65, 0x3b, 0x3d, 0x8c, 0x61, 0xed, 0x32, 0xb5, 0x30, 0x68, 0xf7,
+	0x95, 0x93, 0xc1, 0xb7, 0x2d, 0x5c, 0x17, 0xb5, 0xe5, 0xcd, 0x6a, 0x0b, 0x62, 0xcd, 0x78, 0x38,
+	0x94, 0x49, 0x7c, 0x61, 0xe6, 0x68, 0xb0, 0x82, 0x0e, 0x7e, 0xe1, 0x21, 0x00, 0xd3, 0xfb, 0xa4,
+	0xd1, 0xcb, 0xb8, 0xd2, 0x61, 0xa6, 0x4d, 0x46, 0x0a, 0x04, 0x00, 0x31, 0x1e, 0xae, 0x42, 0x83,
+	0xb6, 0x49, 0xb3, 0x27, 0x95, 0xb6, 0xea, 0xa5, 0x6b, 0xd4, 0x67, 0x2a, 0xc6, 0xbb, 0x21, 0x64,
+	0xea, 0x97, 0xaf, 0x51, 0x2f, 0x34, 0x82, 0x9f, 0x90, 0x0a, 0xf0, 0xaf, 0xdc, 0x4d, 0x8e, 0x3f,
+	0xa5, 0x65, 0xfc, 0x29, 0xcf, 0xf0, 0xc7, 0x27, 0xf5, 0x97, 0x62, 0xc2, 0xe5, 0x54, 0x9b, 0x22,
+	0x2e, 0xb3, 0x9c, 0x0c, 0xfe, 0x55, 0xc3, 0x0b, 0x81, 0x7e, 0x8f, 0xb4, 0x06, 0xdd, 0xfd, 0xc3,
+	0x30, 0x4d, 0x45, 0x32, 0x56, 0xb8, 0xe9, 0xdb, 0x0e, 0x20, 0x15, 0x42, 0x5c, 0xa0, 0xab, 0x0e,
+	0xd6, 0xcf, 0x1c, 0xeb, 0xd2, 0x7f, 0xb7, 0x76, 0xd4, 0xe9, 0x03, 0x52, 0xeb, 0x5f, 0xa8, 0x48,
+	0xc7, 0x18, 0x0d, 0x17, 0x07, 0xdb, 0x56, 0x62, 0xef, 0x32, 0x54, 0xa3, 0x3b, 0xa4, 0xc9, 0xb8,
+	0x2d, 0x0d, 0x65, 0xb6, 0x34, 0x3f, 0x59, 0x21, 0x63, 0x33, 0x35, 0x28, 0xbe, 0xbd, 0x71, 0x26,
+	0xa7, 0xa9, 0x32, 0x51, 0xac, 0xda, 0xe2, 0x73, 0x58, 0xf4, 0x31, 0x21, 0x2f, 0xc2, 0x09, 0x57,
+	0x69, 0x08, 0x6e, 0x6b, 0x4b, 0x7b, 0x28, 0x84, 0xb8, 0x07, 0x47, 0x1b, 0x30, 0x79, 0x9f, 0x9f,
+	0x89, 0x88, 0xe7, 0x77, 0xf2, 0x2d, 0xc7, 0xd0, 0x4a, 0x72, 0x4c, 0x46, 0x3d, 0x7a, 0x9f, 0xd4,
+	0xfb, 0x3c, 0x8a, 0xe4, 0x24, 0xc5, 0xdb, 0x98, 0x3a, 0x26, 0x28, 0x61, 0xb9, 0x0a, 0xbd, 0x4f,
+	0x6e, 0x41, 0x4d, 0x8f, 0x54, 0x2f, 0x93, 0x69, 0x38, 0xb6, 0x27, 0xa8, 0x69, 0x36, 0xb1, 0x2c,
+	0x80, 0xcd, 0x1e, 0x86, 0xea, 0x94, 0x0f, 0x61, 0x63, 0x70, 0x3f, 0x1b, 0x5c, 0x70, 0x58, 0xf4,
+	0x1e, 0x59, 0xcd, 0xeb, 0xde, 0xea, 0xb4, 0x8c, 0xce, 0x3c, 0x13, 0xae, 0x6e, 0x73, 0x74, 0x5d,
+	0xfc, 0x76, 0x38, 0xf4, 0x01, 0x69, 0x74, 0x13, 0xcd, 0x63, 0x36, 0xd4, 0x06, 0xc1, 0x5b, 0x3b,
+	0xef, 0xb8, 0x49, 0x47, 0x11, 0x2b, 0x94, 0xe8, 0x47, 0xa4, 0x05, 0xb5, 0x77, 0x34, 0x1a, 0x29,
+	0xae, 0x95, 0xbf, 0x66, 0x62, 0x75, 0xc7, 0xcd, 0xb7, 0x23, 0xc6, 0x06, 0xc6, 0xe1, 0xd0, 0x47,
+	0xa4, 0xd5, 0xe3, 0x99, 0x02, 0x80, 0x16, 0xfa, 0xc2, 0xbf, 0x61, 0xe6, 0x5c, 0x77, 0xec, 0x1d,
+	0x29, 0x73, 0x55, 0x37, 0xbe, 0x4b, 0x5a, 0x4e, 0x29, 0xbd, 0x4d, 0x83, 0xb1, 0x31, 0x20, 0x37,
+	0x17, 0x57, 0x75, 0x85, 0xfd, 0x07, 0xae, 0x7d, 0x6b, 0xe7, 0x5d, 0x67, 0x51, 0x33, 0x6b, 0xb7,
+	0x6f, 0x79, 0xbf, 0x68, 0xa0, 0x60, 0xee, 0xe1, 0x74, 0x32, 0xc9, 0xfd, 0x59, 0x02, 0x14, 0xf2,
+	0x66, 0xeb, 0x6a, 0x85, 0x9f, 0x92, 0xb5, 0xf9, 0xd3, 0x65, 0xee, 0x4c, 0xa9, 0x74, 0x71, 0xff,
+	0x21, 0x65, 0xaa, 0x5f, 0x26, 0x3a, 0x14, 0x09, 0xcf, 0x8a, 0xab, 0xd0, 0x65, 0x19, 0xe4, 0x16,
+	0x9f, 0x5a, 0x88, 0x5d, 0x65, 0x66, 0x1c, 0x3c, 0x42, 0xff, 0x45, 0xa1, 0x5f, 0x77, 0x0f, 0x98,
+	0x23, 0x55, 0x72, 0xae, 0xf0, 0x5f, 0x7a, 0xa4, 0xe5, 0xd4, 0xfe, 0x75, 0xe0, 0x65, 0x7c, 0x95,
+	0x1c, 0x5f, 0xb7, 0x49, 0xf5, 0x30, 0xfc, 0x44, 0xda, 0xbe, 0xab, 0xcc, 0x2c, 0x61, 0xb8, 0x22,
+	0x91, 0x19, 0xc2, 0x97, 0x25, 0x00, 0xca, 0x9f, 0x8a, 0x98, 0x1f, 0xca, 0x21, 0x37, 0xc7, 0x79,
+	0x95, 0x15, 0x74, 0xde, 0x04, 0xd4, 0x96, 0x9a, 0x80, 0x7a, 0xd1, 0x04, 0x04, 0x7f, 0x2a, 0xe3,
+	0xf6, 0x66, 0x20, 0xf1, 0x9d, 0xd9, 0x31, 0xf6, 0x96, 0xa0, 0xc8, 0x4a, 0x2c, 0x62, 0x2c, 0x1e,
+	0x66, 0xe8, 0xf2, 0xf9, 0x44, 0x66, 0x17, 0x98, 0x7d, 0xf7, 0xf8, 0x5b, 0x01, 0x43, 0x05, 0xba,
+	0x45, 0xca, 0x7b, 0xbd, 0x01, 0x36, 0x96, 0x6b, 0x6e, 0xcb, 0xd7, 0x1b, 0x30, 0x10, 0xd1, 0x2f,
+	0x93, 0x4a, 0x0f, 0x7a, 0x12, 0x8b, 0x6c, 0x37, 0xdc, 0xea, 0x16, 0x43, 0xc5, 0x8c, 0x10, 0xe0,
+	0x63, 0x37, 0x96, 0xd1, 0x69, 0xf7, 0xc8, 0xaf, 0x2e, 0xc1, 0x07, 0x4a, 0x58, 0xae, 0x42, 0x9f,
+	0x92, 0xb5, 0x83, 0xe9, 0x98, 0xa7, 0xe1, 0x98, 0x3f, 0xb7, 0xad, 0xa3, 0xc5, 0x37, 0xdf, 0x31,
+	0x9a, 0x53, 0xc0, 0x0d, 0x2e, 0x58, 0xc1, 0xac, 0x2f, 0xb8, 0x3e, 0x97, 0xd9, 0xa9, 0x5f, 0x5f,
+	0x9a, 0x15, 0x25, 0x2c, 0x57, 0xa1, 0x1f, 0x92, 0xfa, 0x20, 0x11, 0x23, 0xc1, 0x87, 0xd8, 0xa9,
+	0xde, 0xbd, 0x0a, 0xa5, 0xdb, 0xa8, 0x63, 0x8f, 0x7b, 0x6e, 0xb1, 0xf1, 0x98, 0xac, 0xb8, 0x82,
+	0xb7, 0x7a, 0x12, 0xfc, 0x39, 0x2f, 0x3f, 0x8c, 0xf9, 0x6d, 0xb8, 0xe6, 0x26, 0xc2, 0x76, 0x92,
+	0x65, 0x66, 0x09, 0x38, 0x14, 0x8c, 0x2b, 0x9e, 0x9d, 0x59, 0x34, 0x2d, 0x19, 0x99, 0xcb, 0x32,
+	0x87, 0xe2, 0x3c, 0x4c, 0xb1, 0x1a, 0xcd, 0x18, 0x8e, 0xd8, 0xc7, 0x3c, 0x4b, 0x78, 0x8c, 0xd5,
+	0x88, 0x14, 0x74, 0x5a, 0x76, 0xf4, 0x72, 0xaf, 0x67, 0x52, 0x52, 0x66, 0x33, 0x06, 0x20, 0x29,
+	0x58, 0xa7, 0x22, 0x81, 0xe7, 0x66, 0xcd, 0xb4, 0x47, 0x0e, 0x87, 0x7e, 0x9d, 0xdc, 0xdc, 0x17,
+	0x0a, 0x5a, 0xb6, 0xa3, 0xa3, 0xc3, 0x8f, 0x45, 0x1c, 0xf3, 0xcc, 0x44, 0xb8, 0xc1, 0x96, 0xf8,
+	0xc1, 0xef, 0x3d, 0xd2, 0xc8, 0x2b, 0x06, 0x96, 0xd3, 0x3f, 0x09, 0x33, 0x53, 0xb1, 0xe0, 0x14,
+	0x29, 0xd8, 0xf2, 0x0f, 0xa7, 0x52, 0x87, 0xb8, 0x2d, 0x4b, 0x80, 0x76, 0x8f, 0x67, 0x42, 0x0e,
+	0xb1, 0x43, 0x43, 0x0a, 0xda, 0x7e, 0xc6, 0xc3, 0x58, 0x8b, 0x09, 0x67, 0xd3, 0x04, 0x3e, 0xb8,
+	0xbb, 0x45, 0x36, 0xb4, 0xce, 0x39, 0x0b, 0x3d, 0x55, 0x8d, 0xa7, 0x05, 0x2e, 0x84, 0x6e, 0x2f,
+	0x9d, 0x2a, 0x7c, 0xf5, 0x98, 0x31, 0xf0, 0x0e, 0xf9, 0xc4, 0x3e, 0x77, 0x9a, 0xcc, 0x8c, 0x83,
+	0x73, 0xec, 0x88, 0x5f, 0x9b, 0xe6, 0x1e, 0xe1, 0xa2, 0x80, 0x01, 0xef, 0x4a, 0x18, 0x28, 0xb9,
+	0x30, 0xb0, 0x4e, 0x6a, 0xd6, 0x16, 0xa1, 0x0b, 0x29, 0x88, 0xf8, 0x73, 0x1e, 0x8e, 0x50, 0x56,
+	0x31, 0x32, 0x87, 0x13, 0x0c, 0xc8, 0x3b, 0x16, 0x9c, 0x4f, 0x32, 0xa9, 0x75, 0xcc, 0xff, 0x87,
+	0xa9, 0x29, 0xa9, 0xb0, 0x50, 0xf3, 0xbc, 0xdb, 0x85, 0x71, 0xf0, 0x8f, 0x32, 0x59, 0x71, 0xcf,
+	0xa0, 0xb3, 0x3e, 0xef, 0x3f, 0xac, 0xaf, 0xb4, 0xb8, 0x3e, 0xda, 0x21, 0x2b, 0x6e, 0x4c, 0xae,
+	0xe8, 0x8d, 0x5c, 0x31, 0x9e, 0xd7, 0x39, 0x13, 0x3a, 0x20, 0xef, 0xe6, 0xbb, 0x83, 0x7b, 0x7d,
+	0x37, 0x55, 0xe8, 0xab, 0x62, 0x7c, 0x7d, 0xd1, 0xbd, 0xa2, 0xe6, 0xa2, 0x80, 0xde, 0xae, 0xb6,
+	0xa6, 0xaf, 0xc9, 0x7a, 0x2e, 0x78, 0x9d, 0x09, 0xcd, 0x67, 0x7e, 0xab, 0x9f, 0xcf, 0xef, 0x35,
+	0xe6, 0xae, 0x63, 0x98, 0xb1, 0x7b, 0xd4, 0xeb, 0xa3, 0xe3, 0xda, 0x5b, 0x3a, 0x9e, 0x37, 0xa7,
+	0x3f, 0x26, 0xef, 0xcd, 0x4d, 0xe9, 0x78, 0xae, 0x7f, 0x3e, 0xcf, 0xd7, 0xd9, 0x07, 0x77, 0x49,
+	0xb3, 0x80, 0xe6, 0xab, 0x71, 0x26, 0xf8, 0x59, 0xfe, 0xea, 0x73, 0x6f, 0x10, 0xd0, 0xed, 0xc4,
+	0xb1, 0x3c, 0xc7, 0xff, 0x14, 0x96, 0xf8, 0xbf, 0x2f, 0xc5, 0x75, 0x52, 0xeb, 0x44, 0xe6, 0x97,
+	0x96, 0xed, 0x70, 0x91, 0x0a, 0x62, 0xac, 0xca, 0x1c, 0x9a, 0x7d, 0x52, 0xdf, 0x8b, 0x43, 0xa5,
+	0x8a, 0x4e, 0x21, 0x27, 0xe9, 0x2e, 0x21, 0xbd, 0x4c, 0xc8, 0xcc, 0xfe, 0x99, 0x28, 0x2d, 0x75,
+	0x68, 0xd0, 0xcb, 0x65, 0xa3, 0x30, 0xe2, 0xa8, 0x75, 0x91, 0xb7, 0xc3, 0x33, 0xab, 0xe0, 0x29,
+	0xa1, 0xcb, 0x57, 0x0a, 0x5c, 0xd8, 0xbd, 0x70, 0xcc, 0x15, 0xb4, 0x19, 0x16, 0xc6, 0x0b, 0x7a,
+	0x16, 0x39, 0xfb, 0x9a, 0xc4, 0xc8, 0x1d, 0x90, 0xf5, 0xab, 0xe7, 0x84, 0x38, 0x41, 0x57, 0x92,
+	0x37, 0x14, 0x30, 0x36, 0xfe, 0x51, 0x8e, 0xe7, 0xa9, 0xa0, 0x83, 0x9f, 0x7b, 0x18, 0x80, 0xbc,
+	0xa1, 0xbe, 0x47, 0x56, 0xf7, 0xf9, 0x28, 0x9c, 0xc6, 0xba, 0x13, 0x39, 0xcf, 0xd1, 0x79, 0x26,
+	0x68, 0x75, 0xb2, 0xe8, 0x44, 0x68, 0x1e, 0xe9, 0x69, 0xc6, 0xf3, 0x97, 0xd6, 0x3c, 0x93, 0x7e,
+	0x8b, 0x34, 0xa0, 0xb7, 0x0c, 0xe3, 0x58, 0xe1, 0x31, 0x9d, 0xeb, 0xe5, 0xad, 0x28, 0x7f, 0xd8,
+	0xe5, 0x9a, 0x81, 0x20, 0x37, 0xdc, 0x15, 0x75, 0xb2, 0x31, 0x44, 0xa1, 0x9b, 0x0c, 0xf9, 0x1b,
+	0xc4, 0x72, 0x4b, 0x00, 0xf7, 0x55, 0x71, 0xcf, 0x55, 0x98, 0x25, 0x60, 0xb7, 0x66, 0xf0, 0xf2,
+	0x5c, 0x22, 0x00, 0x15, 0x34, 0x5d, 0x23, 0xa5, 0xa3, 0x14, 0xff, 0x58, 0x94, 0x8e, 0xd2, 0x60,
+	0x92, 0x6f, 0xde, 0xce, 0x0d, 0x1e, 0x4d, 0x4f, 0x87, 0xbf, 0x1b, 0x2c, 0x61, 0x6b, 0xa7, 0xb8,
+	0x0a, 0x9b, 0x0c, 0x29, 0xfa, 0x00, 0x5f, 0x99, 0x76, 0x6b, 0xef, 0x2e, 0x3f, 0x53, 0x3a, 0x59,
+	0xfe, 0xae, 0x33, 0x8a, 0x81, 0x24, 0xab, 0x73, 0x0f, 0x00, 0x08, 0xe3, 0xf3, 0x87, 0x7b, 0x61,
+	0x74, 0xc2, 0xfb, 0xd1, 0x09, 0x9f, 0x84, 0x79, 0xb0, 0xe7, 0x98, 0xe6, 0xd5, 0xc2, 0x27, 0xbb,
+	0xe7, 0xa8, 0x63, 0x17, 0xe1, 0xb2, 0x60, 0x85, 0x7b, 0xb1, 0x84, 0xa2, 0xb5, 0x7f, 0x02, 0x90,
+	0x0a, 0x3a, 0xe4, 0xc6, 0x42, 0xa3, 0x6d, 0xae, 0x6e, 0x1e, 0x29, 0x3c, 0x89, 0x66, 0x0c, 0x21,
+	0x7b, 0x11, 0x26, 0x52, 0x01, 0x1f, 0x0b, 0x24, 0xa7, 0x83, 0x1f, 0x90, 0x9b, 0x8b, 0x0f, 0x08,
+	0x98, 0xce, 0xfe, 0xa7, 0xc4, 0xf5, 0x22, 0x05, 0xe1, 0x7b, 0x1a, 0x87, 0xc5, 0xbb, 0xdb, 0x12,
+	0xbb, 0x77, 0xfe, 0xf9, 0xb7, 0x4d, 0xef, 0x37, 0x97, 0x9b, 0xde, 0x6f, 0x2f, 0x37, 0xbd, 0xdf,
+	0x5d, 0x6e, 0x7a, 0x9f, 0x5d, 0x6e, 0x7a, 0x7f, 0xbc, 0xdc, 0xf4, 0xfe, 0x7a, 0xb9, 0xe9, 0x1d,
+	0xd7, 0xcc, 0x7f, 0xa1, 0x87, 0xff, 0x1e, 0x00, 0xe5, 0x94, 0xd3, 0xc8, 0xf8, 0x16, 0x00, 0x00,
 }
@@ -9,3 +9,7 @@ package grpc
 // APIVersion specifies the version of the gRPC communications protocol used
 // by Kata Containers.
 const APIVersion = "0.0.1"
+
+// MinAPIVersion specifies the oldest version of the gRPC communications
+// protocol supported by the agent.
+const MinAPIVersion = "0.0.1"
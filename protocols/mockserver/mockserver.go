@@ -151,6 +151,20 @@ func (m *mockServer) CreateContainer(ctx context.Context, req *pb.CreateContaine
 	return &types.Empty{}, nil
 }
 
+func (m *mockServer) CreateContainerDryRun(ctx context.Context, req *pb.CreateContainerRequest) (*pb.CreateContainerDryRunResponse, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.containerNonExist(req.ContainerId); err != nil {
+		return nil, err
+	}
+
+	if err := validateOCISpec(req.OCI); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateContainerDryRunResponse{}, nil
+}
+
 func (m *mockServer) StartContainer(ctx context.Context, req *pb.StartContainerRequest) (*types.Empty, error) {
 	mockLock.RLock()
 	defer mockLock.RUnlock()
@@ -442,3 +456,128 @@ func (m *mockServer) StopTracing(ctx context.Context, req *pb.StopTracingRequest
 func (m *mockServer) GetOOMEvent(ctx context.Context, req *pb.GetOOMEventRequest) (*pb.OOMEvent, error) {
 	return nil, nil
 }
+
+func (m *mockServer) DropCaches(ctx context.Context, req *pb.DropCachesRequest) (*pb.DropCachesResponse, error) {
+	return &pb.DropCachesResponse{}, nil
+}
+
+func (m *mockServer) CheckpointContainer(ctx context.Context, req *pb.CheckpointContainerRequest) (*pb.CheckpointContainerResponse, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	if err := m.containerExist(req.ContainerId); err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckpointContainerResponse{ImagePath: req.ImagePath}, nil
+}
+
+func (m *mockServer) FreezeFS(ctx context.Context, req *pb.FreezeFSRequest) (*pb.FreezeFSResponse, error) {
+	return &pb.FreezeFSResponse{MountPoints: req.MountPoints}, nil
+}
+
+func (m *mockServer) ThawFS(ctx context.Context, req *pb.ThawFSRequest) (*pb.ThawFSResponse, error) {
+	return &pb.ThawFSResponse{}, nil
+}
+
+func (m *mockServer) ResizeVolume(ctx context.Context, req *pb.ResizeVolumeRequest) (*pb.ResizeVolumeResponse, error) {
+	return &pb.ResizeVolumeResponse{DeviceSize: req.DeviceSize}, nil
+}
+
+func (m *mockServer) ListDir(ctx context.Context, req *pb.ListDirRequest) (*pb.ListDirResponse, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	if err := m.containerExist(req.ContainerId); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListDirResponse{}, nil
+}
+
+func (m *mockServer) GetMemoryInfo(ctx context.Context, req *pb.GetMemoryInfoRequest) (*pb.MemoryInfo, error) {
+	return &pb.MemoryInfo{Guest: &pb.GuestMemoryInfo{}}, nil
+}
+
+func (m *mockServer) GetLogs(ctx context.Context, req *pb.GetLogsRequest) (*pb.GetLogsResponse, error) {
+	return &pb.GetLogsResponse{}, nil
+}
+
+func (m *mockServer) RunOnce(ctx context.Context, req *pb.RunOnceRequest) (*pb.RunOnceResponse, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	if err := m.containerExist(req.ContainerId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RunOnceResponse{}, nil
+}
+
+func (m *mockServer) Events(req *pb.EventsRequest, stream pb.AgentService_EventsServer) error {
+	return nil
+}
+
+func (m *mockServer) WriteFiles(ctx context.Context, req *pb.WriteFilesRequest) (*types.Empty, error) {
+	return &types.Empty{}, nil
+}
+
+func (m *mockServer) GetMetrics(ctx context.Context, req *pb.GetMetricsRequest) (*pb.Metrics, error) {
+	return &pb.Metrics{}, nil
+}
+
+func (m *mockServer) ReadLog(req *pb.ReadLogRequest, stream pb.AgentService_ReadLogServer) error {
+	return nil
+}
+
+func (m *mockServer) ValidateStorage(ctx context.Context, req *pb.ValidateStorageRequest) (*pb.ValidateStorageResponse, error) {
+	return &pb.ValidateStorageResponse{}, nil
+}
+
+func (m *mockServer) UpdateResolvConf(ctx context.Context, req *pb.UpdateResolvConfRequest) (*types.Empty, error) {
+	return &types.Empty{}, nil
+}
+
+func (m *mockServer) Handshake(ctx context.Context, req *pb.HandshakeRequest) (*pb.HandshakeResponse, error) {
+	return &pb.HandshakeResponse{
+		ProtocolVersion:    pb.APIVersion,
+		MinProtocolVersion: pb.MinAPIVersion,
+	}, nil
+}
+
+func (m *mockServer) GetCgroupPath(ctx context.Context, req *pb.GetCgroupPathRequest) (*pb.GetCgroupPathResponse, error) {
+	return &pb.GetCgroupPathResponse{}, nil
+}
+
+func (m *mockServer) SetGuestProxy(ctx context.Context, req *pb.SetGuestProxyRequest) (*types.Empty, error) {
+	return &types.Empty{}, nil
+}
+
+func (m *mockServer) ReadStdio(req *pb.ReadStdioRequest, stream pb.AgentService_ReadStdioServer) error {
+	return nil
+}
+
+func (m *mockServer) GracefulShutdown(ctx context.Context, req *pb.GracefulShutdownRequest) (*pb.GracefulShutdownResponse, error) {
+	return &pb.GracefulShutdownResponse{}, nil
+}
+
+func (m *mockServer) GetInitStatus(ctx context.Context, req *pb.GetInitStatusRequest) (*pb.InitStatus, error) {
+	return &pb.InitStatus{}, nil
+}
+
+func (m *mockServer) GetContainerMetrics(ctx context.Context, req *pb.GetContainerMetricsRequest) (*pb.ContainerMetrics, error) {
+	return &pb.ContainerMetrics{}, nil
+}
+
+func (m *mockServer) SyncContainer(ctx context.Context, req *pb.SyncContainerRequest) (*pb.SyncContainerResponse, error) {
+	return &pb.SyncContainerResponse{}, nil
+}
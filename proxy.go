@@ -0,0 +1,136 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"net/url"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// guestProxy is the HTTP(S) proxy configuration of the sandbox, passed to
+// the containers created after it is set.
+type guestProxy struct {
+	httpProxy  string
+	httpsProxy string
+	noProxy    string
+}
+
+// Schemes of the proxy URLs understood by the usual HTTP clients.
+var proxySchemes = map[string]bool{
+	"http":    true,
+	"https":   true,
+	"socks5":  true,
+	"socks5h": true,
+}
+
+// validateProxyURL checks that proxy, if not empty, is an absolute URL of a
+// proxy server, such as "http://proxy.example.com:3128".
+func validateProxyURL(proxy string) error {
+	if proxy == "" {
+		return nil
+	}
+
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid proxy URL %q: %v", proxy, err)
+	}
+
+	if !proxySchemes[u.Scheme] {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid proxy URL %q: unsupported scheme %q", proxy, u.Scheme)
+	}
+
+	if u.Hostname() == "" {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid proxy URL %q: no host", proxy)
+	}
+
+	return nil
+}
+
+// validateNoProxy checks that noProxy is a comma separated list of hosts,
+// domains or CIDRs.
+func validateNoProxy(noProxy string) error {
+	if noProxy == "" {
+		return nil
+	}
+
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.ContainsAny(entry, " \t") {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid no proxy list %q", noProxy)
+		}
+	}
+
+	return nil
+}
+
+// newGuestProxy returns the proxy configuration described by req.
+func newGuestProxy(req *pb.SetGuestProxyRequest) (guestProxy, error) {
+	for _, proxy := range []string{req.HttpProxy, req.HttpsProxy} {
+		if err := validateProxyURL(proxy); err != nil {
+			return guestProxy{}, err
+		}
+	}
+
+	if err := validateNoProxy(req.NoProxy); err != nil {
+		return guestProxy{}, err
+	}
+
+	return guestProxy{
+		httpProxy:  req.HttpProxy,
+		httpsProxy: req.HttpsProxy,
+		noProxy:    req.NoProxy,
+	}, nil
+}
+
+// env returns the proxy variables of p, in upper and lower case as the
+// clients disagree on the one to use.
+func (p guestProxy) env() []string {
+	var env []string
+
+	for _, v := range []struct {
+		name  string
+		value string
+	}{
+		{"HTTP_PROXY", p.httpProxy},
+		{"HTTPS_PROXY", p.httpsProxy},
+		{"NO_PROXY", p.noProxy},
+	} {
+		if v.value != "" {
+			env = append(env, v.name+"="+v.value, strings.ToLower(v.name)+"="+v.value)
+		}
+	}
+
+	return env
+}
+
+// setupProxyEnv adds the proxy configuration of the sandbox to the
+// environment of process. The variables already set by the process are
+// kept.
+func (s *sandbox) setupProxyEnv(process *pb.Process) {
+	if process == nil {
+		return
+	}
+
+	s.RLock()
+	proxy := s.proxy
+	s.RUnlock()
+
+	set := make(map[string]bool)
+	for _, v := range process.Env {
+		set[strings.SplitN(v, "=", 2)[0]] = true
+	}
+
+	for _, v := range proxy.env() {
+		if !set[strings.SplitN(v, "=", 2)[0]] {
+			process.Env = append(process.Env, v)
+		}
+	}
+}
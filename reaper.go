@@ -148,38 +148,54 @@ func (r *agentReaper) reap() error {
 
 		status := exitStatus(ws)
 
-		agentLog.WithFields(logrus.Fields{
-			"pid":    pid,
-			"status": status,
-		}).Debug("process exited")
-
-		exitCodeCh, err := r.getExitCodeCh(pid)
-		if err != nil {
+		if !r.notifyExit(pid, status) {
 			// No need to signal a process with no channel
 			// associated. When a process has not been registered,
 			// this means the spawner does not expect to get the
-			// exit code from this process.
-			continue
+			// exit code from this process. This is the case of
+			// the orphaned processes the agent inherits as the
+			// subreaper of the sandbox, which still need to be
+			// reaped to not be left as zombies.
+			agentLog.WithFields(logrus.Fields{
+				"pid":    pid,
+				"status": status,
+			}).Debug("reaped untracked process")
 		}
+	}
+}
 
-		// Let's delete the entry here since the channel has been
-		// stored by the caller, in order to wait for the exit code.
-		r.deleteExitCodeCh(pid)
-
-		// Here, we have to signal the routine listening on
-		// this channel so that it can complete the cleanup
-		// of the process and return the exit code to the
-		// caller of WaitProcess().
-		exitCodeCh <- status
-
-		epoller, err := r.getEpoller(pid)
-		if err == nil {
-			//close the socket file to notify readStdio to close terminal specifically
-			//in case this process's terminal has been inherited by its children.
-			epoller.sockW.Close()
-		}
-		r.deleteEpoller(pid)
+// notifyExit routes the exit status of a reaped process to the routine
+// waiting for it, if any, and returns whether such a routine was found.
+func (r *agentReaper) notifyExit(pid, status int) bool {
+	agentLog.WithFields(logrus.Fields{
+		"pid":    pid,
+		"status": status,
+	}).Debug("process exited")
+
+	exitCodeCh, err := r.getExitCodeCh(pid)
+	if err != nil {
+		return false
 	}
+
+	// Let's delete the entry here since the channel has been
+	// stored by the caller, in order to wait for the exit code.
+	r.deleteExitCodeCh(pid)
+
+	// Here, we have to signal the routine listening on
+	// this channel so that it can complete the cleanup
+	// of the process and return the exit code to the
+	// caller of WaitProcess().
+	exitCodeCh <- status
+
+	epoller, err := r.getEpoller(pid)
+	if err == nil {
+		//close the socket file to notify readStdio to close terminal specifically
+		//in case this process's terminal has been inherited by its children.
+		epoller.sockW.Close()
+	}
+	r.deleteEpoller(pid)
+
+	return true
 }
 
 // start starts the exec command and registers the process to the reaper.
@@ -0,0 +1,99 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// waitZombie waits for the process to exit without being reaped.
+func waitZombie(pid int) error {
+	statPath := fmt.Sprintf("/proc/%d/stat", pid)
+
+	for i := 0; i < 500; i++ {
+		content, err := ioutil.ReadFile(statPath)
+		if err != nil {
+			return err
+		}
+
+		// pid (comm) state ...
+		fields := strings.Fields(string(content[strings.LastIndex(string(content), ")")+1:]))
+		if len(fields) > 0 && fields[0] == "Z" {
+			return nil
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return fmt.Errorf("process %d did not exit", pid)
+}
+
+func TestReaperReapUntrackedProcess(t *testing.T) {
+	assert := assert.New(t)
+
+	r := &agentReaper{}
+	r.init()
+
+	cmd := exec.Command("true")
+	assert.NoError(cmd.Start())
+	pid := cmd.Process.Pid
+
+	assert.NoError(waitZombie(pid))
+
+	err := r.reap()
+	assert.NoError(err)
+
+	_, err = os.Stat(fmt.Sprintf("/proc/%d", pid))
+	assert.True(os.IsNotExist(err), "zombie process %d left", pid)
+
+	// Nothing left to reap
+	err = r.reap()
+	assert.NoError(err)
+}
+
+func TestReaperReapTrackedProcess(t *testing.T) {
+	assert := assert.New(t)
+
+	r := &agentReaper{}
+	r.init()
+
+	untracked := exec.Command("true")
+	assert.NoError(untracked.Start())
+
+	tracked := exec.Command("false")
+	exitCodeCh, err := r.start(tracked)
+	assert.NoError(err)
+
+	assert.NoError(waitZombie(untracked.Process.Pid))
+	assert.NoError(waitZombie(tracked.Process.Pid))
+
+	err = r.reap()
+	assert.NoError(err)
+
+	select {
+	case exitCode := <-exitCodeCh:
+		assert.Equal(1, exitCode)
+	default:
+		assert.Fail("exit code of tracked process not received")
+	}
+
+	_, err = r.getExitCodeCh(tracked.Process.Pid)
+	assert.Error(err)
+
+	for _, pid := range []int{untracked.Process.Pid, tracked.Process.Pid} {
+		_, err = os.Stat(fmt.Sprintf("/proc/%d", pid))
+		assert.True(os.IsNotExist(err), "zombie process %d left", pid)
+	}
+}
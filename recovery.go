@@ -0,0 +1,221 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/opencontainers/runc/libcontainer/utils"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// Label of the libcontainer configuration holding the exec ID of the
+// container init process, so that it can be tracked again after a restart.
+const execIDLabel = "io.katacontainers.agent.exec-id"
+
+// File holding the libcontainer state of a container, in its directory.
+const libcontainerStateFile = "state.json"
+
+// Exit code reported for a recovered process whose exit status cannot be
+// known, since it is not a child of the agent.
+const recoveredUnknownExitCode = 255
+
+// Interval at which the recovered init processes are checked for exit.
+var recoveredProcessPollInterval = time.Second
+
+// recoverContainers rebuilds the containers tracked by the sandbox from the
+// libcontainer state left under libcontainerPath by a previous instance of
+// the agent, whose processes may still be running. The state of the sandbox
+// itself, like its shared namespaces and storages, is not recovered.
+func (s *sandbox) recoverContainers() error {
+	entries, err := ioutil.ReadDir(libcontainerPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// The directories holding no container state, like the OCI spec
+	// directories of the containers, are not sandboxes.
+	var sandboxIDs []string
+	for _, e := range entries {
+		if e.IsDir() && holdsContainerState(filepath.Join(libcontainerPath, e.Name())) {
+			sandboxIDs = append(sandboxIDs, e.Name())
+		}
+	}
+
+	switch len(sandboxIDs) {
+	case 0:
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("Found the state of several sandboxes in %s: %v", libcontainerPath, sandboxIDs)
+	}
+
+	sandboxPath := filepath.Join(libcontainerPath, sandboxIDs[0])
+	factory, err := libcontainer.New(sandboxPath, libcontainer.Cgroupfs)
+	if err != nil {
+		return err
+	}
+
+	entries, err = ioutil.ReadDir(sandboxPath)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		ctr, err := s.recoverContainer(factory, e.Name())
+		if err != nil {
+			agentLog.WithError(err).WithField("container", e.Name()).Warn("Could not recover container")
+			continue
+		}
+
+		s.containers[ctr.id] = ctr
+	}
+
+	if len(s.containers) > 0 {
+		s.id = sandboxIDs[0]
+		s.running = true
+		agentLog = agentLog.WithField("sandbox", s.id)
+		agentLog.WithField("containers", len(s.containers)).Info("recovered containers")
+	}
+
+	return nil
+}
+
+// holdsContainerState returns whether dir holds the libcontainer state of a
+// container.
+func holdsContainerState(dir string) bool {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, e.Name(), libcontainerStateFile)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recoverContainer loads the container id from factory and tracks its init
+// process again.
+func (s *sandbox) recoverContainer(factory libcontainer.Factory, id string) (*container, error) {
+	c, err := factory.Load(id)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := c.State()
+	if err != nil {
+		return nil, err
+	}
+
+	ctr := &container{
+		id:        id,
+		container: c,
+		config:    state.Config,
+		processes: make(map[string]*process),
+		ctx:       s.ctx,
+	}
+
+	execID := utils.SearchLabels(state.Config.Labels, execIDLabel)
+	if execID == "" {
+		execID = id
+	}
+
+	ctr.initProcess = &process{
+		id:           execID,
+		recoveredPid: state.InitProcessPid,
+		exitCodeCh:   make(chan int, 1),
+	}
+	ctr.setProcess(ctr.initProcess)
+
+	exitCodeCh := s.forwardInitExit(ctr, ctr.initProcess)
+
+	status, err := c.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	if status == libcontainer.Stopped {
+		exitCodeCh <- recoveredUnknownExitCode
+		return ctr, nil
+	}
+
+	s.subreaper.setExitCodeCh(state.InitProcessPid, exitCodeCh)
+	go s.monitorRecoveredProcess(ctr)
+
+	return ctr, nil
+}
+
+// monitorRecoveredProcess waits for the recovered init process of ctr to
+// exit. The process is reaped by the reaper when it is a child of the agent,
+// otherwise it is polled and its exit reported with an unknown exit code.
+func (s *sandbox) monitorRecoveredProcess(ctr *container) {
+	pid := ctr.initProcess.recoveredPid
+
+	for {
+		time.Sleep(recoveredProcessPollInterval)
+
+		// The reaper cannot reap the process while the lock is held.
+		s.subreaper.lock()
+
+		exitCodeCh, err := s.subreaper.getExitCodeCh(pid)
+		if err != nil {
+			// Already reaped by the reaper.
+			s.subreaper.unlock()
+			return
+		}
+
+		status, err := ctr.container.Status()
+		if err != nil || status != libcontainer.Stopped {
+			s.subreaper.unlock()
+			continue
+		}
+
+		exitCode := recoveredUnknownExitCode
+
+		var ws unix.WaitStatus
+		if wpid, err := unix.Wait4(pid, &ws, unix.WNOHANG, nil); err == nil && wpid == pid {
+			exitCode = exitStatus(ws)
+		}
+
+		s.subreaper.deleteExitCodeCh(pid)
+		s.subreaper.unlock()
+
+		agentLog.WithFields(logrus.Fields{
+			"container": ctr.id,
+			"pid":       pid,
+			"exit-code": exitCode,
+		}).Info("recovered container exited")
+
+		exitCodeCh <- exitCode
+
+		return
+	}
+}
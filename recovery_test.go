@@ -0,0 +1,202 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/system"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeContainerState writes the libcontainer state of a container whose
+// init process is pid, as done by libcontainer when creating it.
+func writeContainerState(t *testing.T, sandboxPath, id string, pid int, startTime uint64, labels []string) {
+	dir := filepath.Join(sandboxPath, id)
+	err := os.MkdirAll(dir, 0700)
+	assert.NoError(t, err)
+
+	state := libcontainer.State{
+		BaseState: libcontainer.BaseState{
+			ID:                   id,
+			InitProcessPid:       pid,
+			InitProcessStartTime: startTime,
+			Config: configs.Config{
+				Rootfs:  "/",
+				Labels:  labels,
+				Cgroups: &configs.Cgroup{Resources: &configs.Resources{}},
+			},
+		},
+	}
+
+	data, err := json.Marshal(state)
+	assert.NoError(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(dir, libcontainerStateFile), data, 0600)
+	assert.NoError(t, err)
+}
+
+func TestRecoverContainers(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "recover")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedLibcontainerPath, savedPollInterval := libcontainerPath, recoveredProcessPollInterval
+	libcontainerPath = dir
+	recoveredProcessPollInterval = 10 * time.Millisecond
+	defer func() {
+		libcontainerPath, recoveredProcessPollInterval = savedLibcontainerPath, savedPollInterval
+	}()
+
+	r, stopReaper := startTestReaper()
+	defer stopReaper()
+
+	// The process of a running container, left by a previous agent.
+	cmd := exec.Command("sleep", "100")
+	r.lock()
+	err = cmd.Start()
+	r.unlock()
+	assert.NoError(err)
+	defer cmd.Process.Kill()
+
+	stat, err := system.Stat(cmd.Process.Pid)
+	assert.NoError(err)
+
+	sandboxPath := filepath.Join(dir, "sandbox")
+	writeContainerState(t, sandboxPath, "running", cmd.Process.Pid, stat.StartTime, []string{execIDLabel + "=init"})
+	// The process of this one is gone, the start time does not match.
+	writeContainerState(t, sandboxPath, "stopped", cmd.Process.Pid, stat.StartTime+1, nil)
+	// Not a container.
+	err = os.MkdirAll(filepath.Join(sandboxPath, "broken"), 0700)
+	assert.NoError(err)
+	// The OCI spec of a container, stored along with the sandbox state,
+	// is not a sandbox.
+	err = os.MkdirAll(filepath.Join(dir, "running"), 0700)
+	assert.NoError(err)
+	err = ioutil.WriteFile(filepath.Join(dir, "running", ociConfigFile), []byte("{}"), 0600)
+	assert.NoError(err)
+
+	s := &sandbox{
+		ctx:        context.Background(),
+		containers: make(map[string]*container),
+		subreaper:  r,
+	}
+	a := &agentGRPC{sandbox: s}
+
+	err = s.recoverContainers()
+	assert.NoError(err)
+
+	assert.Equal("sandbox", s.id)
+	assert.True(s.running)
+	assert.Len(s.containers, 2)
+
+	type testData struct {
+		id             string
+		execID         string
+		expectedStatus libcontainer.Status
+	}
+
+	data := []testData{
+		{"running", "init", libcontainer.Running},
+		{"stopped", "stopped", libcontainer.Stopped},
+	}
+
+	for _, d := range data {
+		ctr, err := s.getContainer(d.id)
+		assert.NoError(err, d.id)
+
+		status, err := ctr.container.Status()
+		assert.NoError(err, d.id)
+		assert.Equal(d.expectedStatus, status, d.id)
+
+		_, err = ctr.getProcess(d.execID)
+		assert.NoError(err, d.id)
+
+		pid, err := ctr.initProcess.pid()
+		assert.NoError(err, d.id)
+		assert.Equal(cmd.Process.Pid, pid, d.id)
+	}
+
+	// The exit code of a process exited while the agent was down is unknown.
+	resp, err := a.WaitProcess(context.Background(), &pb.WaitProcessRequest{
+		ContainerId: "stopped",
+		ExecId:      "stopped",
+	})
+	assert.NoError(err)
+	assert.Equal(int32(recoveredUnknownExitCode), resp.Status)
+
+	// The exit of a running process is reported.
+	err = cmd.Process.Signal(syscall.SIGKILL)
+	assert.NoError(err)
+
+	resp, err = a.WaitProcess(context.Background(), &pb.WaitProcessRequest{
+		ContainerId: "running",
+		ExecId:      "init",
+	})
+	assert.NoError(err)
+	assert.Equal(int32(exitSignalOffset+int(syscall.SIGKILL)), resp.Status)
+
+	events, _, err := s.events.since(0)
+	assert.NoError(err)
+	assert.Len(events, 2)
+}
+
+func TestRecoverContainersNoState(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "recover")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedLibcontainerPath := libcontainerPath
+	defer func() {
+		libcontainerPath = savedLibcontainerPath
+	}()
+
+	s := &sandbox{
+		containers: make(map[string]*container),
+		subreaper:  &mockreaper{},
+	}
+
+	// Nothing to recover.
+	libcontainerPath = filepath.Join(dir, "missing")
+	assert.NoError(s.recoverContainers())
+
+	libcontainerPath = dir
+	assert.NoError(s.recoverContainers())
+
+	// An empty sandbox does not make the sandbox running.
+	err = os.Mkdir(filepath.Join(dir, "sandbox"), 0700)
+	assert.NoError(err)
+	assert.NoError(s.recoverContainers())
+	assert.False(s.running)
+	assert.Empty(s.id)
+
+	// Neither do directories holding no container state.
+	err = os.MkdirAll(filepath.Join(dir, "ctr", "rootfs-overlay"), 0700)
+	assert.NoError(err)
+	assert.NoError(s.recoverContainers())
+	assert.False(s.running)
+
+	// Only the state of a single sandbox can be recovered.
+	writeContainerState(t, filepath.Join(dir, "sandbox"), "c1", 0, 0, nil)
+	writeContainerState(t, filepath.Join(dir, "other"), "c2", 0, 0, nil)
+	assert.Error(s.recoverContainers())
+}
@@ -0,0 +1,121 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+)
+
+const (
+	redactedValue = "<redacted>"
+
+	// Environment variable names usually holding a secret.
+	defaultRedactEnvPattern = `(?i)(passw(or)?d|secret|token|credential|api_?key|access_?key|private_?key)`
+)
+
+// redactEnv returns env, the values of the variables which name matches
+// redactEnvPattern being masked.
+func redactEnv(env []string) []string {
+	if redactEnvPattern == nil || len(env) == 0 {
+		return env
+	}
+
+	redacted := make([]string, len(env))
+	for i, e := range env {
+		redacted[i] = e
+
+		name := strings.SplitN(e, "=", 2)[0]
+		if len(name) < len(e) && redactEnvPattern.MatchString(name) {
+			redacted[i] = name + "=" + redactedValue
+		}
+	}
+
+	return redacted
+}
+
+// redactHooks returns a copy of hooks, the environment of each hook being
+// redacted.
+func redactHooks(hooks []pb.Hook) []pb.Hook {
+	if len(hooks) == 0 {
+		return hooks
+	}
+
+	redacted := make([]pb.Hook, len(hooks))
+	for i, hook := range hooks {
+		redacted[i] = hook
+		redacted[i].Env = redactEnv(hook.Env)
+	}
+
+	return redacted
+}
+
+// redactProcess returns a copy of process which environment is redacted.
+func redactProcess(process *pb.Process) *pb.Process {
+	if process == nil {
+		return nil
+	}
+
+	redacted := *process
+	redacted.Env = redactEnv(process.Env)
+
+	return &redacted
+}
+
+// redactSpec returns a copy of spec fit for logging, where the values of the
+// sensitive environment variables and of the redactAnnotations are masked.
+// spec is left untouched, the copy sharing the fields which need no masking.
+func redactSpec(spec *pb.Spec) *pb.Spec {
+	if spec == nil {
+		return nil
+	}
+
+	redacted := *spec
+	redacted.Process = redactProcess(spec.Process)
+
+	if spec.Hooks != nil {
+		redacted.Hooks = &pb.Hooks{
+			Prestart:  redactHooks(spec.Hooks.Prestart),
+			Poststart: redactHooks(spec.Hooks.Poststart),
+			Poststop:  redactHooks(spec.Hooks.Poststop),
+		}
+	}
+
+	if len(redactAnnotations) > 0 && len(spec.Annotations) > 0 {
+		redacted.Annotations = make(map[string]string, len(spec.Annotations))
+		for key, value := range spec.Annotations {
+			redacted.Annotations[key] = value
+		}
+
+		for _, key := range redactAnnotations {
+			if _, ok := redacted.Annotations[key]; ok {
+				redacted.Annotations[key] = redactedValue
+			}
+		}
+	}
+
+	return &redacted
+}
+
+// redactRequest returns the gRPC request req fit for logging, the spec or
+// the process it holds being redacted.
+func redactRequest(req proto.Message) proto.Message {
+	switch r := req.(type) {
+	case *pb.CreateContainerRequest:
+		redacted := *r
+		redacted.OCI = redactSpec(r.OCI)
+		return &redacted
+	case *pb.ExecProcessRequest:
+		redacted := *r
+		redacted.Process = redactProcess(r.Process)
+		return &redacted
+	}
+
+	return req
+}
@@ -0,0 +1,177 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	gpb "github.com/gogo/protobuf/types"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestRedactEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		env         []string
+		expectedEnv []string
+	}
+
+	data := []testData{
+		{nil, nil},
+		{[]string{"PATH=/bin", "HOME=/root"}, []string{"PATH=/bin", "HOME=/root"}},
+		{[]string{"DB_PASSWORD=hunter2", "PATH=/bin"}, []string{"DB_PASSWORD=<redacted>", "PATH=/bin"}},
+		{[]string{"github_token=abc", "AWS_SECRET_ACCESS_KEY=def", "API_KEY=ghi", "MYSQL_PASSWD=jkl"},
+			[]string{"github_token=<redacted>", "AWS_SECRET_ACCESS_KEY=<redacted>", "API_KEY=<redacted>", "MYSQL_PASSWD=<redacted>"}},
+		// The value itself does not matter.
+		{[]string{"VALUE=password", "TOKEN="}, []string{"VALUE=password", "TOKEN=<redacted>"}},
+		{[]string{"NOVALUE_TOKEN"}, []string{"NOVALUE_TOKEN"}},
+	}
+
+	for i, d := range data {
+		env := append([]string(nil), d.env...)
+		assert.Equal(d.expectedEnv, redactEnv(env), "test %d (%+v)", i, d)
+		assert.Equal(d.env, env, "test %d (%+v)", i, d)
+	}
+
+	savedRedactEnvPattern := redactEnvPattern
+	defer func() {
+		redactEnvPattern = savedRedactEnvPattern
+	}()
+
+	redactEnvPattern = regexp.MustCompile(`^APP_`)
+	assert.Equal([]string{"APP_KEY=<redacted>", "DB_PASSWORD=hunter2"}, redactEnv([]string{"APP_KEY=1", "DB_PASSWORD=hunter2"}))
+
+	redactEnvPattern = nil
+	assert.Equal([]string{"DB_PASSWORD=hunter2"}, redactEnv([]string{"DB_PASSWORD=hunter2"}))
+}
+
+func testRedactSpec() *pb.Spec {
+	return &pb.Spec{
+		Version:  "1.0.1",
+		Hostname: "ctr",
+		Process: &pb.Process{
+			Args: []string{"/bin/app", "--verbose"},
+			Env:  []string{"PATH=/bin", "DB_PASSWORD=hunter2"},
+			Cwd:  "/srv",
+		},
+		Root: &pb.Root{Path: "/rootfs"},
+		Hooks: &pb.Hooks{
+			Prestart: []pb.Hook{
+				{Path: "/bin/hook", Env: []string{"HOOK_TOKEN=s3cr3t", "LANG=C"}},
+			},
+		},
+		Annotations: map[string]string{
+			"io.kubernetes.cri.sandbox-id": "sandbox",
+			"com.example.credentials":      "user:pass",
+		},
+	}
+}
+
+func TestRedactSpec(t *testing.T) {
+	assert := assert.New(t)
+
+	savedRedactAnnotations := redactAnnotations
+	redactAnnotations = []string{"com.example.credentials", "com.example.missing"}
+	defer func() {
+		redactAnnotations = savedRedactAnnotations
+	}()
+
+	assert.Nil(redactSpec(nil))
+	assert.Equal(&pb.Spec{Version: "1.0.1"}, redactSpec(&pb.Spec{Version: "1.0.1"}))
+
+	spec := testRedactSpec()
+	redacted := redactSpec(spec)
+
+	// The spec itself is left untouched.
+	assert.Equal(testRedactSpec(), spec)
+
+	expected := testRedactSpec()
+	expected.Process.Env[1] = "DB_PASSWORD=<redacted>"
+	expected.Hooks.Prestart[0].Env[0] = "HOOK_TOKEN=<redacted>"
+	expected.Annotations["com.example.credentials"] = "<redacted>"
+	assert.Equal(expected, redacted)
+
+	logged := redacted.String()
+	for _, secret := range []string{"hunter2", "s3cr3t", "user:pass"} {
+		assert.False(strings.Contains(logged, secret), logged)
+	}
+	for _, value := range []string{"/bin/app", "--verbose", "PATH=/bin", "LANG=C", "sandbox", "/rootfs"} {
+		assert.True(strings.Contains(logged, value), logged)
+	}
+}
+
+func TestRedactRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	createReq := &pb.CreateContainerRequest{ContainerId: "ctr", OCI: testRedactSpec()}
+	logged := redactRequest(createReq).String()
+	assert.False(strings.Contains(logged, "hunter2"), logged)
+	assert.True(strings.Contains(logged, "DB_PASSWORD=<redacted>"), logged)
+	assert.True(strings.Contains(logged, `container_id:"ctr"`), logged)
+	assert.Equal("DB_PASSWORD=hunter2", createReq.OCI.Process.Env[1])
+
+	execReq := &pb.ExecProcessRequest{ContainerId: "ctr", ExecId: "exec", Process: testRedactSpec().Process}
+	logged = redactRequest(execReq).String()
+	assert.False(strings.Contains(logged, "hunter2"), logged)
+	assert.True(strings.Contains(logged, `exec_id:"exec"`), logged)
+	assert.Equal("DB_PASSWORD=hunter2", execReq.Process.Env[1])
+
+	assert.Nil(redactRequest(&pb.CreateContainerRequest{}).(*pb.CreateContainerRequest).OCI)
+	assert.Nil(redactRequest(&pb.ExecProcessRequest{}).(*pb.ExecProcessRequest).Process)
+
+	otherReq := &pb.SignalProcessRequest{ContainerId: "ctr", Signal: 9}
+	assert.True(otherReq == redactRequest(otherReq))
+}
+
+func TestUnaryInterceptorRedactsSpec(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.Formatter = &logrus.TextFormatter{DisableColors: true}
+	logger.Out = buf
+	logger.Level = logrus.DebugLevel
+
+	// The requests are not logged when tracing.
+	savedLog := agentLog
+	savedTracing := tracing
+	agentLog = logger.WithField("test-agent-logger", true)
+	tracing = false
+	defer func() {
+		agentLog = savedLog
+		tracing = savedTracing
+	}()
+
+	req := &pb.CreateContainerRequest{ContainerId: "ctr", OCI: testRedactSpec()}
+
+	var handled interface{}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = req
+		return &gpb.Empty{}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/grpc.AgentService/CreateContainer"}
+	_, err := makeUnaryInterceptor()(context.Background(), req, info, handler)
+	assert.NoError(err)
+
+	// The handler gets the request as is.
+	assert.True(handled == req)
+
+	logged := buf.String()
+	assert.True(strings.Contains(logged, "new request"), logged)
+	assert.True(strings.Contains(logged, "DB_PASSWORD=<redacted>"), logged)
+	assert.True(strings.Contains(logged, "PATH=/bin"), logged)
+	assert.False(strings.Contains(logged, "hunter2"), logged)
+}
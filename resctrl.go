@@ -0,0 +1,144 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	resctrlSchemataFile = "schemata"
+	resctrlTasksFile    = "tasks"
+	resctrlFileMode     = 0644
+	resctrlDirMode      = 0755
+)
+
+// Path overridden in unit tests
+var resctrlPath = "/sys/fs/resctrl"
+
+// isResctrlAvailable returns whether the resctrl filesystem is mounted,
+// meaning the CPU and the kernel support Intel RDT.
+func isResctrlAvailable() bool {
+	_, err := os.Stat(filepath.Join(resctrlPath, resctrlSchemataFile))
+	return err == nil
+}
+
+// getIntelRdtSchemata builds the content of the schemata file of a resctrl
+// group, with one line per resource.
+func getIntelRdtSchemata(rdt *pb.LinuxIntelRdt) string {
+	var schemata []string
+
+	for _, schema := range []string{rdt.L3CacheSchema, rdt.MemBwSchema} {
+		if schema != "" {
+			schemata = append(schemata, schema)
+		}
+	}
+
+	if len(schemata) == 0 {
+		return ""
+	}
+
+	return strings.Join(schemata, "\n") + "\n"
+}
+
+// setupIntelRdt places the container init process pid into a resctrl group
+// applying the cache and memory bandwidth allocations of the spec. The group
+// is named after the class of service if provided, so that it can be shared
+// between containers, otherwise after the container. The path of the group
+// is returned, along with whether it has been created for this container
+// only, or "" when no group is used.
+//
+// libcontainer is not relied upon since its factory would need to be created
+// with Intel RDT support, failing the container creation when resctrl is not
+// available.
+func setupIntelRdt(containerID string, pid int, rdt *pb.LinuxIntelRdt) (string, bool, error) {
+	if rdt == nil {
+		return "", false, nil
+	}
+
+	if !isResctrlAvailable() {
+		agentLog.WithField("container", containerID).Warn("Intel RDT not supported, ignoring cache and memory bandwidth allocation")
+		return "", false, nil
+	}
+
+	groupName := rdt.ClosID
+	if groupName == "" {
+		groupName = containerID
+	}
+
+	groupPath := filepath.Join(resctrlPath, groupName)
+	if filepath.Dir(groupPath) != filepath.Clean(resctrlPath) {
+		return "", false, grpcStatus.Errorf(codes.InvalidArgument, "Invalid Intel RDT group name %q", groupName)
+	}
+
+	if err := os.MkdirAll(groupPath, resctrlDirMode); err != nil {
+		return "", false, grpcStatus.Errorf(codes.Internal, "Could not create Intel RDT group %q: %v", groupPath, err)
+	}
+
+	ownedGroupPath := ""
+	if rdt.ClosID == "" {
+		ownedGroupPath = groupPath
+	}
+
+	if schemata := getIntelRdtSchemata(rdt); schemata != "" {
+		if err := ioutil.WriteFile(filepath.Join(groupPath, resctrlSchemataFile), []byte(schemata), resctrlFileMode); err != nil {
+			removeIntelRdtGroup(ownedGroupPath)
+			return "", false, grpcStatus.Errorf(codes.Internal, "Could not write Intel RDT schemata %q: %v", schemata, err)
+		}
+	}
+
+	// Processes forked later by the container inherit the group.
+	if err := addIntelRdtTask(groupPath, pid); err != nil {
+		removeIntelRdtGroup(ownedGroupPath)
+		return "", false, err
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"container": containerID,
+		"group":     groupPath,
+	}).Debug("applied Intel RDT allocation")
+
+	return groupPath, ownedGroupPath != "", nil
+}
+
+// addIntelRdtTask places the process pid into the resctrl group groupPath,
+// like the processes executed in a container after its init process, which
+// are not forked by it. Nothing is done when groupPath is "".
+func addIntelRdtTask(groupPath string, pid int) error {
+	if groupPath == "" {
+		return nil
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(groupPath, resctrlTasksFile), []byte(strconv.Itoa(pid)), resctrlFileMode); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not add process %d to Intel RDT group: %v", pid, err)
+	}
+
+	return nil
+}
+
+// removeIntelRdtGroup removes a resctrl group, the tasks being returned to
+// the default group by the kernel.
+func removeIntelRdtGroup(groupPath string) error {
+	if groupPath == "" {
+		return nil
+	}
+
+	if err := os.Remove(groupPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
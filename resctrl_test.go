@@ -0,0 +1,182 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIntelRdtSchemata(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		rdt              *pb.LinuxIntelRdt
+		expectedSchemata string
+	}
+
+	data := []testData{
+		{&pb.LinuxIntelRdt{}, ""},
+		{&pb.LinuxIntelRdt{L3CacheSchema: "L3:0=ffff0"}, "L3:0=ffff0\n"},
+		{&pb.LinuxIntelRdt{MemBwSchema: "MB:0=20;1=70"}, "MB:0=20;1=70\n"},
+		{&pb.LinuxIntelRdt{L3CacheSchema: "L3:0=ffff0;1=fff00", MemBwSchema: "MB:0=20;1=70"}, "L3:0=ffff0;1=fff00\nMB:0=20;1=70\n"},
+	}
+
+	for i, d := range data {
+		assert.Equal(d.expectedSchemata, getIntelRdtSchemata(d.rdt), "test %d", i)
+	}
+}
+
+func TestSetupIntelRdt(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "resctrl")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedResctrlPath := resctrlPath
+	defer func() {
+		resctrlPath = savedResctrlPath
+	}()
+
+	resctrlPath = tmpDir
+
+	rdt := &pb.LinuxIntelRdt{
+		L3CacheSchema: "L3:0=ffff0",
+		MemBwSchema:   "MB:0=50",
+	}
+
+	// No Intel RDT requested
+	group, owned, err := setupIntelRdt("foo", 10, nil)
+	assert.NoError(err)
+	assert.Empty(group)
+	assert.False(owned)
+
+	// resctrl not available
+	group, owned, err = setupIntelRdt("foo", 10, rdt)
+	assert.NoError(err)
+	assert.Empty(group)
+	assert.False(owned)
+	_, err = os.Stat(filepath.Join(tmpDir, "foo"))
+	assert.True(os.IsNotExist(err))
+
+	err = createEmptyFile(filepath.Join(tmpDir, resctrlSchemataFile))
+	assert.NoError(err)
+
+	group, owned, err = setupIntelRdt("foo", 10, rdt)
+	assert.NoError(err)
+	assert.Equal(filepath.Join(tmpDir, "foo"), group)
+	assert.True(owned)
+
+	content, err := ioutil.ReadFile(filepath.Join(group, resctrlSchemataFile))
+	assert.NoError(err)
+	assert.Equal("L3:0=ffff0\nMB:0=50\n", string(content))
+
+	content, err = ioutil.ReadFile(filepath.Join(group, resctrlTasksFile))
+	assert.NoError(err)
+	assert.Equal("10", string(content))
+
+	// A group named after the class of service is not owned by the container
+	group, owned, err = setupIntelRdt("bar", 20, &pb.LinuxIntelRdt{ClosID: "shared", L3CacheSchema: "L3:0=f"})
+	assert.NoError(err)
+	assert.Equal(filepath.Join(tmpDir, "shared"), group)
+	assert.False(owned)
+
+	content, err = ioutil.ReadFile(filepath.Join(tmpDir, "shared", resctrlTasksFile))
+	assert.NoError(err)
+	assert.Equal("20", string(content))
+
+	_, _, err = setupIntelRdt("bar", 20, &pb.LinuxIntelRdt{ClosID: "../escape"})
+	assert.Error(err)
+
+	// The fake resctrl files are regular files, hence the group directory
+	// can only be removed once emptied.
+	assert.Error(removeIntelRdtGroup(filepath.Join(tmpDir, "foo")))
+	assert.NoError(os.Remove(filepath.Join(tmpDir, "foo", resctrlSchemataFile)))
+	assert.NoError(os.Remove(filepath.Join(tmpDir, "foo", resctrlTasksFile)))
+	assert.NoError(removeIntelRdtGroup(filepath.Join(tmpDir, "foo")))
+	assert.NoError(removeIntelRdtGroup(filepath.Join(tmpDir, "foo")))
+	assert.NoError(removeIntelRdtGroup(""))
+}
+
+func TestExecProcessIntelRdt(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "resctrl")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	tasksPath := filepath.Join(tmpDir, resctrlTasksFile)
+	err = createEmptyFile(tasksPath)
+	assert.NoError(err)
+
+	cid := "test-exec-intel-rdt"
+
+	c, cleanup := createTestContainer(t, cid)
+	defer cleanup()
+
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"sleep", "100"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	ctr := &container{
+		id:           cid,
+		container:    c,
+		initProcess:  initProc,
+		processes:    make(map[string]*process),
+		intelRdtPath: tmpDir,
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{cid: ctr},
+			running:    true,
+			subreaper:  &mockreaper{},
+		},
+	}
+
+	// The executed processes are placed into the group of the container,
+	// not being forked by its init process.
+	_, err = a.ExecProcess(context.Background(), &pb.ExecProcessRequest{
+		ContainerId: cid,
+		ExecId:      "exec",
+		Process: &pb.Process{
+			Args: []string{"sleep", "100"},
+			Env:  []string{"PATH=/bin"},
+			Cwd:  "/",
+		},
+	})
+	assert.NoError(err)
+
+	proc, err := ctr.getProcess("exec")
+	assert.NoError(err)
+	defer proc.closePostExitFDs()
+
+	pid, err := proc.pid()
+	assert.NoError(err)
+
+	content, err := ioutil.ReadFile(tasksPath)
+	assert.NoError(err)
+	assert.Equal(strconv.Itoa(pid), string(content))
+}
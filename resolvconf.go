@@ -0,0 +1,105 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// How the sandbox DNS configuration is provided to the containers.
+const (
+	// The containers bind mount the sandbox resolv.conf, and see its
+	// updates.
+	resolvConfModeShared = "shared"
+	// Each container gets its own copy of the sandbox resolv.conf, which
+	// is not updated anymore.
+	resolvConfModeCopy = "copy"
+)
+
+const containerResolvConf = "/etc/resolv.conf"
+
+// Directory holding the resolv.conf copy of each container.
+var containerDNSDir = "/run/kata-containers/dns"
+
+// containerDNSFile returns the resolv.conf copy of the container cid.
+func containerDNSFile(cid string) string {
+	return filepath.Join(containerDNSDir, cid, "resolv.conf")
+}
+
+// setupContainerResolvConf mounts the sandbox DNS configuration on the
+// resolv.conf of the container cid created from spec, according to
+// resolvConfMode, instead of any resolv.conf mount of the spec. Nothing is
+// done when no mode is configured or the sandbox has no DNS configuration.
+func setupContainerResolvConf(spec *specs.Spec, cid string) error {
+	if resolvConfMode == "" {
+		return nil
+	}
+
+	if _, err := os.Stat(kataGuestSandboxDNSFile); os.IsNotExist(err) {
+		return nil
+	}
+
+	m := specs.Mount{
+		Destination: containerResolvConf,
+		Type:        "bind",
+		Source:      kataGuestSandboxDNSFile,
+		Options:     []string{"rbind", "ro"},
+	}
+
+	if resolvConfMode == resolvConfModeCopy {
+		content, err := ioutil.ReadFile(kataGuestSandboxDNSFile)
+		if err != nil {
+			return err
+		}
+
+		m.Source = containerDNSFile(cid)
+		m.Options = []string{"rbind", "rw"}
+
+		if err := os.MkdirAll(filepath.Dir(m.Source), 0700); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(m.Source, content, 0644); err != nil {
+			return err
+		}
+	}
+
+	var mounts []specs.Mount
+	for _, mnt := range spec.Mounts {
+		if filepath.Clean(mnt.Destination) != containerResolvConf {
+			mounts = append(mounts, mnt)
+		}
+	}
+	spec.Mounts = append(mounts, m)
+
+	return nil
+}
+
+// removeContainerResolvConf removes the resolv.conf copy of the container
+// cid, if any.
+func removeContainerResolvConf(cid string) error {
+	return os.RemoveAll(filepath.Dir(containerDNSFile(cid)))
+}
+
+// updateDNS replaces the sandbox DNS configuration with dns. The containers
+// sharing it see the change, while the copies are left untouched.
+func (s *sandbox) updateDNS(dns []string) error {
+	if _, err := os.Stat(kataGuestSandboxDNSFile); os.IsNotExist(err) {
+		if err := setupDNS(dns); err != nil {
+			return err
+		}
+	} else if err := writeDNSFile(kataGuestSandboxDNSFile, dns); err != nil {
+		return err
+	}
+
+	s.network.dns = dns
+
+	return nil
+}
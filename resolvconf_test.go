@@ -0,0 +1,185 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// setupResolvConfTest points the DNS files to a temporary directory,
+// returned along with a function restoring them.
+func setupResolvConfTest(t *testing.T, mode string) (string, func()) {
+	tmpDir, err := ioutil.TempDir("", "resolv-conf")
+	assert.NoError(t, err)
+
+	savedMode, savedGuestDNSFile, savedSandboxDNSFile, savedDNSDir := resolvConfMode, guestDNSFile, kataGuestSandboxDNSFile, containerDNSDir
+
+	resolvConfMode = mode
+	guestDNSFile = filepath.Join(tmpDir, "etc", "resolv.conf")
+	kataGuestSandboxDNSFile = filepath.Join(tmpDir, "sandbox", "resolv.conf")
+	containerDNSDir = filepath.Join(tmpDir, "dns")
+
+	return tmpDir, func() {
+		resolvConfMode, guestDNSFile, kataGuestSandboxDNSFile, containerDNSDir = savedMode, savedGuestDNSFile, savedSandboxDNSFile, savedDNSDir
+		os.RemoveAll(tmpDir)
+	}
+}
+
+func TestSetupContainerResolvConf(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		mode            string
+		sandboxDNS      bool
+		expectedSource  string
+		expectedOptions []string
+	}
+
+	specMount := specs.Mount{Destination: "/etc//resolv.conf", Source: "/host/resolv.conf", Type: "bind"}
+	otherMount := specs.Mount{Destination: "/etc/hosts", Source: "/host/hosts", Type: "bind"}
+
+	data := []testData{
+		{"", true, specMount.Source, nil},
+		{resolvConfModeShared, false, specMount.Source, nil},
+		{resolvConfModeCopy, false, specMount.Source, nil},
+		{resolvConfModeShared, true, "sandbox", []string{"rbind", "ro"}},
+		{resolvConfModeCopy, true, "copy", []string{"rbind", "rw"}},
+	}
+
+	for i, d := range data {
+		_, cleanup := setupResolvConfTest(t, d.mode)
+
+		if d.sandboxDNS {
+			err := os.MkdirAll(filepath.Dir(kataGuestSandboxDNSFile), 0700)
+			assert.NoError(err)
+			err = writeDNSFile(kataGuestSandboxDNSFile, []string{"nameserver 8.8.8.8"})
+			assert.NoError(err)
+		}
+
+		spec := &specs.Spec{Mounts: []specs.Mount{specMount, otherMount}}
+		err := setupContainerResolvConf(spec, "ctr")
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		expectedSource := d.expectedSource
+		switch expectedSource {
+		case "sandbox":
+			expectedSource = kataGuestSandboxDNSFile
+		case "copy":
+			expectedSource = containerDNSFile("ctr")
+		}
+
+		if expectedSource == specMount.Source {
+			assert.Equal([]specs.Mount{specMount, otherMount}, spec.Mounts, "test %d (%+v)", i, d)
+		} else {
+			// The mount of the spec is replaced.
+			assert.Equal([]specs.Mount{otherMount, {
+				Destination: containerResolvConf,
+				Type:        "bind",
+				Source:      expectedSource,
+				Options:     d.expectedOptions,
+			}}, spec.Mounts, "test %d (%+v)", i, d)
+
+			content, err := ioutil.ReadFile(expectedSource)
+			assert.NoError(err)
+			assert.Equal("nameserver 8.8.8.8", string(content), "test %d (%+v)", i, d)
+		}
+
+		assert.NoError(removeContainerResolvConf("ctr"))
+		_, err = os.Stat(containerDNSFile("ctr"))
+		assert.True(os.IsNotExist(err), "test %d (%+v)", i, d)
+
+		cleanup()
+	}
+}
+
+func TestUpdateResolvConf(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, cleanup := setupResolvConfTest(t, resolvConfModeShared)
+	defer cleanup()
+
+	a := &agentGRPC{sandbox: &sandbox{}}
+
+	_, err := a.UpdateResolvConf(context.Background(), &pb.UpdateResolvConfRequest{})
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	a.sandbox.running = true
+
+	err = os.MkdirAll(filepath.Dir(guestDNSFile), 0755)
+	assert.NoError(err)
+	err = ioutil.WriteFile(guestDNSFile, nil, 0644)
+	assert.NoError(err)
+
+	// The sandbox DNS is set up by the first update, if not done yet.
+	initialDNS := []string{"nameserver 8.8.8.8"}
+	_, err = a.UpdateResolvConf(context.Background(), &pb.UpdateResolvConfRequest{Dns: initialDNS})
+	assert.NoError(err)
+	defer syscall.Unmount(guestDNSFile, 0)
+	assert.Equal(initialDNS, a.sandbox.network.dns)
+
+	// A container sharing the sandbox resolv.conf, and one with its copy.
+	shared := &specs.Spec{}
+	err = setupContainerResolvConf(shared, "shared")
+	assert.NoError(err)
+
+	resolvConfMode = resolvConfModeCopy
+	copied := &specs.Spec{}
+	err = setupContainerResolvConf(copied, "copied")
+	assert.NoError(err)
+
+	var targets []string
+	for _, spec := range []*specs.Spec{shared, copied} {
+		target := filepath.Join(tmpDir, "container", filepath.Base(filepath.Dir(spec.Mounts[0].Source))+"-resolv.conf")
+		err = os.MkdirAll(filepath.Dir(target), 0755)
+		assert.NoError(err)
+		err = ioutil.WriteFile(target, nil, 0644)
+		assert.NoError(err)
+
+		err = syscall.Mount(spec.Mounts[0].Source, target, "bind", syscall.MS_BIND, "")
+		assert.NoError(err)
+		defer syscall.Unmount(target, 0)
+
+		targets = append(targets, target)
+	}
+
+	updatedDNS := []string{"nameserver 1.1.1.1", "search example.com"}
+	_, err = a.UpdateResolvConf(context.Background(), &pb.UpdateResolvConfRequest{Dns: updatedDNS})
+	assert.NoError(err)
+	assert.Equal(updatedDNS, a.sandbox.network.dns)
+
+	type testData struct {
+		path            string
+		expectedContent string
+	}
+
+	data := []testData{
+		{kataGuestSandboxDNSFile, "nameserver 1.1.1.1\nsearch example.com"},
+		{guestDNSFile, "nameserver 1.1.1.1\nsearch example.com"},
+		// The update is seen by the shared mount only.
+		{targets[0], "nameserver 1.1.1.1\nsearch example.com"},
+		{targets[1], "nameserver 8.8.8.8"},
+	}
+
+	for i, d := range data {
+		content, err := ioutil.ReadFile(d.path)
+		assert.NoError(err)
+		assert.Equal(d.expectedContent, string(content), "test %d (%+v)", i, d)
+	}
+}
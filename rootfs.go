@@ -0,0 +1,171 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	rootfsTypeBind    = "bind"
+	rootfsTypeBlock   = "block"
+	rootfsTypeOverlay = "overlay"
+
+	// Directory holding the upper and work directories of the overlay
+	// rootfs of the containers, next to their first source directory.
+	rootfsOverlayDir = "rootfs-overlay"
+)
+
+// Storage drivers providing a block device.
+var blockStorageDrivers = []string{
+	driverBlkType,
+	driverBlkCCWType,
+	driverMmioBlkType,
+	driverSCSIType,
+	driverNvdimmType,
+}
+
+// rootfsOverlayPath returns the directory holding the changes made to the
+// overlay rootfs of the request, next to the storage it overlays rather than
+// in the tmpfs of the container state, or "" for another rootfs type.
+func rootfsOverlayPath(req *pb.CreateContainerRequest) string {
+	if req.Rootfs == nil || req.Rootfs.Type != rootfsTypeOverlay {
+		return ""
+	}
+
+	top := strings.Split(req.Rootfs.Source, ":")[0]
+
+	return filepath.Join(filepath.Dir(filepath.Clean(top)), rootfsOverlayDir, req.ContainerId)
+}
+
+// checkRootfsMount checks that the request provides what the rootfs type it
+// selects needs.
+func checkRootfsMount(req *pb.CreateContainerRequest) error {
+	rootfs := req.Rootfs
+	if rootfs == nil {
+		return nil
+	}
+
+	if req.OCI == nil || req.OCI.Root == nil || !filepath.IsAbs(req.OCI.Root.Path) {
+		return grpcStatus.Errorf(codes.InvalidArgument, "%s rootfs of container %s needs an absolute root path", rootfs.Type, req.ContainerId)
+	}
+	root := filepath.Clean(req.OCI.Root.Path)
+
+	switch rootfs.Type {
+	case rootfsTypeBind:
+		if !filepath.IsAbs(rootfs.Source) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "bind rootfs of container %s needs an absolute source directory, got %q", req.ContainerId, rootfs.Source)
+		}
+	case rootfsTypeBlock:
+		for _, storage := range req.Storages {
+			if storage == nil || filepath.Clean(storage.MountPoint) != root {
+				continue
+			}
+
+			for _, driver := range blockStorageDrivers {
+				if storage.Driver == driver {
+					return nil
+				}
+			}
+
+			return grpcStatus.Errorf(codes.InvalidArgument, "block rootfs of container %s mounted by storage with non block driver %q", req.ContainerId, storage.Driver)
+		}
+
+		return grpcStatus.Errorf(codes.InvalidArgument, "block rootfs of container %s not mounted by any storage", req.ContainerId)
+	case rootfsTypeOverlay:
+		if rootfs.Source == "" {
+			return grpcStatus.Errorf(codes.InvalidArgument, "overlay rootfs of container %s needs source directories", req.ContainerId)
+		}
+
+		for _, lower := range strings.Split(rootfs.Source, ":") {
+			if !filepath.IsAbs(lower) {
+				return grpcStatus.Errorf(codes.InvalidArgument, "overlay rootfs of container %s needs absolute source directories, got %q", req.ContainerId, lower)
+			}
+		}
+	default:
+		return grpcStatus.Errorf(codes.InvalidArgument, "Unknown rootfs type %q for container %s", rootfs.Type, req.ContainerId)
+	}
+
+	return nil
+}
+
+// checkRootfsSource checks that the source directories of a rootfs exist,
+// once the storages of the container have been mounted.
+func checkRootfsSource(id string, sources []string) error {
+	for _, source := range sources {
+		info, err := os.Stat(source)
+		if os.IsNotExist(err) {
+			return grpcStatus.Errorf(codes.NotFound, "Rootfs source %s of container %s not found", source, id)
+		} else if err != nil {
+			return grpcStatus.Errorf(codes.Internal, "Could not check rootfs source %s of container %s: %v", source, id, err)
+		}
+
+		if !info.IsDir() {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Rootfs source %s of container %s is not a directory", source, id)
+		}
+	}
+
+	return nil
+}
+
+// setupRootfsMount sets up the rootfs of the request as its type selects,
+// once the storages have been mounted, returning the mounts it added.
+func setupRootfsMount(req *pb.CreateContainerRequest) ([]string, error) {
+	rootfs := req.Rootfs
+	if rootfs == nil || rootfs.Type == rootfsTypeBlock {
+		return nil, nil
+	}
+
+	root := filepath.Clean(req.OCI.Root.Path)
+
+	switch rootfs.Type {
+	case rootfsTypeBind:
+		if err := checkRootfsSource(req.ContainerId, []string{rootfs.Source}); err != nil {
+			return nil, err
+		}
+
+		if err := mount(rootfs.Source, root, "bind", syscall.MS_BIND|syscall.MS_REC, ""); err != nil {
+			return nil, err
+		}
+	case rootfsTypeOverlay:
+		lowers := strings.Split(rootfs.Source, ":")
+		if err := checkRootfsSource(req.ContainerId, lowers); err != nil {
+			return nil, err
+		}
+
+		overlayPath := rootfsOverlayPath(req)
+		upper := filepath.Join(overlayPath, "upper")
+		work := filepath.Join(overlayPath, "work")
+
+		for _, dir := range []string{upper, work, root} {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return nil, grpcStatus.Errorf(codes.Internal, "Could not create overlay rootfs of container %s: %v", req.ContainerId, err)
+			}
+		}
+
+		options := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s", rootfs.Source, upper, work)
+		if err := syscall.Mount("overlay", root, "overlay", 0, options); err != nil {
+			return nil, grpcStatus.Errorf(codes.Internal, "Could not mount overlay rootfs of container %s: %v", req.ContainerId, err)
+		}
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"container": req.ContainerId,
+		"type":      rootfs.Type,
+	}).Info("Rootfs set up")
+
+	return []string{root}, nil
+}
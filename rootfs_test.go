@@ -0,0 +1,183 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const testRootfsPath = "/run/kata-containers/ctr/rootfs"
+
+func TestCheckRootfsMount(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		root         string
+		rootfs       *pb.RootfsMount
+		storages     []*pb.Storage
+		expectedCode codes.Code
+	}
+
+	blkStorage := &pb.Storage{Driver: driverBlkType, MountPoint: testRootfsPath + "/"}
+
+	data := []testData{
+		{"", nil, nil, codes.OK},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeBind, Source: "/run/kata-containers/shared/ctr"}, nil, codes.OK},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeBlock}, []*pb.Storage{nil, {Driver: driverLocalType, MountPoint: "/foo"}, blkStorage}, codes.OK},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeBlock}, []*pb.Storage{{Driver: driverSCSIType, MountPoint: testRootfsPath}}, codes.OK},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeOverlay, Source: "/lower1:/lower2"}, nil, codes.OK},
+
+		{"", &pb.RootfsMount{Type: rootfsTypeBind, Source: "/foo"}, nil, codes.InvalidArgument},
+		{"rootfs", &pb.RootfsMount{Type: rootfsTypeBind, Source: "/foo"}, nil, codes.InvalidArgument},
+		{testRootfsPath, &pb.RootfsMount{Type: "nfs", Source: "/foo"}, nil, codes.InvalidArgument},
+		{testRootfsPath, &pb.RootfsMount{}, nil, codes.InvalidArgument},
+		// The source is missing.
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeBind}, nil, codes.InvalidArgument},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeBind, Source: "shared/ctr"}, nil, codes.InvalidArgument},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeBlock}, nil, codes.InvalidArgument},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeBlock}, []*pb.Storage{{Driver: driverBlkType, MountPoint: "/foo"}}, codes.InvalidArgument},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeBlock}, []*pb.Storage{{Driver: driver9pType, MountPoint: testRootfsPath}}, codes.InvalidArgument},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeOverlay}, nil, codes.InvalidArgument},
+		{testRootfsPath, &pb.RootfsMount{Type: rootfsTypeOverlay, Source: "/lower1:lower2"}, nil, codes.InvalidArgument},
+	}
+
+	for i, d := range data {
+		req := &pb.CreateContainerRequest{
+			ContainerId: "ctr",
+			OCI:         &pb.Spec{Root: &pb.Root{Path: d.root}},
+			Storages:    d.storages,
+			Rootfs:      d.rootfs,
+		}
+
+		err := checkRootfsMount(req)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+	}
+
+	err := checkRootfsMount(&pb.CreateContainerRequest{Rootfs: &pb.RootfsMount{Type: rootfsTypeBind, Source: "/foo"}})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
+
+func TestRootfsOverlayPath(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		rootfs       *pb.RootfsMount
+		expectedPath string
+	}
+
+	data := []testData{
+		{nil, ""},
+		{&pb.RootfsMount{Type: rootfsTypeBind, Source: "/run/kata-containers/shared/ctr"}, ""},
+		{&pb.RootfsMount{Type: rootfsTypeOverlay, Source: "/run/kata-containers/ctr/lower1:/lower2"}, "/run/kata-containers/ctr/rootfs-overlay/ctr"},
+		{&pb.RootfsMount{Type: rootfsTypeOverlay, Source: "/lower1/"}, "/rootfs-overlay/ctr"},
+	}
+
+	for i, d := range data {
+		path := rootfsOverlayPath(&pb.CreateContainerRequest{ContainerId: "ctr", Rootfs: d.rootfs})
+		assert.Equal(d.expectedPath, path, "test %d (%+v)", i, d)
+	}
+}
+
+func TestSetupRootfsMount(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "rootfs")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedOCIConfigBasePath := ociConfigBasePath
+	ociConfigBasePath = filepath.Join(tmpDir, "libcontainer")
+	defer func() {
+		ociConfigBasePath = savedOCIConfigBasePath
+	}()
+
+	root := filepath.Join(tmpDir, "ctr", "rootfs")
+
+	// Each source holds a file named after it.
+	var sources []string
+	for _, name := range []string{"shared", "lower1", "lower2"} {
+		source := filepath.Join(tmpDir, name)
+		err := os.Mkdir(source, 0755)
+		assert.NoError(err)
+		err = ioutil.WriteFile(filepath.Join(source, name), []byte(name), 0644)
+		assert.NoError(err)
+		sources = append(sources, source)
+	}
+
+	type testData struct {
+		rootfs       *pb.RootfsMount
+		expectedCode codes.Code
+		// files seen in the rootfs
+		expectedFiles []string
+	}
+
+	data := []testData{
+		{nil, codes.OK, nil},
+		{&pb.RootfsMount{Type: rootfsTypeBlock}, codes.OK, nil},
+		{&pb.RootfsMount{Type: rootfsTypeBind, Source: sources[0]}, codes.OK, []string{"shared"}},
+		{&pb.RootfsMount{Type: rootfsTypeOverlay, Source: sources[1] + ":" + sources[2]}, codes.OK, []string{"lower1", "lower2"}},
+		// The source is missing.
+		{&pb.RootfsMount{Type: rootfsTypeBind, Source: filepath.Join(tmpDir, "missing")}, codes.NotFound, nil},
+		{&pb.RootfsMount{Type: rootfsTypeBind, Source: filepath.Join(sources[0], "shared")}, codes.InvalidArgument, nil},
+		{&pb.RootfsMount{Type: rootfsTypeOverlay, Source: sources[1] + ":" + filepath.Join(tmpDir, "missing")}, codes.NotFound, nil},
+	}
+
+	for i, d := range data {
+		req := &pb.CreateContainerRequest{
+			ContainerId: "ctr",
+			OCI:         &pb.Spec{Root: &pb.Root{Path: root}},
+			Rootfs:      d.rootfs,
+		}
+
+		mounts, err := setupRootfsMount(req)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		if len(d.expectedFiles) == 0 {
+			assert.Empty(mounts, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.Equal([]string{root}, mounts, "test %d (%+v)", i, d)
+
+		for _, name := range d.expectedFiles {
+			content, err := ioutil.ReadFile(filepath.Join(root, name))
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(name, string(content), "test %d (%+v)", i, d)
+		}
+
+		// The changes to an overlay are kept out of its sources.
+		if d.rootfs.Type == rootfsTypeOverlay {
+			err = ioutil.WriteFile(filepath.Join(root, "lower1"), []byte("changed"), 0644)
+			assert.NoError(err, "test %d (%+v)", i, d)
+
+			content, err := ioutil.ReadFile(filepath.Join(sources[1], "lower1"))
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal("lower1", string(content), "test %d (%+v)", i, d)
+
+			content, err = ioutil.ReadFile(filepath.Join(tmpDir, rootfsOverlayDir, "ctr", "upper", "lower1"))
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal("changed", string(content), "test %d (%+v)", i, d)
+
+			// They are not kept in the container state directory.
+			_, err = os.Stat(ociConfigBasePath)
+			assert.True(os.IsNotExist(err), "test %d (%+v)", i, d)
+		}
+
+		err = removeMounts(mounts)
+		assert.NoError(err, "test %d (%+v)", i, d)
+	}
+}
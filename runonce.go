@@ -0,0 +1,213 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	runOnceDefaultTimeout = 10 * time.Second
+	runOnceMaxTimeout     = 5 * time.Minute
+
+	runOnceDefaultMaxOutput = 64 * 1024
+	runOnceMaxOutput        = 1024 * 1024
+
+	// Time given to read the output left in the pipes once the command
+	// exited, in case some of its children still hold them open.
+	runOnceDrainTimeout = 100 * time.Millisecond
+
+	runOnceDefaultPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+)
+
+// boundedBuffer keeps the first bytes written to it, up to max, and silently
+// drops the remaining ones so that the writer never gets an error.
+type boundedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *boundedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.buf.Len(); len(p) > room {
+		b.truncated = true
+		b.buf.Write(p[:room])
+	} else {
+		b.buf.Write(p)
+	}
+
+	return len(p), nil
+}
+
+// lookupContainerPath returns the path, relative to the container root, of
+// the executable name found in the PATH of env.
+func lookupContainerPath(root, name string, env []string) (string, error) {
+	if strings.Contains(name, "/") {
+		return name, nil
+	}
+
+	return findContainerExecutable(root, "/", name, envPath(env, runOnceDefaultPath))
+}
+
+// startRunOnce starts proc in the container ctr, registering its exit code
+// channel with the reaper lock held as execProcess() does, without tracking
+// it as a process of the container.
+func startRunOnce(r reaper, ctr *container, proc *libcontainer.Process) (<-chan int, error) {
+	r.lock()
+	defer r.unlock()
+
+	if err := ctr.container.Run(proc); err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not run command: %v", err)
+	}
+
+	pid, err := proc.Pid()
+	if err != nil {
+		return nil, err
+	}
+
+	exitCodeCh := make(chan int, 1)
+	r.setExitCodeCh(pid, exitCodeCh)
+
+	return exitCodeCh, nil
+}
+
+// runOnce runs the command described by req in the container ctr, and returns
+// its exit code and the beginning of its output. It is run by libcontainer as
+// an exec process of the user of the container init, getting the namespaces,
+// cgroups, capabilities and seccomp filter of the container.
+func runOnce(ctx context.Context, r reaper, ctr *container, req *pb.RunOnceRequest) (*pb.RunOnceResponse, error) {
+	if len(req.Args) == 0 || req.Args[0] == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "Missing command")
+	}
+
+	// Like the exec processes, see ExecProcess().
+	if ctr.timeNs {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Cannot run command in container %s with a time namespace", ctr.id)
+	}
+
+	timeout := runOnceDefaultTimeout
+	if req.Timeout != 0 {
+		timeout = time.Duration(req.Timeout) * time.Second
+	}
+	if timeout > runOnceMaxTimeout {
+		timeout = runOnceMaxTimeout
+	}
+
+	maxOutput := runOnceDefaultMaxOutput
+	if req.MaxOutput != 0 {
+		maxOutput = int(req.MaxOutput)
+	}
+	if maxOutput > runOnceMaxOutput {
+		maxOutput = runOnceMaxOutput
+	}
+
+	root, err := getContainerRoot(ctr)
+	if err != nil {
+		return nil, err
+	}
+
+	// libcontainer would only fail to execute the command once started.
+	if _, err := lookupContainerPath(root, req.Args[0], req.Env); err != nil {
+		return nil, err
+	}
+
+	// Do not leak the agent environment to the command.
+	env := req.Env
+	if len(env) == 0 {
+		env = []string{"PATH=" + runOnceDefaultPath}
+	}
+
+	stdout := &boundedBuffer{max: maxOutput}
+	stderr := &boundedBuffer{max: maxOutput}
+
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	defer outR.Close()
+
+	errR, errW, err := os.Pipe()
+	if err != nil {
+		outW.Close()
+		return nil, err
+	}
+	defer errR.Close()
+
+	proc := &libcontainer.Process{
+		Args:             req.Args,
+		Env:              env,
+		Cwd:              "/",
+		User:             ctr.initProcess.process.User,
+		AdditionalGroups: ctr.initProcess.process.AdditionalGroups,
+		Stdout:           outW,
+		Stderr:           errW,
+	}
+
+	exitCodeCh, err := startRunOnce(r, ctr, proc)
+	outW.Close()
+	errW.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	drained := make(chan struct{}, 2)
+	for _, p := range []struct {
+		r *os.File
+		w io.Writer
+	}{{outR, stdout}, {errR, stderr}} {
+		go func(r *os.File, w io.Writer) {
+			io.Copy(w, r)
+			drained <- struct{}{}
+		}(p.r, p.w)
+	}
+
+	codeCh := make(chan int, 1)
+	go func() {
+		exitCode, _ := r.wait(exitCodeCh, (*reaperLibcontainerProcess)(proc))
+		codeCh <- exitCode
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var exitCode int
+	select {
+	case exitCode = <-codeCh:
+	case <-ctx.Done():
+		proc.Signal(syscall.SIGKILL)
+		<-codeCh
+		if ctx.Err() == context.Canceled {
+			return nil, grpcStatus.Errorf(codes.Canceled, "Command %q canceled", req.Args[0])
+		}
+		return nil, grpcStatus.Errorf(codes.DeadlineExceeded, "Command %q did not complete within %v", req.Args[0], timeout)
+	}
+
+	deadline := time.Now().Add(runOnceDrainTimeout)
+	outR.SetReadDeadline(deadline)
+	errR.SetReadDeadline(deadline)
+	<-drained
+	<-drained
+
+	return &pb.RunOnceResponse{
+		Stdout:          stdout.buf.Bytes(),
+		Stderr:          stderr.buf.Bytes(),
+		ExitCode:        int32(exitCode),
+		StdoutTruncated: stdout.truncated,
+		StderrTruncated: stderr.truncated,
+	}, nil
+}
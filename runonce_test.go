@@ -0,0 +1,178 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// startTestReaper returns a reaper reaping the children of the test process
+// until the returned function is called.
+func startTestReaper() (*agentReaper, func()) {
+	r := &agentReaper{}
+	r.init()
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for {
+			r.reap()
+
+			select {
+			case <-stop:
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}()
+
+	return r, func() {
+		close(stop)
+		<-done
+	}
+}
+
+func TestBoundedBuffer(t *testing.T) {
+	assert := assert.New(t)
+
+	b := &boundedBuffer{max: 4}
+
+	n, err := b.Write([]byte("ab"))
+	assert.NoError(err)
+	assert.Equal(2, n)
+	assert.False(b.truncated)
+
+	n, err = b.Write([]byte("cdef"))
+	assert.NoError(err)
+	assert.Equal(4, n)
+	assert.True(b.truncated)
+	assert.Equal("abcd", b.buf.String())
+
+	n, err = b.Write([]byte("g"))
+	assert.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal("abcd", b.buf.String())
+}
+
+func TestLookupContainerPath(t *testing.T) {
+	assert := assert.New(t)
+
+	path, err := lookupContainerPath("/", "/bin/sh", nil)
+	assert.NoError(err)
+	assert.Equal("/bin/sh", path)
+
+	path, err = lookupContainerPath("/", "sh", nil)
+	assert.NoError(err)
+	assert.True(strings.HasSuffix(path, "/sh"), path)
+
+	_, err = lookupContainerPath("/", "sh", []string{"PATH=/does/not/exist"})
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+
+	_, err = lookupContainerPath("/", "does-not-exist", nil)
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+}
+
+func TestRunOnce(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	// The command runs with the capabilities of the container.
+	c, cleanup := createTestContainerWithConfig(t, "test-run-once", func(config *configs.Config) {
+		config.Capabilities = &configs.Capabilities{Bounding: []string{"CAP_KILL"}}
+	})
+	defer cleanup()
+
+	initProc, err := buildProcess(&pb.Process{
+		Args: []string{"sleep", "100"},
+		Env:  []string{"PATH=/bin"},
+		Cwd:  "/",
+	}, "init", true)
+	assert.NoError(err)
+
+	err = c.Run(&initProc.process)
+	initProc.closePostStartFDs()
+	assert.NoError(err)
+	defer initProc.closePostExitFDs()
+
+	pid, err := initProc.pid()
+	assert.NoError(err)
+
+	// The command gets the namespaces and cgroups of the init process.
+	var expected []string
+	for _, name := range []string{"ns/mnt", "ns/pid", "cgroup"} {
+		path := fmt.Sprintf("/proc/%d/%s", pid, name)
+		if name == "cgroup" {
+			content, err := ioutil.ReadFile(path)
+			assert.NoError(err)
+			expected = append(expected, strings.TrimSpace(string(content)))
+		} else {
+			link, err := os.Readlink(path)
+			assert.NoError(err)
+			expected = append(expected, link)
+		}
+	}
+	expected = append(expected, "CapBnd:\t0000000000000020")
+
+	r, stopReaper := startTestReaper()
+	defer stopReaper()
+
+	ctr := &container{id: "test-run-once", container: c, initProcess: initProc}
+
+	resp, err := runOnce(context.Background(), r, ctr, &pb.RunOnceRequest{
+		Args: []string{"sh", "-c", "readlink /proc/self/ns/mnt /proc/self/ns/pid; cat /proc/self/cgroup; grep CapBnd /proc/self/status; echo failed >&2; exit 3"},
+		Env:  []string{"PATH=/bin"},
+	})
+	assert.NoError(err)
+	assert.Equal(strings.Join(expected, "\n")+"\n", string(resp.Stdout))
+	assert.Equal("failed\n", string(resp.Stderr))
+	assert.Equal(int32(3), resp.ExitCode)
+	assert.False(resp.StdoutTruncated)
+	assert.False(resp.StderrTruncated)
+
+	// The command is not tracked by the container.
+	assert.Empty(ctr.processes)
+
+	resp, err = runOnce(context.Background(), r, ctr, &pb.RunOnceRequest{
+		Args:      []string{"sh", "-c", "cat /proc/self/status /proc/self/status"},
+		MaxOutput: 16,
+	})
+	assert.NoError(err)
+	assert.Len(resp.Stdout, 16)
+	assert.True(resp.StdoutTruncated)
+	assert.Equal(int32(0), resp.ExitCode)
+
+	_, err = runOnce(context.Background(), r, ctr, &pb.RunOnceRequest{
+		Args:    []string{"sleep", "10"},
+		Timeout: 1,
+	})
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+
+	_, err = runOnce(context.Background(), r, ctr, &pb.RunOnceRequest{Args: []string{"does-not-exist"}})
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+
+	_, err = runOnce(context.Background(), r, ctr, &pb.RunOnceRequest{})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	ctr.timeNs = true
+	_, err = runOnce(context.Background(), r, ctr, &pb.RunOnceRequest{Args: []string{"true"}})
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+}
@@ -0,0 +1,109 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	units "github.com/docker/go-units"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	// Annotation requesting the size of the container /dev/shm, e.g. "256m".
+	shmSizeAnnotation = containerAnnotationPrefix + "shm_size"
+
+	containerShm = "/dev/shm"
+)
+
+// Options of the tmpfs mounted on the container /dev/shm, along with its size.
+var containerShmOptions = []string{"nosuid", "noexec", "nodev", "mode=1777"}
+
+// parseShmSize returns the size in bytes described by size, such as "256m"
+// or "1g".
+func parseShmSize(size string) (int64, error) {
+	bytes, err := units.RAMInBytes(size)
+	if err != nil {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "invalid /dev/shm size %q: %v", size, err)
+	}
+
+	if bytes <= 0 {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "invalid /dev/shm size %q: must be positive", size)
+	}
+
+	return bytes, nil
+}
+
+// containerShmSize returns the size of the container /dev/shm requested by
+// spec, through the shmSizeAnnotation annotation or else the size option of
+// its /dev/shm mount, or an empty string if none is.
+func containerShmSize(spec *specs.Spec) string {
+	if size, ok := spec.Annotations[shmSizeAnnotation]; ok {
+		return size
+	}
+
+	for _, m := range spec.Mounts {
+		if filepath.Clean(m.Destination) != containerShm {
+			continue
+		}
+
+		for _, opt := range m.Options {
+			if strings.HasPrefix(opt, "size=") {
+				return strings.TrimPrefix(opt, "size=")
+			}
+		}
+	}
+
+	return ""
+}
+
+// checkContainerShm checks the size of the container /dev/shm requested by
+// spec, if any.
+func checkContainerShm(spec *specs.Spec) error {
+	size := containerShmSize(spec)
+	if size == "" {
+		return nil
+	}
+
+	_, err := parseShmSize(size)
+	return err
+}
+
+// setupContainerShm replaces the /dev/shm mount of spec with a tmpfs of the
+// requested size, if any. The mount of the spec is left as is otherwise.
+func setupContainerShm(spec *specs.Spec) error {
+	size := containerShmSize(spec)
+	if size == "" {
+		return nil
+	}
+
+	bytes, err := parseShmSize(size)
+	if err != nil {
+		return err
+	}
+
+	m := specs.Mount{
+		Destination: containerShm,
+		Type:        "tmpfs",
+		Source:      "shm",
+		Options:     append(append([]string{}, containerShmOptions...), fmt.Sprintf("size=%d", bytes)),
+	}
+
+	var mounts []specs.Mount
+	for _, mnt := range spec.Mounts {
+		if filepath.Clean(mnt.Destination) != containerShm {
+			mounts = append(mounts, mnt)
+		}
+	}
+	spec.Mounts = append(mounts, m)
+
+	return nil
+}
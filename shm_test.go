@@ -0,0 +1,148 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestParseShmSize(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		size          string
+		expectedBytes int64
+		expectError   bool
+	}
+
+	data := []testData{
+		{"256m", 256 * 1024 * 1024, false},
+		{"256M", 256 * 1024 * 1024, false},
+		{"65536k", 64 * 1024 * 1024, false},
+		{"1g", 1024 * 1024 * 1024, false},
+		{"4096", 4096, false},
+		{"", 0, true},
+		{"0", 0, true},
+		{"-1m", 0, true},
+		{"lots", 0, true},
+		{"50%", 0, true},
+	}
+
+	for i, d := range data {
+		bytes, err := parseShmSize(d.size)
+		if d.expectError {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedBytes, bytes, "test %d (%+v)", i, d)
+	}
+}
+
+func TestSetupContainerShm(t *testing.T) {
+	assert := assert.New(t)
+
+	sandboxShm := specs.Mount{Destination: "/dev/shm", Source: "/run/kata-containers/sandbox/shm", Type: "bind", Options: []string{"rbind"}}
+	sizedShm := specs.Mount{Destination: "/dev/shm/", Source: "shm", Type: "tmpfs", Options: []string{"nosuid", "size=65536k"}}
+	otherMount := specs.Mount{Destination: "/etc/hosts", Source: "/host/hosts", Type: "bind"}
+
+	type testData struct {
+		mounts          []specs.Mount
+		annotations     map[string]string
+		expectedOptions []string
+		expectError     bool
+	}
+
+	data := []testData{
+		{[]specs.Mount{sandboxShm, otherMount}, nil, nil, false},
+		{[]specs.Mount{otherMount}, map[string]string{"foo": "bar"}, nil, false},
+		{[]specs.Mount{sandboxShm, otherMount}, map[string]string{shmSizeAnnotation: "256m"}, []string{"size=268435456"}, false},
+		{[]specs.Mount{otherMount}, map[string]string{shmSizeAnnotation: "1g"}, []string{"size=1073741824"}, false},
+		{[]specs.Mount{sizedShm, otherMount}, nil, []string{"size=67108864"}, false},
+		// The annotation takes precedence over the mount option.
+		{[]specs.Mount{sizedShm, otherMount}, map[string]string{shmSizeAnnotation: "128m"}, []string{"size=134217728"}, false},
+		{[]specs.Mount{sandboxShm, otherMount}, map[string]string{shmSizeAnnotation: "big"}, nil, true},
+	}
+
+	for i, d := range data {
+		mounts := append([]specs.Mount{}, d.mounts...)
+		spec := &specs.Spec{Mounts: mounts, Annotations: d.annotations}
+
+		err := setupContainerShm(spec)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			continue
+		}
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		if d.expectedOptions == nil {
+			assert.Equal(d.mounts, spec.Mounts, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.Equal([]specs.Mount{otherMount, {
+			Destination: containerShm,
+			Type:        "tmpfs",
+			Source:      "shm",
+			Options:     append(append([]string{}, containerShmOptions...), d.expectedOptions...),
+		}}, spec.Mounts, "test %d (%+v)", i, d)
+	}
+}
+
+func TestMountContainerShm(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "shm")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	for i, size := range []string{"256m", "1g"} {
+		spec := &specs.Spec{Annotations: map[string]string{shmSizeAnnotation: size}}
+		err = setupContainerShm(spec)
+		assert.NoError(err)
+
+		m := spec.Mounts[0]
+		flags, options, err := parseMountFlagsAndOptions(m.Options)
+		assert.NoError(err)
+
+		err = unix.Mount(m.Source, tmpDir, m.Type, uintptr(flags), options)
+		assert.NoError(err, "test %d (%s)", i, size)
+
+		mounts, err := mountinfo.GetMounts()
+		assert.NoError(err)
+
+		var superOptions string
+		for _, mi := range mounts {
+			if mi.Mountpoint == tmpDir {
+				superOptions = mi.VfsOpts
+			}
+		}
+
+		bytes, err := parseShmSize(size)
+		assert.NoError(err)
+
+		// The kernel reports the size of the tmpfs in kilobytes.
+		assert.Contains(strings.Split(superOptions, ","), "size="+strconv.FormatInt(bytes/1024, 10)+"k", "test %d (%s)", i, size)
+
+		err = unix.Unmount(tmpDir, 0)
+		assert.NoError(err)
+	}
+}
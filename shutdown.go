@@ -0,0 +1,144 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/opencontainers/runc/libcontainer"
+)
+
+// Time given to a graceful shutdown requested without timeout.
+const defaultShutdownTimeout = 30 * time.Second
+
+// Interval at which the containers are checked while waiting for them to
+// stop.
+var shutdownPollInterval = 50 * time.Millisecond
+
+// syncFilesystems flushes the filesystems once the sandbox is torn down.
+var syncFilesystems = syscall.Sync
+
+// stop sends SIGTERM to all the processes of c, and kills them if they are
+// still running at deadline.
+func (c *container) stop(deadline time.Time) error {
+	stopped := func() (bool, error) {
+		status, err := c.container.Status()
+		return status == libcontainer.Stopped, err
+	}
+
+	if done, err := stopped(); done || err != nil {
+		return err
+	}
+
+	if err := c.container.Signal(syscall.SIGTERM, true); err != nil {
+		return err
+	}
+
+	for time.Now().Before(deadline) {
+		time.Sleep(shutdownPollInterval)
+
+		if done, err := stopped(); done || err != nil {
+			return err
+		}
+	}
+
+	agentLog.WithField("container", c.id).Warn("Container not stopped in time, killing it")
+
+	return c.container.Signal(syscall.SIGKILL, true)
+}
+
+// stopContainers stops all the containers of the sandbox simultaneously,
+// giving them until deadline to exit.
+func (s *sandbox) stopContainers(deadline time.Time) []error {
+	s.RLock()
+	ctrs := make([]*container, 0, len(s.containers))
+	for _, ctr := range s.containers {
+		ctrs = append(ctrs, ctr)
+	}
+	s.RUnlock()
+
+	errs := make([]error, len(ctrs))
+
+	var wg sync.WaitGroup
+	for i, ctr := range ctrs {
+		wg.Add(1)
+		go func(i int, ctr *container) {
+			defer wg.Done()
+
+			if err := ctr.stop(deadline); err != nil {
+				errs[i] = fmt.Errorf("stop container %s: %v", ctr.id, err)
+			}
+		}(i, ctr)
+	}
+	wg.Wait()
+
+	var failures []error
+	for _, err := range errs {
+		if err != nil {
+			failures = append(failures, err)
+		}
+	}
+
+	return failures
+}
+
+// shutdown tears the sandbox down in order: the containers are stopped,
+// given until stopDeadline to exit, and removed, then the network, the
+// sandbox mounts and the shared namespaces are released and the filesystems
+// are synchronized. Unlike DestroySandbox, a failing step does not prevent
+// the next ones from running, all the failures being returned.
+func (s *sandbox) shutdown(stopDeadline time.Time) []error {
+	errs := s.stopContainers(stopDeadline)
+
+	s.RLock()
+	ctrs := make([]*container, 0, len(s.containers))
+	for _, ctr := range s.containers {
+		ctrs = append(ctrs, ctr)
+	}
+	s.RUnlock()
+
+	for _, ctr := range ctrs {
+		if err := s.removeContainer(ctr); err != nil {
+			errs = append(errs, fmt.Errorf("remove container %s: %v", ctr.id, err))
+		}
+	}
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"remove network", s.removeNetwork},
+		{"remove sandbox mounts", func() error { return removeMounts(s.mounts) }},
+		{"tear down shared PID namespace", s.teardownSharedPidNs},
+		{"unmount shared namespaces", s.unmountSharedNamespaces},
+	}
+
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", step.name, err))
+		}
+	}
+
+	s.Lock()
+	s.hostname = ""
+	s.id = ""
+	s.containers = make(map[string]*container)
+	s.running = false
+	s.network = network{}
+	s.mounts = []string{}
+	s.storages = make(map[string]*sandboxStorage)
+	s.Unlock()
+
+	// Whatever failed, the container logs and everything written to the
+	// guest filesystems must reach their devices before the power off.
+	syncFilesystems()
+
+	return errs
+}
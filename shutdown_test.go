@@ -0,0 +1,175 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestContainerStop(t *testing.T) {
+	assert := assert.New(t)
+
+	savedInterval := shutdownPollInterval
+	shutdownPollInterval = time.Millisecond
+	defer func() {
+		shutdownPollInterval = savedInterval
+	}()
+
+	type testData struct {
+		status          libcontainer.Status
+		stopSignals     []os.Signal
+		expectedSignals []os.Signal
+	}
+
+	data := []testData{
+		{libcontainer.Stopped, nil, nil},
+		{libcontainer.Running, []os.Signal{syscall.SIGTERM}, []os.Signal{syscall.SIGTERM}},
+		{libcontainer.Running, []os.Signal{syscall.SIGKILL}, []os.Signal{syscall.SIGTERM, syscall.SIGKILL}},
+	}
+
+	for i, d := range data {
+		mock := &mockContainer{status: d.status, stopSignals: d.stopSignals}
+		ctr := &container{id: "ctr", container: mock}
+
+		err := ctr.stop(time.Now().Add(10 * time.Millisecond))
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedSignals, mock.signals, "test %d (%+v)", i, d)
+		assert.Equal(libcontainer.Stopped, mock.status, "test %d (%+v)", i, d)
+	}
+}
+
+func TestGracefulShutdown(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSync, savedInterval := syncFilesystems, shutdownPollInterval
+	shutdownPollInterval = time.Millisecond
+	defer func() {
+		syncFilesystems, shutdownPollInterval = savedSync, savedInterval
+	}()
+
+	// The sandbox is not started, the filesystems are synchronized only.
+	a := &agentGRPC{sandbox: &sandbox{containers: make(map[string]*container)}}
+
+	syncs := 0
+	syncFilesystems = func() {
+		syncs++
+	}
+
+	resp, err := a.GracefulShutdown(context.Background(), &pb.GracefulShutdownRequest{})
+	assert.NoError(err)
+	assert.Empty(resp.Failures)
+	assert.Equal(1, syncs)
+
+	// One container stopping on SIGTERM, the other one needing SIGKILL.
+	terminated := &mockContainer{status: libcontainer.Running, stopSignals: []os.Signal{syscall.SIGTERM}}
+	killed := &mockContainer{status: libcontainer.Running, stopSignals: []os.Signal{syscall.SIGKILL}}
+
+	a.sandbox = &sandbox{
+		id:         "sandbox",
+		running:    true,
+		storages:   make(map[string]*sandboxStorage),
+		stopServer: make(chan struct{}),
+		containers: map[string]*container{
+			"terminated": {id: "terminated", container: terminated},
+			"killed":     {id: "killed", container: killed},
+		},
+	}
+
+	// The filesystems are synchronized last, once everything is torn down.
+	syncs = 0
+	syncFilesystems = func() {
+		syncs++
+		assert.True(terminated.destroyed)
+		assert.True(killed.destroyed)
+		assert.Empty(a.sandbox.containers)
+		assert.False(a.sandbox.running)
+	}
+
+	resp, err = a.GracefulShutdown(context.Background(), &pb.GracefulShutdownRequest{Timeout: 1})
+	assert.NoError(err)
+	assert.Equal(1, syncs)
+
+	assert.Equal([]os.Signal{syscall.SIGTERM}, terminated.signals)
+	assert.Equal([]os.Signal{syscall.SIGTERM, syscall.SIGKILL}, killed.signals)
+
+	// The shared namespaces not being mounted cannot be unmounted, which
+	// is reported without stopping the teardown.
+	assert.Len(resp.Failures, 1)
+	assert.Contains(resp.Failures[0], "unmount shared namespaces")
+	assert.Equal("", a.sandbox.id)
+}
+
+func TestGracefulShutdownTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSync, savedTracing, savedStartTracingCalled := syncFilesystems, tracing, startTracingCalled
+	defer func() {
+		syncFilesystems, tracing, startTracingCalled = savedSync, savedTracing, savedStartTracingCalled
+	}()
+
+	// The server is stopped once the sandbox is torn down.
+	tracing, startTracingCalled = true, false
+
+	// The teardown does not complete in time.
+	release := make(chan struct{})
+	syncFilesystems = func() {
+		<-release
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			id:         "sandbox",
+			running:    true,
+			storages:   make(map[string]*sandboxStorage),
+			stopServer: make(chan struct{}),
+			containers: make(map[string]*container),
+		},
+	}
+
+	_, err := a.GracefulShutdown(context.Background(), &pb.GracefulShutdownRequest{Timeout: 1})
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+
+	select {
+	case <-a.sandbox.stopServer:
+		assert.Fail("server stopped before the end of the teardown")
+	default:
+	}
+
+	// Another teardown waits for the first one to complete, which stops
+	// the server once only.
+	destroyed := make(chan error, 1)
+	go func() {
+		_, err := a.DestroySandbox(context.Background(), &pb.DestroySandboxRequest{})
+		destroyed <- err
+	}()
+
+	select {
+	case <-destroyed:
+		assert.Fail("sandbox destroyed along with its graceful shutdown")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+
+	assert.NoError(<-destroyed)
+	<-a.sandbox.stopServer
+	assert.False(a.sandbox.isRunning())
+
+	resp, err := a.GracefulShutdown(context.Background(), &pb.GracefulShutdownRequest{})
+	assert.NoError(err)
+	assert.Empty(resp.Failures)
+}
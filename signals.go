@@ -14,6 +14,10 @@ import (
 	"runtime/pprof"
 	"strings"
 	"syscall"
+
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 // List of handled signals.
@@ -35,6 +39,52 @@ var handledSignalsMap = map[syscall.Signal]bool{
 	syscall.SIGUSR1:   false,
 }
 
+// Alternative names of signals, not known by unix.SignalNum().
+var signalAliases = map[string]syscall.Signal{
+	"SIGCLD":  unix.SIGCLD,
+	"SIGIOT":  unix.SIGIOT,
+	"SIGPOLL": unix.SIGPOLL,
+}
+
+// parseSignalName returns the number of the signal called name on the
+// architecture of the guest. The "SIG" prefix is optional.
+func parseSignalName(name string) (syscall.Signal, error) {
+	sigName := strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(sigName, "SIG") {
+		sigName = "SIG" + sigName
+	}
+
+	if sig := unix.SignalNum(sigName); sig != 0 {
+		return sig, nil
+	}
+
+	if sig, ok := signalAliases[sigName]; ok {
+		return sig, nil
+	}
+
+	return 0, grpcStatus.Errorf(codes.InvalidArgument, "Unknown signal %q", name)
+}
+
+// resolveSignal returns the signal designated by its number or its name,
+// making sure both agree when both are provided.
+func resolveSignal(number uint32, name string) (syscall.Signal, error) {
+	if name == "" {
+		return syscall.Signal(number), nil
+	}
+
+	sig, err := parseSignalName(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if number != 0 && syscall.Signal(number) != sig {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument,
+			"Signal %q is %d, not %d", name, sig, number)
+	}
+
+	return sig, nil
+}
+
 func handlePanic() {
 	r := recover()
 
@@ -16,6 +16,8 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 func TestSignalFatalSignal(t *testing.T) {
@@ -132,3 +134,60 @@ func TestSignalBacktrace(t *testing.T) {
 	assert.True(strings.Contains(b, `level=error`))
 	assert.True(strings.Contains(b, name))
 }
+
+func TestParseSignalName(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		name           string
+		expectedSignal syscall.Signal
+		expectError    bool
+	}
+
+	data := []testData{
+		{"SIGTERM", syscall.SIGTERM, false},
+		{"TERM", syscall.SIGTERM, false},
+		{"term", syscall.SIGTERM, false},
+		{" SIGKILL ", syscall.SIGKILL, false},
+		{"SIGUSR1", syscall.SIGUSR1, false},
+		{"HUP", syscall.SIGHUP, false},
+		{"SIGIOT", syscall.SIGABRT, false},
+		{"CLD", syscall.SIGCHLD, false},
+		{"SIGPOLL", syscall.SIGIO, false},
+		{"", 0, true},
+		{"SIG", 0, true},
+		{"SIGFOO", 0, true},
+		{"15", 0, true},
+	}
+
+	for i, d := range data {
+		sig, err := parseSignalName(d.name)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedSignal, sig, "test %d (%+v)", i, d)
+	}
+}
+
+func TestResolveSignal(t *testing.T) {
+	assert := assert.New(t)
+
+	sig, err := resolveSignal(uint32(syscall.SIGINT), "")
+	assert.NoError(err)
+	assert.Equal(syscall.SIGINT, sig)
+
+	sig, err = resolveSignal(0, "INT")
+	assert.NoError(err)
+	assert.Equal(syscall.SIGINT, sig)
+
+	sig, err = resolveSignal(uint32(syscall.SIGINT), "SIGINT")
+	assert.NoError(err)
+	assert.Equal(syscall.SIGINT, sig)
+
+	_, err = resolveSignal(uint32(syscall.SIGKILL), "SIGINT")
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
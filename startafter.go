@@ -0,0 +1,164 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	defaultStartAfterTimeout = 30 * time.Second
+
+	startAfterInitialInterval = 10 * time.Millisecond
+	startAfterMaxInterval     = 500 * time.Millisecond
+)
+
+// setReady marks the container as started and ready, letting the containers
+// started after it proceed.
+func (c *container) setReady() {
+	c.Lock()
+	c.ready = true
+	c.Unlock()
+}
+
+func (c *container) isReady() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.ready
+}
+
+// checkStartAfter checks that the container id can be started after the
+// containers deps without closing a cycle through the start dependencies of
+// the containers of the sandbox.
+func (s *sandbox) checkStartAfter(id string, deps []string) error {
+	s.RLock()
+	defer s.RUnlock()
+
+	visited := make(map[string]bool)
+	var cycle []string
+
+	var visit func(path []string, deps []string) bool
+	visit = func(path []string, deps []string) bool {
+		for _, dep := range deps {
+			depPath := append(path[:len(path):len(path)], dep)
+			if dep == id {
+				cycle = depPath
+				return true
+			}
+
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+
+			if ctr, ok := s.containers[dep]; ok && visit(depPath, ctr.startAfter) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, dep := range deps {
+		if dep == "" {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Empty start dependency for container %s", id)
+		}
+	}
+
+	if visit([]string{id}, deps) {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Cyclic start dependency for container %s: %s", id, strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
+// startAfterPending returns the first dependency of ctr which is not ready
+// yet, or an error if a dependency stopped after being ready.
+func (s *sandbox) startAfterPending(ctr *container) (string, error) {
+	for _, dep := range ctr.startAfter {
+		depCtr, err := s.getContainer(dep)
+		if err != nil || !depCtr.isReady() {
+			return dep, nil
+		}
+
+		status, err := depCtr.container.Status()
+		if err != nil {
+			return "", err
+		}
+
+		if status == libcontainer.Stopped {
+			return "", grpcStatus.Errorf(codes.FailedPrecondition, "Container %s stopped before container %s could start", dep, ctr.id)
+		}
+	}
+
+	return "", nil
+}
+
+// waitForStartAfter waits until the containers ctr has to be started after
+// are ready, the wait times out or ctx is cancelled.
+func (s *sandbox) waitForStartAfter(ctx context.Context, ctr *container) error {
+	if len(ctr.startAfter) == 0 {
+		return nil
+	}
+
+	// Containers created concurrently could have escaped the check done
+	// upon creation.
+	if err := s.checkStartAfter(ctr.id, ctr.startAfter); err != nil {
+		return err
+	}
+
+	timeout := defaultStartAfterTimeout
+	if ctr.startAfterTimeout > 0 {
+		timeout = ctr.startAfterTimeout
+	}
+
+	fieldLogger := agentLog.WithFields(logrus.Fields{
+		"container":   ctr.id,
+		"start-after": ctr.startAfter,
+		"timeout":     timeout,
+	})
+	fieldLogger.Debug("waiting for container dependencies")
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	b := newBackoff(startAfterInitialInterval, startAfterMaxInterval)
+
+	for {
+		pending, err := s.startAfterPending(ctr)
+		if err != nil {
+			return err
+		}
+
+		if pending == "" {
+			fieldLogger.Info("container dependencies ready")
+			return nil
+		}
+
+		poll := time.NewTimer(b.next())
+
+		select {
+		case <-poll.C:
+		case <-ctx.Done():
+			poll.Stop()
+			return grpcStatus.Errorf(codes.Canceled,
+				"Stopped waiting for the dependencies of container %s: %v", ctr.id, ctx.Err())
+		case <-deadline.C:
+			poll.Stop()
+			return grpcStatus.Errorf(codes.DeadlineExceeded,
+				"Container %s not ready after %s, container %s cannot start", pending, timeout, ctr.id)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestCheckStartAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &sandbox{
+		containers: map[string]*container{
+			"a": {id: "a", startAfter: []string{"b"}},
+			"b": {id: "b", startAfter: []string{"c", "missing"}},
+			"c": {id: "c"},
+		},
+	}
+
+	type testData struct {
+		id           string
+		deps         []string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{"new", nil, codes.OK},
+		{"new", []string{"a"}, codes.OK},
+		{"new", []string{"a", "c", "missing"}, codes.OK},
+		{"missing", []string{"c"}, codes.OK},
+		{"c", []string{"new"}, codes.OK},
+		{"c", []string{"a"}, codes.InvalidArgument},
+		{"missing", []string{"a"}, codes.InvalidArgument},
+		{"new", []string{"new"}, codes.InvalidArgument},
+		{"new", []string{""}, codes.InvalidArgument},
+	}
+
+	for i, d := range data {
+		err := s.checkStartAfter(d.id, d.deps)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+	}
+
+	err := s.checkStartAfter("c", []string{"a"})
+	assert.True(strings.Contains(err.Error(), "c -> a -> b -> c"), err.Error())
+}
+
+func TestStartContainerStartAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				"a": {
+					id:        "a",
+					container: &mockContainer{status: libcontainer.Created},
+				},
+				"b": {
+					id:         "b",
+					container:  &mockContainer{status: libcontainer.Created},
+					startAfter: []string{"a"},
+				},
+			},
+			running: true,
+		},
+	}
+
+	errCh := make(chan error)
+	go func() {
+		_, err := a.StartContainer(context.Background(), &pb.StartContainerRequest{ContainerId: "b"})
+		errCh <- err
+	}()
+
+	// b waits for a to be started.
+	select {
+	case err := <-errCh:
+		assert.Fail("container started before its dependency", "error: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	ctrB, err := a.sandbox.getContainer("b")
+	assert.NoError(err)
+	assert.False(ctrB.isReady())
+
+	_, err = a.StartContainer(context.Background(), &pb.StartContainerRequest{ContainerId: "a"})
+	assert.NoError(err)
+
+	select {
+	case err := <-errCh:
+		assert.NoError(err)
+	case <-time.After(5 * time.Second):
+		assert.Fail("container not started after its dependency")
+	}
+
+	assert.True(ctrB.isReady())
+}
+
+func TestStartContainerStartAfterFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		depCtr       *container
+		ctx          context.Context
+		expectedCode codes.Code
+	}
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	data := []testData{
+		// The dependency is never started.
+		{nil, context.Background(), codes.DeadlineExceeded},
+		{&container{id: "dep", container: &mockContainer{status: libcontainer.Created}}, context.Background(), codes.DeadlineExceeded},
+		{&container{id: "dep", container: &mockContainer{status: libcontainer.Created}}, cancelledCtx, codes.Canceled},
+		// The dependency has exited.
+		{&container{id: "dep", container: &mockContainer{status: libcontainer.Stopped}, ready: true}, context.Background(), codes.FailedPrecondition},
+		// The dependency waits for the container.
+		{&container{id: "dep", container: &mockContainer{status: libcontainer.Created}, startAfter: []string{"ctr"}}, context.Background(), codes.InvalidArgument},
+	}
+
+	for i, d := range data {
+		ctr := &container{
+			id:                "ctr",
+			container:         &mockContainer{status: libcontainer.Created},
+			startAfter:        []string{"dep"},
+			startAfterTimeout: 100 * time.Millisecond,
+		}
+
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{"ctr": ctr},
+				running:    true,
+			},
+		}
+
+		if d.depCtr != nil {
+			a.sandbox.containers["dep"] = d.depCtr
+		}
+
+		_, err := a.StartContainer(d.ctx, &pb.StartContainerRequest{ContainerId: "ctr"})
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.False(ctr.isReady(), "test %d (%+v)", i, d)
+	}
+}
+
+func TestCreateContainerChecksStartAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				"a": {id: "a", startAfter: []string{"b"}},
+			},
+			running: true,
+		},
+	}
+
+	err := a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "b", OCI: &pb.Spec{}})
+	assert.NoError(err)
+
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "b", OCI: &pb.Spec{}, StartAfter: []string{"c"}})
+	assert.NoError(err)
+
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "b", OCI: &pb.Spec{}, StartAfter: []string{"a"}})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
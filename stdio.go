@@ -0,0 +1,66 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+)
+
+// Size of the data read for each frame of ReadStdio.
+const stdioFrameSize = 32 * 1024
+
+// streamStdio reads the stdout and the stderr of the process execID of the
+// container cid until their end, each from its own routine, passing the data
+// to send tagged with its stream. The last frame of each stream is an empty
+// EOF frame. A process using a terminal only has a stdout stream.
+func (s *sandbox) streamStdio(cid, execID string, send func(*pb.StdioFrame) error) error {
+	proc, _, err := s.getProcess(cid, execID)
+	if err != nil {
+		return err
+	}
+
+	streams := []string{containerLogStdout}
+	if proc.termMaster == nil {
+		streams = append(streams, containerLogStderr)
+	}
+
+	errCh := make(chan error, len(streams))
+	for _, stream := range streams {
+		go func(stream string) {
+			errCh <- s.streamStdioStream(cid, execID, stream, send)
+		}(stream)
+	}
+
+	var errs []error
+	for range streams {
+		if err := <-errCh; err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return combineErrors(errs)
+}
+
+// streamStdioStream reads one stream of the process execID of the container
+// cid for streamStdio.
+func (s *sandbox) streamStdioStream(cid, execID, stream string, send func(*pb.StdioFrame) error) error {
+	for {
+		data, err := s.readStdio(cid, execID, stdioFrameSize, stream == containerLogStdout)
+		if err == io.EOF {
+			return send(&pb.StdioFrame{Stream: stream, Eof: true})
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := send(&pb.StdioFrame{Stream: stream, Data: data}); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,113 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestStreamStdio(t *testing.T) {
+	assert := assert.New(t)
+
+	stdoutR, stdoutW, err := os.Pipe()
+	assert.NoError(err)
+	defer stdoutR.Close()
+	stderrR, stderrW, err := os.Pipe()
+	assert.NoError(err)
+	defer stderrR.Close()
+
+	proc := &process{id: "exec", stdout: stdoutR, stderr: stderrR}
+	s := &sandbox{
+		running: true,
+		containers: map[string]*container{
+			"ctr": {id: "ctr", processes: map[string]*process{"exec": proc}},
+		},
+	}
+
+	// The process writes to both streams alternately, then a stdout chunk
+	// larger than a frame.
+	cmd := exec.Command("/bin/sh", "-c",
+		"i=0; while [ $i -lt 100 ]; do echo out$i; echo err$i >&2; i=$((i+1)); done; head -c 100000 /dev/zero")
+	cmd.Stdout = stdoutW
+	cmd.Stderr = stderrW
+	assert.NoError(cmd.Start())
+	stdoutW.Close()
+	stderrW.Close()
+
+	var lock sync.Mutex
+	streams := make(map[string]*bytes.Buffer)
+	eofs := make(map[string]int)
+
+	err = s.streamStdio("ctr", "exec", func(f *pb.StdioFrame) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		assert.Equal(0, eofs[f.Stream], "frame %+v after EOF", f)
+		if f.Eof {
+			assert.Empty(f.Data)
+			eofs[f.Stream]++
+			return nil
+		}
+
+		assert.True(len(f.Data) <= stdioFrameSize)
+		if streams[f.Stream] == nil {
+			streams[f.Stream] = &bytes.Buffer{}
+		}
+		streams[f.Stream].Write(f.Data)
+		return nil
+	})
+	assert.NoError(err)
+	assert.NoError(cmd.Wait())
+
+	var expectedStdout, expectedStderr bytes.Buffer
+	for i := 0; i < 100; i++ {
+		fmt.Fprintf(&expectedStdout, "out%d\n", i)
+		fmt.Fprintf(&expectedStderr, "err%d\n", i)
+	}
+	expectedStdout.Write(make([]byte, 100000))
+
+	assert.Equal(map[string]int{"stdout": 1, "stderr": 1}, eofs)
+	assert.Len(streams, 2)
+	assert.Equal(expectedStdout.Bytes(), streams["stdout"].Bytes())
+	assert.Equal(expectedStderr.String(), streams["stderr"].String())
+
+	// A send failure ends the streams.
+	stdoutR2, stdoutW2, err := os.Pipe()
+	assert.NoError(err)
+	defer stdoutR2.Close()
+	stderrR2, stderrW2, err := os.Pipe()
+	assert.NoError(err)
+	defer stderrR2.Close()
+
+	proc.stdout, proc.stderr = stdoutR2, stderrR2
+	stdoutW2.Write([]byte("out"))
+	stdoutW2.Close()
+	stderrW2.Close()
+
+	err = s.streamStdio("ctr", "exec", func(f *pb.StdioFrame) error {
+		if f.Stream == containerLogStdout {
+			return fmt.Errorf("send failed")
+		}
+		return nil
+	})
+	assert.Error(err)
+
+	err = s.streamStdio("ctr", "unknown", func(*pb.StdioFrame) error {
+		return nil
+	})
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+}
@@ -0,0 +1,177 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"sync"
+)
+
+const (
+	defaultStreamBufferSize  = 1024 * 1024
+	defaultStreamBufferTotal = 16 * 1024 * 1024
+
+	streamBufferPolicyBlock = "block"
+	streamBufferPolicyDrop  = "drop"
+)
+
+var (
+	streamBufferedBytes = newGauge("kata_agent_stream_buffered_bytes",
+		"Bytes buffered by the agent for the streaming RPCs.")
+	streamDroppedMessages = newCounter("kata_agent_stream_dropped_messages_total",
+		"Messages dropped because a streaming RPC buffer was full.")
+)
+
+// All the stream buffers share a lock, so that a stream waiting for room in
+// the global budget gets woken up whenever any buffer is drained.
+var (
+	streamBuffersLock sync.Mutex
+	streamBuffersCond = sync.NewCond(&streamBuffersLock)
+	// bytes queued by all the stream buffers
+	streamBuffersUsed int
+)
+
+// sizedMessage is a message whose encoded size is known, like the gRPC
+// messages.
+type sizedMessage interface {
+	Size() int
+}
+
+// streamBuffer queues the messages of a streaming RPC, so that producers do
+// not wait for each message to be sent, while bounding the memory held for a
+// slow consumer. Once either the limit of the stream or the global limit of
+// all the streams is reached, producers are blocked or their messages are
+// dropped, according to the configured policy.
+type streamBuffer struct {
+	send  func(sizedMessage) error
+	limit int
+	drop  bool
+
+	queue []sizedMessage
+	used  int
+	err   error
+	done  bool
+	// closed when the sending routine exits
+	exited chan struct{}
+}
+
+// newStreamBuffer returns a buffer sending its messages through send, from
+// a dedicated routine, with the configured limits and policy.
+func newStreamBuffer(send func(sizedMessage) error) *streamBuffer {
+	b := &streamBuffer{
+		send:   send,
+		limit:  int(streamBufferSize),
+		drop:   streamBufferPolicy == streamBufferPolicyDrop,
+		exited: make(chan struct{}),
+	}
+
+	go b.sendLoop()
+
+	return b
+}
+
+// fitsLocked returns whether size bytes can be queued. A message larger than
+// the limits is accepted once the buffer is empty, not to block forever.
+func (b *streamBuffer) fitsLocked(size int) bool {
+	if b.used == 0 {
+		return streamBuffersUsed == 0 || streamBuffersUsed+size <= int(streamBufferTotal)
+	}
+
+	return b.used+size <= b.limit && streamBuffersUsed+size <= int(streamBufferTotal)
+}
+
+// push queues m. It blocks while the buffer is full with the block policy,
+// and it returns the error of a previous send, if any.
+func (b *streamBuffer) push(m sizedMessage) error {
+	size := m.Size()
+
+	streamBuffersLock.Lock()
+	defer streamBuffersLock.Unlock()
+
+	for b.err == nil && !b.fitsLocked(size) {
+		if b.drop {
+			streamDroppedMessages.inc()
+			return nil
+		}
+		streamBuffersCond.Wait()
+	}
+
+	if b.err != nil {
+		return b.err
+	}
+
+	b.queue = append(b.queue, m)
+	b.used += size
+	streamBuffersUsed += size
+	streamBufferedBytes.add(int64(size))
+	streamBuffersCond.Broadcast()
+
+	return nil
+}
+
+func (b *streamBuffer) sendLoop() {
+	defer close(b.exited)
+
+	streamBuffersLock.Lock()
+	defer streamBuffersLock.Unlock()
+
+	for {
+		for len(b.queue) == 0 && !b.done && b.err == nil {
+			streamBuffersCond.Wait()
+		}
+
+		if len(b.queue) == 0 || b.err != nil {
+			return
+		}
+
+		m := b.queue[0]
+		b.queue[0] = nil
+		b.queue = b.queue[1:]
+
+		streamBuffersLock.Unlock()
+		err := b.send(m)
+		streamBuffersLock.Lock()
+
+		size := m.Size()
+		b.used -= size
+		streamBuffersUsed -= size
+		streamBufferedBytes.add(-int64(size))
+
+		if err != nil {
+			b.err = err
+			b.releaseLocked()
+		}
+
+		streamBuffersCond.Broadcast()
+	}
+}
+
+// releaseLocked drops the queued messages after a send failure.
+func (b *streamBuffer) releaseLocked() {
+	for _, m := range b.queue {
+		size := m.Size()
+		b.used -= size
+		streamBuffersUsed -= size
+		streamBufferedBytes.add(-int64(size))
+	}
+	b.queue = nil
+}
+
+// close waits for the queued messages to be sent and returns the first send
+// error, if any.
+func (b *streamBuffer) close() error {
+	streamBuffersLock.Lock()
+	b.done = true
+	streamBuffersCond.Broadcast()
+	streamBuffersLock.Unlock()
+
+	<-b.exited
+
+	streamBuffersLock.Lock()
+	defer streamBuffersLock.Unlock()
+
+	return b.err
+}
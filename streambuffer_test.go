@@ -0,0 +1,223 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSizedMessage int
+
+func (m testSizedMessage) Size() int {
+	return int(m)
+}
+
+// slowConsumer records the messages sent through a stream buffer, sending
+// one of them each time release is written to.
+type slowConsumer struct {
+	sync.Mutex
+	release  chan struct{}
+	received []sizedMessage
+}
+
+func newSlowConsumer() *slowConsumer {
+	return &slowConsumer{release: make(chan struct{})}
+}
+
+func (c *slowConsumer) send(m sizedMessage) error {
+	<-c.release
+
+	c.Lock()
+	defer c.Unlock()
+	c.received = append(c.received, m)
+
+	return nil
+}
+
+func (c *slowConsumer) count() int {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.received)
+}
+
+func setStreamBufferConfig(size, total uint32, policy string) func() {
+	savedSize, savedTotal, savedPolicy := streamBufferSize, streamBufferTotal, streamBufferPolicy
+	streamBufferSize, streamBufferTotal, streamBufferPolicy = size, total, policy
+
+	return func() {
+		streamBufferSize, streamBufferTotal, streamBufferPolicy = savedSize, savedTotal, savedPolicy
+	}
+}
+
+func streamBufferUsage(b *streamBuffer) (int, int) {
+	streamBuffersLock.Lock()
+	defer streamBuffersLock.Unlock()
+	return b.used, streamBuffersUsed
+}
+
+func TestStreamBufferBlock(t *testing.T) {
+	assert := assert.New(t)
+
+	defer setStreamBufferConfig(100, 1000, streamBufferPolicyBlock)()
+
+	c := newSlowConsumer()
+	b := newStreamBuffer(c.send)
+
+	pushed := make(chan int, 10)
+	go func() {
+		for i := 0; i < 10; i++ {
+			assert.NoError(b.push(testSizedMessage(30)))
+			pushed <- i
+		}
+		close(pushed)
+	}()
+
+	// Only 3 messages fit in the buffer, the producer is blocked.
+	for i := 0; i < 3; i++ {
+		<-pushed
+	}
+	select {
+	case <-pushed:
+		t.Fatal("producer not blocked by a full buffer")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	used, total := streamBufferUsage(b)
+	assert.Equal(90, used)
+	assert.Equal(90, total)
+	assert.Equal(int64(90), streamBufferedBytes.get())
+
+	for i := 0; i < 10; i++ {
+		c.release <- struct{}{}
+
+		used, _ := streamBufferUsage(b)
+		assert.True(used <= 100, "buffer holding %d bytes", used)
+	}
+
+	for range pushed {
+	}
+
+	assert.NoError(b.close())
+	assert.Equal(10, c.count())
+
+	used, total = streamBufferUsage(b)
+	assert.Zero(used)
+	assert.Zero(total)
+	assert.Zero(streamBufferedBytes.get())
+}
+
+func TestStreamBufferDrop(t *testing.T) {
+	assert := assert.New(t)
+
+	defer setStreamBufferConfig(100, 1000, streamBufferPolicyDrop)()
+
+	c := newSlowConsumer()
+	b := newStreamBuffer(c.send)
+
+	dropped := streamDroppedMessages.get()
+
+	// The producer is never blocked.
+	for i := 0; i < 10; i++ {
+		assert.NoError(b.push(testSizedMessage(30)))
+	}
+
+	used, _ := streamBufferUsage(b)
+	assert.Equal(90, used)
+	assert.Equal(dropped+7, streamDroppedMessages.get())
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			c.release <- struct{}{}
+		}
+	}()
+
+	assert.NoError(b.close())
+	assert.Equal(3, c.count())
+}
+
+func TestStreamBufferGlobalLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	defer setStreamBufferConfig(100, 50, streamBufferPolicyDrop)()
+
+	c1 := newSlowConsumer()
+	b1 := newStreamBuffer(c1.send)
+	c2 := newSlowConsumer()
+	b2 := newStreamBuffer(c2.send)
+
+	dropped := streamDroppedMessages.get()
+
+	assert.NoError(b1.push(testSizedMessage(30)))
+	// Fits in the buffer but not in the global budget.
+	assert.NoError(b2.push(testSizedMessage(30)))
+	assert.NoError(b2.push(testSizedMessage(20)))
+
+	assert.Equal(dropped+1, streamDroppedMessages.get())
+
+	_, total := streamBufferUsage(b1)
+	assert.Equal(50, total)
+
+	go func() {
+		c1.release <- struct{}{}
+		c2.release <- struct{}{}
+	}()
+
+	assert.NoError(b1.close())
+	assert.NoError(b2.close())
+	assert.Equal([]sizedMessage{testSizedMessage(30)}, c1.received)
+	assert.Equal([]sizedMessage{testSizedMessage(20)}, c2.received)
+}
+
+func TestStreamBufferSendError(t *testing.T) {
+	assert := assert.New(t)
+
+	defer setStreamBufferConfig(100, 1000, streamBufferPolicyBlock)()
+
+	sendErr := errors.New("connection closed")
+	b := newStreamBuffer(func(m sizedMessage) error {
+		return sendErr
+	})
+
+	assert.NoError(b.push(testSizedMessage(10)))
+
+	// Pushing fails once the sending routine stopped.
+	var err error
+	for i := 0; i < 100 && err == nil; i++ {
+		err = b.push(testSizedMessage(10))
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(sendErr, err)
+
+	assert.Equal(sendErr, b.close())
+
+	_, total := streamBufferUsage(b)
+	assert.Zero(total)
+}
+
+func TestStreamBufferOversizedMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	defer setStreamBufferConfig(100, 1000, streamBufferPolicyBlock)()
+
+	c := newSlowConsumer()
+	b := newStreamBuffer(c.send)
+
+	// Accepted since the buffer is empty.
+	assert.NoError(b.push(testSizedMessage(200)))
+
+	go func() {
+		c.release <- struct{}{}
+	}()
+
+	assert.NoError(b.close())
+	assert.Equal(1, c.count())
+}
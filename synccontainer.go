@@ -0,0 +1,98 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// syncfs is a variable so that tests can check the paths being synced.
+var syncfs = syncfsImpl
+
+// syncfsImpl flushes the filesystem holding path to its backing storage.
+// Sockets, devices and fifos cannot be opened to be synced, or opening them
+// has side effects, so the filesystem of their parent directory is synced
+// instead.
+func syncfsImpl(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() && !info.Mode().IsRegular() {
+		path = filepath.Dir(path)
+	}
+
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return unix.Syncfs(int(f.Fd()))
+}
+
+// syncMount is a writable mount of a container, which source lives on the
+// filesystem to sync.
+type syncMount struct {
+	destination string
+	source      string
+}
+
+// writableMounts returns the writable mounts of a container backed by a
+// filesystem of the guest, the rootfs first. Virtual filesystems such as
+// proc, sysfs or tmpfs have nothing to sync and are skipped.
+func writableMounts(config configs.Config) []syncMount {
+	var mounts []syncMount
+
+	if config.Rootfs != "" && !config.Readonlyfs {
+		mounts = append(mounts, syncMount{"/", config.Rootfs})
+	}
+
+	for _, m := range config.Mounts {
+		if m.Device != "bind" && m.Flags&syscall.MS_BIND == 0 {
+			continue
+		}
+
+		if m.Flags&syscall.MS_RDONLY != 0 {
+			continue
+		}
+
+		mounts = append(mounts, syncMount{m.Destination, m.Source})
+	}
+
+	return mounts
+}
+
+// syncContainer syncs the filesystems backing the writable mounts of ctr,
+// returning the destinations of the mounts synced.
+func syncContainer(ctr *container) ([]string, error) {
+	synced := []string{}
+
+	for _, m := range writableMounts(ctr.config) {
+		if err := syncfs(m.source); err != nil {
+			return nil, grpcStatus.Errorf(codes.Internal, "Could not sync mount %s of container %s: %v", m.destination, ctr.id, err)
+		}
+
+		synced = append(synced, m.destination)
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"container": ctr.id,
+		"mounts":    synced,
+	}).Debug("Container synced")
+
+	return synced, nil
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestSyncContainer(t *testing.T) {
+	assert := assert.New(t)
+
+	var synced []string
+	savedSyncfs := syncfs
+	syncfs = func(path string) error {
+		if path == "/broken" {
+			return errors.New("I/O error")
+		}
+		synced = append(synced, path)
+		return nil
+	}
+	defer func() {
+		syncfs = savedSyncfs
+	}()
+
+	mounts := []*configs.Mount{
+		{Source: "proc", Destination: "/proc", Device: "proc"},
+		{Source: "tmpfs", Destination: "/dev", Device: "tmpfs"},
+		{Source: "/run/kata-containers/shared/data", Destination: "/data", Device: "bind", Flags: syscall.MS_BIND | syscall.MS_REC},
+		{Source: "/run/kata-containers/shared/config", Destination: "/config", Device: "bind", Flags: syscall.MS_BIND | syscall.MS_RDONLY},
+		{Source: "/run/kata-containers/shared/hosts", Destination: "/etc/hosts", Flags: syscall.MS_BIND},
+	}
+
+	type testData struct {
+		config          configs.Config
+		expectedCode    codes.Code
+		expectedMounts  []string
+		expectedSources []string
+	}
+
+	data := []testData{
+		{configs.Config{}, codes.OK, []string{}, nil},
+		{configs.Config{Rootfs: "/rootfs"}, codes.OK, []string{"/"}, []string{"/rootfs"}},
+		{configs.Config{Rootfs: "/rootfs", Readonlyfs: true}, codes.OK, []string{}, nil},
+		{configs.Config{Rootfs: "/rootfs", Mounts: mounts}, codes.OK,
+			[]string{"/", "/data", "/etc/hosts"},
+			[]string{"/rootfs", "/run/kata-containers/shared/data", "/run/kata-containers/shared/hosts"}},
+		{configs.Config{Rootfs: "/rootfs", Readonlyfs: true, Mounts: mounts}, codes.OK,
+			[]string{"/data", "/etc/hosts"},
+			[]string{"/run/kata-containers/shared/data", "/run/kata-containers/shared/hosts"}},
+		{configs.Config{Rootfs: "/broken"}, codes.Internal, nil, nil},
+	}
+
+	for i, d := range data {
+		synced = nil
+
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{
+					"ctr": {id: "ctr", config: d.config},
+				},
+			},
+		}
+
+		resp, err := a.SyncContainer(context.Background(), &pb.SyncContainerRequest{ContainerId: "ctr"})
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.Equal(d.expectedSources, synced, "test %d (%+v)", i, d)
+		if d.expectedCode != codes.OK {
+			continue
+		}
+
+		assert.Equal(d.expectedMounts, resp.Mounts, "test %d (%+v)", i, d)
+	}
+
+	a := &agentGRPC{sandbox: &sandbox{containers: make(map[string]*container)}}
+	_, err := a.SyncContainer(context.Background(), &pb.SyncContainerRequest{ContainerId: "missing"})
+	assert.Error(err)
+}
+
+func TestSyncfs(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "syncfs")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	err = syncfsImpl(tmpDir)
+	assert.NoError(err)
+
+	err = syncfsImpl(tmpDir + "/missing")
+	assert.True(os.IsNotExist(err))
+
+	// The filesystem of the socket is synced, the socket not being opened.
+	socketPath := filepath.Join(tmpDir, "socket")
+	l, err := net.Listen("unix", socketPath)
+	assert.NoError(err)
+	defer l.Close()
+
+	err = syncfsImpl(socketPath)
+	assert.NoError(err)
+
+	err = syncfsImpl("/dev/null")
+	assert.NoError(err)
+
+	// A container bind mounting the socket can be synced.
+	ctr := &container{
+		id: "ctr",
+		config: configs.Config{
+			Mounts: []*configs.Mount{
+				{Source: socketPath, Destination: "/run/socket", Device: "bind", Flags: syscall.MS_BIND},
+			},
+		},
+	}
+
+	synced, err := syncContainer(ctr)
+	assert.NoError(err)
+	assert.Equal([]string{"/run/socket"}, synced)
+}
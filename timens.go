@@ -0,0 +1,163 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"sort"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	nsTypeTime nsType = "time"
+
+	// CLONE_NEWTIME is not defined by the vendored unix package.
+	cloneNewTime = 0x80
+
+	timensOffsetsMode = 0644
+	nanosecsPerSec    = 1000000000
+)
+
+// Clocks which can be shifted in a time namespace.
+var timeNamespaceClocks = map[string]bool{
+	"monotonic": true,
+	"boottime":  true,
+}
+
+// set function in variable to overwrite for testing.
+var runInNewTimeNamespace = runInNewTimeNamespaceImpl
+
+// extractTimeNamespace reports whether a new time namespace is requested by
+// spec, and removes it from the namespaces list since libcontainer does not
+// know about it.
+func extractTimeNamespace(spec *pb.Spec) (bool, error) {
+	if spec == nil || spec.Linux == nil {
+		return false, nil
+	}
+
+	requested := false
+	namespaces := spec.Linux.Namespaces[:0]
+
+	for _, ns := range spec.Linux.Namespaces {
+		if ns.Type != string(nsTypeTime) {
+			namespaces = append(namespaces, ns)
+			continue
+		}
+
+		if ns.Path != "" {
+			return false, grpcStatus.Errorf(codes.InvalidArgument,
+				"Joining the time namespace %s is not supported", ns.Path)
+		}
+
+		requested = true
+	}
+
+	spec.Linux.Namespaces = namespaces
+
+	if !requested && len(spec.Linux.TimeOffsets) > 0 {
+		return false, grpcStatus.Error(codes.InvalidArgument,
+			"Time offsets require a time namespace")
+	}
+
+	if _, err := formatTimeOffsets(spec.Linux.TimeOffsets); err != nil {
+		return false, err
+	}
+
+	return requested, nil
+}
+
+// formatTimeOffsets returns the offsets as expected by the timens_offsets
+// file, one "<clock> <secs> <nanosecs>" line per clock.
+func formatTimeOffsets(offsets map[string]*pb.LinuxTimeOffset) (string, error) {
+	var clocks []string
+	for clock := range offsets {
+		if !timeNamespaceClocks[clock] {
+			return "", grpcStatus.Errorf(codes.InvalidArgument, "Invalid time namespace clock %q", clock)
+		}
+		clocks = append(clocks, clock)
+	}
+	sort.Strings(clocks)
+
+	var lines []string
+	for _, clock := range clocks {
+		offset := offsets[clock]
+		if offset == nil {
+			continue
+		}
+
+		if offset.Nanosecs >= nanosecsPerSec {
+			return "", grpcStatus.Errorf(codes.InvalidArgument,
+				"Invalid nanoseconds offset %d for clock %q", offset.Nanosecs, clock)
+		}
+
+		lines = append(lines, fmt.Sprintf("%s %d %d\n", clock, offset.Secs, offset.Nanosecs))
+	}
+
+	return strings.Join(lines, ""), nil
+}
+
+// writeTimeOffsets writes offsets to the timens_offsets file found at path.
+func writeTimeOffsets(path string, offsets map[string]*pb.LinuxTimeOffset) error {
+	content, err := formatTimeOffsets(offsets)
+	if err != nil {
+		return err
+	}
+
+	if content == "" {
+		return nil
+	}
+
+	return ioutil.WriteFile(path, []byte(content), timensOffsetsMode)
+}
+
+// runInNewTimeNamespaceImpl runs fn from a thread whose children are placed
+// in a new time namespace with the given clock offsets. The offsets can only
+// be written before any process enters the namespace, so fn is expected to
+// start the container init.
+//
+// The kernel does not allow a multithreaded process to switch back to its
+// original time namespace, hence the thread is never unlocked and gets
+// terminated once fn returns.
+func runInNewTimeNamespaceImpl(offsets map[string]*pb.LinuxTimeOffset, fn func() error) error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		runtime.LockOSThread()
+
+		if err := unix.Unshare(cloneNewTime); err != nil {
+			errCh <- fmt.Errorf("failed to create time namespace: %v", err)
+			return
+		}
+
+		path := fmt.Sprintf("/proc/%d/timens_offsets", unix.Gettid())
+		if err := writeTimeOffsets(path, offsets); err != nil {
+			errCh <- fmt.Errorf("failed to set time namespace offsets: %v", err)
+			return
+		}
+
+		errCh <- fn()
+	}()
+
+	return <-errCh
+}
+
+// startInTimeNamespace runs fn, which starts the container init, inside a
+// new time namespace if the container requested one.
+func startInTimeNamespace(ctr *container, spec *pb.Spec, fn func() error) error {
+	if !ctr.timeNs {
+		return fn()
+	}
+
+	return runInNewTimeNamespace(spec.Linux.TimeOffsets, fn)
+}
@@ -0,0 +1,207 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestWriteTimeOffsets(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "timens")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	type testData struct {
+		offsets         map[string]*pb.LinuxTimeOffset
+		expectedContent string
+		expectError     bool
+	}
+
+	data := []testData{
+		{
+			map[string]*pb.LinuxTimeOffset{
+				"monotonic": {Secs: 3600, Nanosecs: 500},
+			},
+			"monotonic 3600 500\n",
+			false,
+		},
+		{
+			map[string]*pb.LinuxTimeOffset{
+				"monotonic": {Secs: -10},
+				"boottime":  {Secs: 86400, Nanosecs: 999999999},
+			},
+			"boottime 86400 999999999\nmonotonic -10 0\n",
+			false,
+		},
+		{
+			map[string]*pb.LinuxTimeOffset{
+				"realtime": {Secs: 1},
+			},
+			"",
+			true,
+		},
+		{
+			map[string]*pb.LinuxTimeOffset{
+				"boottime": {Nanosecs: 1000000000},
+			},
+			"",
+			true,
+		},
+	}
+
+	for i, d := range data {
+		path := filepath.Join(tmpDir, "timens_offsets")
+		os.Remove(path)
+
+		err := writeTimeOffsets(path, d.offsets)
+		if d.expectError {
+			assert.Error(err, "test %d", i)
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d", i)
+			continue
+		}
+		assert.NoError(err, "test %d", i)
+
+		content, err := ioutil.ReadFile(path)
+		assert.NoError(err, "test %d", i)
+		assert.Equal(d.expectedContent, string(content), "test %d", i)
+	}
+
+	// Nothing is written without offsets.
+	path := filepath.Join(tmpDir, "empty")
+	err = writeTimeOffsets(path, nil)
+	assert.NoError(err)
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err))
+}
+
+func TestExtractTimeNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	offsets := map[string]*pb.LinuxTimeOffset{
+		"monotonic": {Secs: 1},
+	}
+
+	spec := &pb.Spec{
+		Linux: &pb.Linux{
+			Namespaces: []pb.LinuxNamespace{
+				{Type: "pid"},
+				{Type: string(nsTypeTime)},
+				{Type: "mount"},
+			},
+			TimeOffsets: offsets,
+		},
+	}
+
+	requested, err := extractTimeNamespace(spec)
+	assert.NoError(err)
+	assert.True(requested)
+	assert.Equal([]pb.LinuxNamespace{{Type: "pid"}, {Type: "mount"}}, spec.Linux.Namespaces)
+
+	// The namespace has been removed, only the offsets are left.
+	_, err = extractTimeNamespace(spec)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	spec.Linux.TimeOffsets = nil
+	requested, err = extractTimeNamespace(spec)
+	assert.NoError(err)
+	assert.False(requested)
+
+	spec.Linux.Namespaces = append(spec.Linux.Namespaces, pb.LinuxNamespace{Type: string(nsTypeTime), Path: "/proc/1/ns/time"})
+	_, err = extractTimeNamespace(spec)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	requested, err = extractTimeNamespace(&pb.Spec{})
+	assert.NoError(err)
+	assert.False(requested)
+}
+
+func TestStartInTimeNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	var calledOffsets map[string]*pb.LinuxTimeOffset
+	created := 0
+
+	savedFunc := runInNewTimeNamespace
+	runInNewTimeNamespace = func(offsets map[string]*pb.LinuxTimeOffset, fn func() error) error {
+		created++
+		calledOffsets = offsets
+		return fn()
+	}
+	defer func() {
+		runInNewTimeNamespace = savedFunc
+	}()
+
+	spec := &pb.Spec{
+		Linux: &pb.Linux{
+			TimeOffsets: map[string]*pb.LinuxTimeOffset{
+				"boottime": {Secs: 42},
+			},
+		},
+	}
+
+	started := 0
+	start := func() error {
+		started++
+		return nil
+	}
+
+	err := startInTimeNamespace(&container{}, spec, start)
+	assert.NoError(err)
+	assert.Equal(1, started)
+	assert.Equal(0, created)
+
+	err = startInTimeNamespace(&container{timeNs: true}, spec, start)
+	assert.NoError(err)
+	assert.Equal(2, started)
+	assert.Equal(1, created)
+	assert.Equal(spec.Linux.TimeOffsets, calledOffsets)
+
+	startErr := errors.New("start failed")
+	err = startInTimeNamespace(&container{timeNs: true}, spec, func() error {
+		return startErr
+	})
+	assert.Equal(startErr, err)
+}
+
+func TestExecProcessTimeNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				"ctr": {
+					id:        "ctr",
+					container: &mockContainer{id: "ctr", status: libcontainer.Running},
+					timeNs:    true,
+				},
+			},
+			running: true,
+		},
+	}
+
+	req := &pb.ExecProcessRequest{
+		ContainerId: "ctr",
+		ExecId:      "exec",
+		Process:     &pb.Process{Args: []string{"date"}},
+	}
+
+	_, err := a.ExecProcess(context.Background(), req)
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+}
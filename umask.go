@@ -0,0 +1,44 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Umask of the container init process when the spec does not specify one,
+// matching the one set by libcontainer.
+const defaultContainerUmask = 0022
+
+// containerUmask returns the umask of the init process of the container
+// created from spec.
+func containerUmask(spec *pb.Spec) (uint32, error) {
+	if spec == nil {
+		return initUmask(nil)
+	}
+
+	return initUmask(spec.Process)
+}
+
+// initUmask returns the umask of the container init process run as process.
+// libcontainer sets the default umask of the init process, any other being
+// set by the agent binary the process is then executed through, see
+// execProcess().
+func initUmask(process *pb.Process) (uint32, error) {
+	if process == nil || process.User.Umask == nil {
+		return containerDefaultUmask, nil
+	}
+
+	umask := process.User.Umask.Value
+	if umask&^0777 != 0 {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid umask %#o", umask)
+	}
+
+	return umask, nil
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestContainerUmask(t *testing.T) {
+	assert := assert.New(t)
+
+	savedUmask := containerDefaultUmask
+	containerDefaultUmask = 0027
+	defer func() {
+		containerDefaultUmask = savedUmask
+	}()
+
+	withUmask := func(umask uint32) *pb.Spec {
+		return &pb.Spec{
+			Process: &pb.Process{
+				User: pb.User{Umask: &types.UInt32Value{Value: umask}},
+			},
+		}
+	}
+
+	type testData struct {
+		spec          *pb.Spec
+		shouldErr     bool
+		expectedUmask uint32
+	}
+
+	data := []testData{
+		{nil, false, 0027},
+		{&pb.Spec{}, false, 0027},
+		{&pb.Spec{Process: &pb.Process{}}, false, 0027},
+		{withUmask(0077), false, 0077},
+		{withUmask(0), false, 0},
+		{withUmask(0777), false, 0777},
+		{withUmask(01000), true, 0},
+	}
+
+	for i, d := range data {
+		umask, err := containerUmask(d.spec)
+		if d.shouldErr {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedUmask, umask, "test %d (%+v)", i, d)
+	}
+}
+
+func TestBuildProcessUmask(t *testing.T) {
+	assert := assert.New(t)
+
+	savedUmask := containerDefaultUmask
+	defer func() {
+		containerDefaultUmask = savedUmask
+	}()
+
+	type testData struct {
+		defaultUmask uint32
+		umask        *types.UInt32Value
+		init         bool
+		shouldErr    bool
+		expectedArgs []string
+	}
+
+	args := []string{"sh", "-c", "umask"}
+	execArgs := func(umask string) []string {
+		return append([]string{execProcessPath, execProcessArg, umask, ""}, args...)
+	}
+
+	data := []testData{
+		// libcontainer sets the default umask itself.
+		{defaultContainerUmask, nil, true, false, args},
+		{defaultContainerUmask, &types.UInt32Value{Value: defaultContainerUmask}, true, false, args},
+		{defaultContainerUmask, &types.UInt32Value{Value: 0077}, true, false, execArgs("77")},
+		{0027, nil, true, false, execArgs("27")},
+		{0027, &types.UInt32Value{Value: 0}, true, false, execArgs("0")},
+		{defaultContainerUmask, &types.UInt32Value{Value: 01000}, true, true, nil},
+		// The umask only applies to the init process.
+		{0027, &types.UInt32Value{Value: 0077}, false, false, args},
+	}
+
+	for i, d := range data {
+		containerDefaultUmask = d.defaultUmask
+
+		proc, err := buildProcess(&pb.Process{
+			Args: args,
+			User: pb.User{Umask: d.umask},
+		}, "ctr", d.init)
+		if d.shouldErr {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedArgs, proc.process.Args, "test %d (%+v)", i, d)
+	}
+}
+
+func TestContainerInitUmask(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	for i, umask := range []uint32{defaultContainerUmask, 0077} {
+		c, cleanup := createTestContainer(t, fmt.Sprintf("test-umask-%d", i))
+
+		initProc, err := buildProcess(&pb.Process{
+			Args: []string{"sh", "-c", "umask"},
+			Env:  []string{"PATH=/bin"},
+			Cwd:  "/",
+			User: pb.User{Umask: &types.UInt32Value{Value: umask}},
+		}, "init", true)
+		assert.NoError(err)
+
+		output := runTestProcess(t, c, initProc)
+		assert.Equal(fmt.Sprintf("%04o\n", umask), output, "test %d (%#o)", i, umask)
+
+		cleanup()
+	}
+}
@@ -0,0 +1,109 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"strconv"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/user"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// lookupUserName returns the name of the user uid in the /etc/passwd file of
+// rootfs, or an empty string if it has no entry.
+func lookupUserName(rootfs string, uid uint32) (string, error) {
+	path, err := securejoin.SecureJoin(rootfs, "/etc/passwd")
+	if err != nil {
+		return "", err
+	}
+
+	users, err := user.ParsePasswdFileFilter(path, func(u user.User) bool {
+		return u.Uid == int(uid)
+	})
+	if os.IsNotExist(err) || len(users) == 0 {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return users[0].Name, nil
+}
+
+// userGroups returns the groups listing the user u as a member in the
+// /etc/group file of rootfs, u being named after its /etc/passwd entry.
+func userGroups(rootfs string, u pb.User) ([]string, error) {
+	name, err := lookupUserName(rootfs, u.UID)
+	if err != nil || name == "" {
+		return nil, err
+	}
+
+	path, err := securejoin.SecureJoin(rootfs, "/etc/group")
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := user.ParseGroupFileFilter(path, func(g user.Group) bool {
+		for _, member := range g.List {
+			if member == name {
+				return true
+			}
+		}
+		return false
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	gids := make([]string, 0, len(groups))
+	for _, g := range groups {
+		gids = append(gids, strconv.Itoa(g.Gid))
+	}
+
+	return gids, nil
+}
+
+// addUserGroups adds the groups of the user of proc found in the container
+// /etc/group file to its additional groups, when resolveUserGroups is set.
+// libcontainer does it already for a user specified by name.
+func (c *container) addUserGroups(proc *process, u pb.User) error {
+	if !resolveUserGroups || u.Username != "" {
+		return nil
+	}
+
+	gids, err := userGroups(c.config.Rootfs, u)
+	if err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not resolve groups of user %d in container %s: %v", u.UID, c.id, err)
+	}
+
+	known := make(map[string]bool)
+	for _, gid := range proc.process.AdditionalGroups {
+		known[gid] = true
+	}
+
+	for _, gid := range gids {
+		if !known[gid] {
+			known[gid] = true
+			proc.process.AdditionalGroups = append(proc.process.AdditionalGroups, gid)
+		}
+	}
+
+	if len(gids) > 0 {
+		agentLog.WithFields(logrus.Fields{
+			"container": c.id,
+			"groups":    proc.process.AdditionalGroups,
+		}).Debug("Resolved user groups")
+	}
+
+	return nil
+}
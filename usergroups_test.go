@@ -0,0 +1,188 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testEtcPasswd = `root:x:0:0:root:/root:/bin/sh
+alice:x:1000:1000:Alice:/home/alice:/bin/sh
+bob:x:1001:1001:Bob:/home/bob:/bin/sh
+`
+	testEtcGroup = `root:x:0:
+alice:x:1000:
+bob:x:1001:
+audio:x:2000:alice,bob
+video:x:2001:bob,alice
+users:x:2002:bob
+`
+)
+
+// writeEtcUsers writes the test /etc/passwd and /etc/group files in rootfs.
+func writeEtcUsers(t *testing.T, rootfs string) {
+	etc := filepath.Join(rootfs, "etc")
+	err := os.MkdirAll(etc, 0755)
+	assert.NoError(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(etc, "passwd"), []byte(testEtcPasswd), 0644)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(etc, "group"), []byte(testEtcGroup), 0644)
+	assert.NoError(t, err)
+}
+
+func TestUserGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	rootfs, err := ioutil.TempDir("", "rootfs")
+	assert.NoError(err)
+	defer os.RemoveAll(rootfs)
+
+	// No group is found without the files.
+	gids, err := userGroups(rootfs, pb.User{UID: 1000})
+	assert.NoError(err)
+	assert.Empty(gids)
+
+	writeEtcUsers(t, rootfs)
+
+	type testData struct {
+		uid          uint32
+		expectedGids []string
+	}
+
+	data := []testData{
+		{0, []string{}},
+		{1000, []string{"2000", "2001"}},
+		{1001, []string{"2000", "2001", "2002"}},
+		// The user has no passwd entry.
+		{1002, nil},
+	}
+
+	for i, d := range data {
+		gids, err := userGroups(rootfs, pb.User{UID: d.uid})
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedGids, gids, "test %d (%+v)", i, d)
+	}
+
+	err = os.Remove(filepath.Join(rootfs, "etc", "group"))
+	assert.NoError(err)
+
+	gids, err = userGroups(rootfs, pb.User{UID: 1000})
+	assert.NoError(err)
+	assert.Empty(gids)
+}
+
+func TestAddUserGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	rootfs, err := ioutil.TempDir("", "rootfs")
+	assert.NoError(err)
+	defer os.RemoveAll(rootfs)
+
+	writeEtcUsers(t, rootfs)
+
+	ctr := &container{
+		id:     "ctr",
+		config: configs.Config{Rootfs: rootfs},
+	}
+
+	defer func() {
+		resolveUserGroups = false
+	}()
+
+	type testData struct {
+		resolve        bool
+		user           pb.User
+		expectedGroups []string
+	}
+
+	data := []testData{
+		{false, pb.User{UID: 1000, AdditionalGids: []uint32{3000}}, []string{"3000"}},
+		{true, pb.User{UID: 1000, AdditionalGids: []uint32{3000}}, []string{"3000", "2000", "2001"}},
+		// The groups are only added once.
+		{true, pb.User{UID: 1000, AdditionalGids: []uint32{2001}}, []string{"2001", "2000"}},
+		// libcontainer resolves the groups of a named user.
+		{true, pb.User{Username: "alice"}, []string{}},
+		{true, pb.User{UID: 1002}, []string{}},
+	}
+
+	for i, d := range data {
+		resolveUserGroups = d.resolve
+
+		proc, err := buildProcess(&pb.Process{User: d.user}, "exec", false)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		err = ctr.addUserGroups(proc, d.user)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedGroups, proc.process.AdditionalGroups, "test %d (%+v)", i, d)
+
+		proc.closePostStartFDs()
+		proc.closePostExitFDs()
+	}
+}
+
+func TestUserGroupsExec(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	c, cleanup := createTestContainerWithConfig(t, "test-user-groups", func(config *configs.Config) {
+		writeEtcUsers(t, config.Rootfs)
+	})
+	defer cleanup()
+
+	ctr := &container{id: "ctr", config: c.Config()}
+
+	defer func() {
+		resolveUserGroups = false
+	}()
+
+	type testData struct {
+		resolve bool
+		user    pb.User
+		// supplementary groups of the process
+		expectedGids string
+	}
+
+	data := []testData{
+		{false, pb.User{UID: 1000, GID: 1000}, ""},
+		{false, pb.User{UID: 1000, GID: 1000, AdditionalGids: []uint32{3000, 3001}}, "3000 3001"},
+		{true, pb.User{UID: 1001, GID: 1001, AdditionalGids: []uint32{3000}}, "2000 2001 2002 3000"},
+		{true, pb.User{UID: 1002, GID: 1002}, ""},
+	}
+
+	for i, d := range data {
+		resolveUserGroups = d.resolve
+
+		proc, err := buildProcess(&pb.Process{
+			Args: []string{"sh", "-c", "grep '^Groups:' /proc/self/status"},
+			Env:  []string{"PATH=/bin"},
+			Cwd:  "/",
+			User: d.user,
+		}, "exec", false)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		err = ctr.addUserGroups(proc, d.user)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		// Groups:	2000 2001
+		output := strings.TrimSpace(strings.TrimPrefix(runTestProcess(t, c, proc), "Groups:"))
+		gids := strings.Fields(output)
+		sort.Strings(gids)
+		assert.Equal(d.expectedGids, strings.Join(gids, " "), "test %d (%+v)", i, d)
+	}
+}
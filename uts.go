@@ -0,0 +1,84 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	etcHostnamePath = "/etc/hostname"
+	etcHostnameMode = 0644
+)
+
+// set function in variable to overwrite for testing.
+var setContainerDomainname = setContainerDomainnameImpl
+
+// setContainerDomainnameImpl sets the domainname from the UTS namespace of
+// the process pid. libcontainer takes care of the hostname, but has no
+// support for the domainname.
+func setContainerDomainnameImpl(pid int, domainname string) error {
+	nsPath := fmt.Sprintf("/proc/%d/ns/%s", pid, nsTypeUTS)
+
+	return runInNamespace(nsPath, nsTypeUTS, func() error {
+		return unix.Setdomainname([]byte(domainname))
+	})
+}
+
+// setupContainerDomainname applies the domainname of the spec to the UTS
+// namespace of the container init process pid.
+func setupContainerDomainname(pid int, domainname string) error {
+	if domainname == "" {
+		return nil
+	}
+
+	if err := setContainerDomainname(pid, domainname); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not set domainname %q: %v", domainname, err)
+	}
+
+	return nil
+}
+
+// writeEtcHostname writes the container hostname to /etc/hostname in the
+// container rootfs, unless the runtime provides this file through a mount.
+func writeEtcHostname(config *configs.Config) error {
+	if config.Hostname == "" {
+		return nil
+	}
+
+	for _, m := range config.Mounts {
+		if filepath.Clean(m.Destination) == etcHostnamePath {
+			return nil
+		}
+	}
+
+	etcDir, err := securejoin.SecureJoin(config.Rootfs, filepath.Dir(etcHostnamePath))
+	if err != nil {
+		return err
+	}
+
+	// Do not create /etc in a rootfs which does not have one.
+	if info, err := os.Stat(etcDir); err != nil || !info.IsDir() {
+		return nil
+	}
+
+	hostnamePath, err := securejoin.SecureJoin(config.Rootfs, etcHostnamePath)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(hostnamePath, []byte(config.Hostname+"\n"), etcHostnameMode)
+}
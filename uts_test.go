@@ -0,0 +1,171 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func TestWriteEtcHostname(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "rootfs")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	rootfs := filepath.Join(tmpDir, "rootfs")
+	hostnameFile := filepath.Join(rootfs, "etc", "hostname")
+
+	// No /etc directory in the rootfs
+	assert.NoError(os.MkdirAll(rootfs, 0755))
+	err = writeEtcHostname(&configs.Config{Rootfs: rootfs, Hostname: "foo"})
+	assert.NoError(err)
+	_, err = os.Stat(filepath.Dir(hostnameFile))
+	assert.True(os.IsNotExist(err))
+
+	assert.NoError(os.MkdirAll(filepath.Dir(hostnameFile), 0755))
+
+	// No hostname
+	err = writeEtcHostname(&configs.Config{Rootfs: rootfs})
+	assert.NoError(err)
+	_, err = os.Stat(hostnameFile)
+	assert.True(os.IsNotExist(err))
+
+	// /etc/hostname provided by the runtime
+	err = writeEtcHostname(&configs.Config{
+		Rootfs:   rootfs,
+		Hostname: "foo",
+		Mounts: []*configs.Mount{
+			{Destination: "/etc/hostname/"},
+		},
+	})
+	assert.NoError(err)
+	_, err = os.Stat(hostnameFile)
+	assert.True(os.IsNotExist(err))
+
+	err = writeEtcHostname(&configs.Config{Rootfs: rootfs, Hostname: "foo"})
+	assert.NoError(err)
+	content, err := ioutil.ReadFile(hostnameFile)
+	assert.NoError(err)
+	assert.Equal("foo\n", string(content))
+
+	// A symlink cannot be used to escape the rootfs
+	outside := filepath.Join(tmpDir, "outside")
+	assert.NoError(os.Remove(hostnameFile))
+	assert.NoError(os.Symlink("../../outside", hostnameFile))
+
+	err = writeEtcHostname(&configs.Config{Rootfs: rootfs, Hostname: "bar"})
+	assert.NoError(err)
+	_, err = os.Stat(outside)
+	assert.True(os.IsNotExist(err))
+	content, err = ioutil.ReadFile(filepath.Join(rootfs, "outside"))
+	assert.NoError(err)
+	assert.Equal("bar\n", string(content))
+}
+
+func TestSetupContainerDomainname(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSetContainerDomainname := setContainerDomainname
+	defer func() {
+		setContainerDomainname = savedSetContainerDomainname
+	}()
+
+	var calledPid int
+	var calledDomainname string
+	setContainerDomainname = func(pid int, domainname string) error {
+		calledPid = pid
+		calledDomainname = domainname
+		return nil
+	}
+
+	err := setupContainerDomainname(10, "")
+	assert.NoError(err)
+	assert.Equal(0, calledPid)
+
+	err = setupContainerDomainname(10, "example.com")
+	assert.NoError(err)
+	assert.Equal(10, calledPid)
+	assert.Equal("example.com", calledDomainname)
+
+	setContainerDomainname = func(pid int, domainname string) error {
+		return fmt.Errorf("setdomainname failure")
+	}
+
+	err = setupContainerDomainname(10, "example.com")
+	assert.Error(err)
+}
+
+func getDomainname() string {
+	var uts unix.Utsname
+	if err := unix.Uname(&uts); err != nil {
+		return ""
+	}
+
+	name := uts.Domainname[:]
+	if i := bytes.IndexByte(name, 0); i >= 0 {
+		name = name[:i]
+	}
+
+	return string(name)
+}
+
+func TestSetContainerDomainname(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	cmd := exec.Command("sleep", "30")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWUTS,
+	}
+	err := cmd.Start()
+	if err != nil {
+		t.Skipf("Could not create UTS namespace: %v", err)
+	}
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	agentDomainname := getDomainname()
+
+	err = setContainerDomainnameImpl(cmd.Process.Pid, "example.com")
+	assert.NoError(err)
+
+	assert.Equal(agentDomainname, getDomainname())
+
+	var domainname string
+	nsPath := fmt.Sprintf("/proc/%d/ns/uts", cmd.Process.Pid)
+	err = runInNamespace(nsPath, nsTypeUTS, func() error {
+		domainname = getDomainname()
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal("example.com", domainname)
+
+	err = runInNamespace(nsPath, nsTypeUTS, func() error {
+		return fmt.Errorf("failure")
+	})
+	assert.Error(err)
+
+	err = runInNamespace("/proc/0/ns/uts", nsTypeUTS, func() error {
+		return nil
+	})
+	assert.Error(err)
+}
@@ -0,0 +1,206 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+)
+
+var procFilesystems = "/proc/filesystems"
+
+// Format of each slot of a PCI path.
+var pciSlotRegexp = regexp.MustCompile(`^[0-9a-fA-F]{2}$`)
+
+// supportedFilesystems returns the filesystem types registered by the guest
+// kernel.
+func supportedFilesystems() (map[string]bool, error) {
+	f, err := os.Open(procFilesystems)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	filesystems := make(map[string]bool)
+
+	// Each line is a type, optionally prefixed with "nodev".
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 0 {
+			filesystems[fields[len(fields)-1]] = true
+		}
+	}
+
+	return filesystems, scanner.Err()
+}
+
+// storageDevicePresent returns whether the block device identified by devID
+// in sysfs is present, as expected by getDeviceName().
+func storageDevicePresent(devID string) bool {
+	return getBlockDeviceNameFromSysfs(devID) != ""
+}
+
+// checkStorageSource checks the source of storage can be resolved by its
+// driver. It returns a warning if the device is not present yet but may
+// still be hotplugged.
+func checkStorageSource(storage *pb.Storage) (string, error) {
+	notPresent := fmt.Sprintf("device %s not present yet", storage.Source)
+
+	switch storage.Driver {
+	case driverBlkType:
+		if strings.HasPrefix(storage.Source, "/dev") {
+			fi, err := os.Stat(storage.Source)
+			if err != nil {
+				return "", err
+			}
+			if fi.Mode()&os.ModeDevice == 0 {
+				return "", fmt.Errorf("invalid device %s", storage.Source)
+			}
+			return "", nil
+		}
+
+		for _, slot := range strings.Split(storage.Source, "/") {
+			if !pciSlotRegexp.MatchString(slot) {
+				return "", fmt.Errorf("invalid PCI path %q", storage.Source)
+			}
+		}
+
+		sysfsRelPath, err := pciPathToSysfs(PciPath{storage.Source})
+		if err != nil || !storageDevicePresent(sysfsRelPath) {
+			return notPresent, nil
+		}
+	case driverBlkCCWType:
+		if err := checkCCWBusFormat(storage.Source); err != nil {
+			return "", err
+		}
+
+		if !storageDevicePresent(path.Join(storage.Source, blkCCWSuffix)) {
+			return notPresent, nil
+		}
+	case driverSCSIType:
+		tokens := strings.Split(storage.Source, ":")
+		if len(tokens) != 2 {
+			return "", fmt.Errorf("invalid SCSI address %q, expect SCSIID:LUN", storage.Source)
+		}
+		for _, t := range tokens {
+			if _, err := strconv.ParseUint(t, 10, 32); err != nil {
+				return "", fmt.Errorf("invalid SCSI address %q, expect SCSIID:LUN", storage.Source)
+			}
+		}
+
+		if !storageDevicePresent(filepath.Join(scsiHostChannel+storage.Source, scsiBlockSuffix)) {
+			return notPresent, nil
+		}
+	case driverNvdimmType:
+		if !strings.HasPrefix(storage.Source, "/dev") || !strings.HasPrefix(filepath.Base(storage.Source), "pmem") {
+			return "", fmt.Errorf("invalid nvdimm source path: %v", storage.Source)
+		}
+
+		if !storageDevicePresent(filepath.Join("/", scsiBlockSuffix, filepath.Base(storage.Source))) {
+			return notPresent, nil
+		}
+	case driverMmioBlkType:
+		if _, err := os.Stat(storage.Source); err != nil {
+			return notPresent, nil
+		}
+	case driver9pType, driverVirtioFSType:
+		if storage.Source == "" {
+			return "", fmt.Errorf("missing %s mount tag", storage.Driver)
+		}
+	}
+
+	return "", nil
+}
+
+// isBindMount returns whether the options of a storage make it a bind mount,
+// the filesystem type being ignored.
+func isBindMount(options []string) bool {
+	for _, opt := range options {
+		if opt == "bind" || opt == "rbind" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateStorage runs storage through the checks of its driver, without
+// waiting for any device nor mounting anything. filesystems are the types
+// supported by the guest kernel.
+func validateStorage(storage *pb.Storage, filesystems map[string]bool) *pb.StorageValidation {
+	result := &pb.StorageValidation{}
+
+	if storage == nil {
+		result.Errors = append(result.Errors, "missing storage")
+		return result
+	}
+
+	result.MountPoint = storage.MountPoint
+
+	if _, ok := storageHandlerList[storage.Driver]; !ok {
+		result.Errors = append(result.Errors, fmt.Sprintf("unknown storage driver %q", storage.Driver))
+		return result
+	}
+
+	if storage.Driver != driverLocalType && !filepath.IsAbs(storage.MountPoint) {
+		result.Errors = append(result.Errors, fmt.Sprintf("mount point %q must be absolute", storage.MountPoint))
+	}
+
+	if err := validateMountOptions(storage.Options); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	if storage.Driver == driverLocalType {
+		if mode, ok := parseOptions(storage.Options)["mode"]; ok {
+			if _, err := strconv.ParseUint(mode, 8, 32); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("invalid mode %q", mode))
+			}
+		}
+
+		// Nothing gets mounted.
+		return result
+	}
+
+	if !isBindMount(storage.Options) && !filesystems[storage.Fstype] {
+		result.Errors = append(result.Errors, fmt.Sprintf("filesystem type %q not supported by the guest kernel", storage.Fstype))
+	}
+
+	warning, err := checkStorageSource(storage)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+	if warning != "" {
+		result.Warnings = append(result.Warnings, warning)
+	}
+
+	return result
+}
+
+// validateStorages returns the validation results of storages, in the same
+// order.
+func validateStorages(storages []*pb.Storage) ([]*pb.StorageValidation, error) {
+	filesystems, err := supportedFilesystems()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*pb.StorageValidation
+	for _, storage := range storages {
+		results = append(results, validateStorage(storage, filesystems))
+	}
+
+	return results, nil
+}
@@ -0,0 +1,131 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportedFilesystems(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "filesystems")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedProcFilesystems := procFilesystems
+	defer func() {
+		procFilesystems = savedProcFilesystems
+	}()
+
+	procFilesystems = filepath.Join(tmpDir, "filesystems")
+	err = ioutil.WriteFile(procFilesystems, []byte("nodev\tsysfs\nnodev\ttmpfs\n\text4\n\n\txfs\n"), 0644)
+	assert.NoError(err)
+
+	filesystems, err := supportedFilesystems()
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"sysfs": true, "tmpfs": true, "ext4": true, "xfs": true}, filesystems)
+
+	procFilesystems = filepath.Join(tmpDir, "missing")
+	_, err = supportedFilesystems()
+	assert.Error(err)
+}
+
+func TestValidateStorage(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "validate-storage")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	savedProcFilesystems, savedSysBlockPath, savedPciPathToSysfs := procFilesystems, sysBlockPath, pciPathToSysfs
+	defer func() {
+		procFilesystems, sysBlockPath, pciPathToSysfs = savedProcFilesystems, savedSysBlockPath, savedPciPathToSysfs
+	}()
+
+	procFilesystems = filepath.Join(tmpDir, "filesystems")
+	err = ioutil.WriteFile(procFilesystems, []byte("nodev\t9p\nnodev\tvirtiofs\nnodev\ttmpfs\n\text4\n"), 0644)
+	assert.NoError(err)
+
+	sysBlockPath = filepath.Join(tmpDir, "block")
+	err = os.Mkdir(sysBlockPath, 0755)
+	assert.NoError(err)
+
+	// The block device at PCI path 02/03 is present, no other one is.
+	pciPathToSysfs = func(pciPath PciPath) (string, error) {
+		return "0000:00:" + filepath.Base(pciPath.path) + ".0", nil
+	}
+	err = os.Symlink("../../devices/pci0000:00/0000:00:03.0/virtio3/block/vdb", filepath.Join(sysBlockPath, "vdb"))
+	assert.NoError(err)
+
+	type testData struct {
+		storage          *pb.Storage
+		expectedErrors   int
+		expectedWarnings int
+	}
+
+	data := []testData{
+		{nil, 1, 0},
+		{&pb.Storage{Driver: "unknown", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "02/03", Fstype: "ext4", MountPoint: "/mnt"}, 0, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "02/04", Fstype: "ext4", MountPoint: "/mnt"}, 0, 1},
+		{&pb.Storage{Driver: driverBlkType, Source: "02/xyz", Fstype: "ext4", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "/dev/null", Fstype: "ext4", MountPoint: "/mnt"}, 0, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "/dev", Fstype: "ext4", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "/dev/missing", Fstype: "ext4", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "02/03", Fstype: "btrfs", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "02/03", Fstype: "ext4", MountPoint: "mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "02/03", Fstype: "ext4", MountPoint: "/mnt", Options: []string{"ro", "rw"}}, 1, 0},
+		{&pb.Storage{Driver: driverBlkType, Source: "02/xyz", Fstype: "btrfs", MountPoint: "mnt"}, 3, 0},
+		{&pb.Storage{Driver: driverBlkCCWType, Source: "0.0.0005", Fstype: "ext4", MountPoint: "/mnt"}, 0, 1},
+		{&pb.Storage{Driver: driverBlkCCWType, Source: "0.5", Fstype: "ext4", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverSCSIType, Source: "1:0", Fstype: "ext4", MountPoint: "/mnt"}, 0, 1},
+		{&pb.Storage{Driver: driverSCSIType, Source: "1", Fstype: "ext4", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverSCSIType, Source: "1:a", Fstype: "ext4", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverNvdimmType, Source: "/dev/pmem0", Fstype: "ext4", MountPoint: "/mnt"}, 0, 1},
+		{&pb.Storage{Driver: driverNvdimmType, Source: "/dev/vda", Fstype: "ext4", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverMmioBlkType, Source: "/dev/missing", Fstype: "ext4", MountPoint: "/mnt"}, 0, 1},
+		{&pb.Storage{Driver: driver9pType, Source: "shared", Fstype: "9p", MountPoint: "/mnt"}, 0, 0},
+		{&pb.Storage{Driver: driver9pType, Fstype: "9p", MountPoint: "/mnt"}, 1, 0},
+		{&pb.Storage{Driver: driverVirtioFSType, Source: "shared", Fstype: "virtiofs", MountPoint: "/mnt"}, 0, 0},
+		{&pb.Storage{Driver: driverEphemeralType, Source: "tmpfs", Fstype: "tmpfs", MountPoint: "/mnt"}, 0, 0},
+		{&pb.Storage{Driver: driverEphemeralType, Source: "/src", Fstype: "unknown", MountPoint: "/mnt", Options: []string{"bind"}}, 0, 0},
+		{&pb.Storage{Driver: driverLocalType, MountPoint: "local", Options: []string{"mode=0750"}}, 0, 0},
+		{&pb.Storage{Driver: driverLocalType, MountPoint: "local", Options: []string{"mode=0999"}}, 1, 0},
+	}
+
+	storages := make([]*pb.Storage, len(data))
+	for i, d := range data {
+		storages[i] = d.storage
+	}
+
+	a := &agentGRPC{}
+	resp, err := a.ValidateStorage(context.Background(), &pb.ValidateStorageRequest{Storages: storages})
+	assert.NoError(err)
+	assert.Len(resp.Results, len(data))
+
+	for i, d := range data {
+		result := resp.Results[i]
+		if d.storage != nil {
+			assert.Equal(d.storage.MountPoint, result.MountPoint, "test %d (%+v)", i, d)
+		}
+		assert.Len(result.Errors, d.expectedErrors, "test %d (%+v): %v", i, d, result.Errors)
+		assert.Len(result.Warnings, d.expectedWarnings, "test %d (%+v): %v", i, d, result.Warnings)
+	}
+
+	// Nothing can be validated without the supported filesystems.
+	procFilesystems = filepath.Join(tmpDir, "missing")
+	_, err = a.ValidateStorage(context.Background(), &pb.ValidateStorageRequest{Storages: storages})
+	assert.Error(err)
+}
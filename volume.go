@@ -0,0 +1,95 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"unsafe"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// set function in variable to overwrite for testing.
+var getBlockDeviceSize = getBlockDeviceSizeImpl
+var getMountDevice = getMountDeviceAndFSType
+
+// getBlockDeviceSizeImpl returns the size in bytes of a block device.
+func getBlockDeviceSizeImpl(device string) (uint64, error) {
+	f, err := os.Open(device)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var size uint64
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), unix.BLKGETSIZE64, uintptr(unsafe.Pointer(&size))); errno != 0 {
+		return 0, errno
+	}
+
+	return size, nil
+}
+
+// getGrowFSCommand returns the command growing online the filesystem of
+// type fsType, mounted at mountPoint from device, to the device size.
+func getGrowFSCommand(fsType, device, mountPoint string) ([]string, error) {
+	switch fsType {
+	case "ext2", "ext3", "ext4":
+		return []string{"resize2fs", device}, nil
+	case "xfs":
+		return []string{"xfs_growfs", mountPoint}, nil
+	case "btrfs":
+		return []string{"btrfs", "filesystem", "resize", "max", mountPoint}, nil
+	}
+
+	return nil, grpcStatus.Errorf(codes.InvalidArgument, "Online resize of filesystem type %q is not supported", fsType)
+}
+
+// resizeVolume grows the filesystem mounted at mountPoint to the size of
+// its block device, once it has reached the expected size.
+func (s *sandbox) resizeVolume(mountPoint string, expectedSize uint64) (uint64, error) {
+	if mountPoint == "" {
+		return 0, grpcStatus.Error(codes.InvalidArgument, "Mount point cannot be empty")
+	}
+
+	device, fsType, err := getMountDevice(mountPoint)
+	if err != nil {
+		return 0, grpcStatus.Errorf(codes.NotFound, "Could not find filesystem mounted at %q: %v", mountPoint, err)
+	}
+
+	args, err := getGrowFSCommand(fsType, device, mountPoint)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := getBlockDeviceSize(device)
+	if err != nil {
+		return 0, grpcStatus.Errorf(codes.Internal, "Could not get size of device %q: %v", device, err)
+	}
+
+	if size < expectedSize {
+		return 0, grpcStatus.Errorf(codes.FailedPrecondition, "Device %q size %d is lower than the expected size %d",
+			device, size, expectedSize)
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"mount-point": mountPoint,
+		"device":      device,
+		"fstype":      fsType,
+		"size":        size,
+	}).Info("growing filesystem")
+
+	cmd := exec.Command(args[0], args[1:]...)
+	if output, err := s.subreaper.combinedOutput(cmd); err != nil {
+		return 0, grpcStatus.Errorf(codes.Internal, "Could not grow filesystem mounted at %q: %v: %s", mountPoint, err, output)
+	}
+
+	return size, nil
+}
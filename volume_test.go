@@ -0,0 +1,152 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetGrowFSCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		fsType          string
+		expectedCommand []string
+		expectError     bool
+	}
+
+	device := "/dev/vdb"
+	mountPoint := "/run/kata-containers/volume"
+
+	data := []testData{
+		{"ext2", []string{"resize2fs", device}, false},
+		{"ext3", []string{"resize2fs", device}, false},
+		{"ext4", []string{"resize2fs", device}, false},
+		{"xfs", []string{"xfs_growfs", mountPoint}, false},
+		{"btrfs", []string{"btrfs", "filesystem", "resize", "max", mountPoint}, false},
+		{"vfat", nil, true},
+		{"tmpfs", nil, true},
+		{"", nil, true},
+	}
+
+	for i, d := range data {
+		command, err := getGrowFSCommand(d.fsType, device, mountPoint)
+		if d.expectError {
+			assert.Error(err, "test %d", i)
+			continue
+		}
+
+		assert.NoError(err, "test %d", i)
+		assert.Equal(d.expectedCommand, command, "test %d", i)
+	}
+}
+
+func TestResizeVolume(t *testing.T) {
+	assert := assert.New(t)
+
+	savedGetBlockDeviceSize := getBlockDeviceSize
+	savedGetMountDevice := getMountDevice
+	defer func() {
+		getBlockDeviceSize = savedGetBlockDeviceSize
+		getMountDevice = savedGetMountDevice
+	}()
+
+	mountPoint := "/run/kata-containers/volume"
+	fsType := "ext4"
+	deviceSize := uint64(2 << 30)
+
+	getMountDevice = func(m string) (string, string, error) {
+		if m != mountPoint {
+			return "", "", errors.New("not a mount point")
+		}
+		return "/dev/vdb", fsType, nil
+	}
+	getBlockDeviceSize = func(device string) (uint64, error) {
+		return deviceSize, nil
+	}
+
+	// Provide fake grow commands
+	binDir, err := ioutil.TempDir("", "bin")
+	assert.NoError(err)
+	defer os.RemoveAll(binDir)
+
+	for _, cmd := range []string{"resize2fs", "xfs_growfs"} {
+		err = createFileWithPerms(filepath.Join(binDir, cmd), "#!/bin/sh\nexit 0\n", 0755)
+		assert.NoError(err)
+	}
+
+	savedPath := os.Getenv("PATH")
+	defer os.Setenv("PATH", savedPath)
+	os.Setenv("PATH", binDir)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			subreaper: &mockreaper{},
+		},
+	}
+
+	_, err = a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{})
+	assert.Error(err)
+
+	_, err = a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{MountPoint: "/foo"})
+	assert.Error(err)
+
+	// The device has not grown yet
+	_, err = a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{MountPoint: mountPoint, DeviceSize: deviceSize + 1})
+	assert.Error(err)
+
+	resp, err := a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{MountPoint: mountPoint, DeviceSize: deviceSize})
+	assert.NoError(err)
+	assert.Equal(deviceSize, resp.DeviceSize)
+
+	resp, err = a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{MountPoint: mountPoint})
+	assert.NoError(err)
+	assert.Equal(deviceSize, resp.DeviceSize)
+
+	fsType = "9p"
+	_, err = a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{MountPoint: mountPoint})
+	assert.Error(err)
+
+	fsType = "xfs"
+	resp, err = a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{MountPoint: mountPoint})
+	assert.NoError(err)
+	assert.Equal(deviceSize, resp.DeviceSize)
+
+	// The grow command is not available
+	fsType = "btrfs"
+	_, err = a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{MountPoint: mountPoint})
+	assert.Error(err)
+
+	getBlockDeviceSize = func(device string) (uint64, error) {
+		return 0, errors.New("ioctl failure")
+	}
+	_, err = a.ResizeVolume(context.TODO(), &pb.ResizeVolumeRequest{MountPoint: mountPoint})
+	assert.Error(err)
+}
+
+func TestGetBlockDeviceSize(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := getBlockDeviceSizeImpl("/dev/does-not-exist")
+	assert.Error(err)
+
+	// Not a block device
+	file := filepath.Join(os.TempDir(), "not-a-block-device")
+	assert.NoError(createEmptyFile(file))
+	defer os.Remove(file)
+
+	_, err = getBlockDeviceSizeImpl(file)
+	assert.Error(err)
+}
@@ -0,0 +1,178 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// stagedFile tracks a file written by WriteFiles, so that it can be rolled
+// back if another file of the group fails.
+type stagedFile struct {
+	path    string
+	tmpPath string
+	// hard link to the file previously found at path, if any
+	backupPath string
+	renamed    bool
+}
+
+// checkWriteFilePath makes sure path is a canonical path below the directory
+// holding the containers rootfs, in order not to overwrite guest files.
+func checkWriteFilePath(path string) error {
+	if !filepath.IsAbs(path) || filepath.Clean(path) != path {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Path %q must be absolute and canonical", path)
+	}
+
+	rel, err := filepath.Rel(containersRootfsPath, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Only is possible to write files into the %s directory", containersRootfsPath)
+	}
+
+	return nil
+}
+
+// stageFile writes the content of file to a temporary file next to its
+// destination, with the requested mode and ownership.
+func stageFile(file *pb.FileContent) (*stagedFile, error) {
+	dir, base := filepath.Split(file.Path)
+
+	if err := os.MkdirAll(dir, os.FileMode(file.DirMode)); err != nil {
+		return nil, err
+	}
+
+	tmpFile, err := ioutil.TempFile(dir, "."+base+".")
+	if err != nil {
+		return nil, err
+	}
+
+	staged := &stagedFile{
+		path:    file.Path,
+		tmpPath: tmpFile.Name(),
+	}
+
+	_, err = tmpFile.Write(file.Content)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(staged.tmpPath, os.FileMode(file.Mode))
+	}
+	if err == nil {
+		err = os.Chown(staged.tmpPath, int(file.Uid), int(file.Gid))
+	}
+	if err != nil {
+		os.Remove(staged.tmpPath)
+		return nil, err
+	}
+
+	return staged, nil
+}
+
+// commit moves the staged file to its destination, keeping a link to the
+// file it replaces.
+func (f *stagedFile) commit() error {
+	if _, err := os.Lstat(f.path); err == nil {
+		backupPath := f.tmpPath + ".orig"
+		if err := os.Link(f.path, backupPath); err != nil {
+			return err
+		}
+		f.backupPath = backupPath
+	}
+
+	if err := os.Rename(f.tmpPath, f.path); err != nil {
+		return err
+	}
+	f.renamed = true
+
+	return nil
+}
+
+// rollback undoes stage and commit, restoring the file previously found at
+// the destination, if any.
+func (f *stagedFile) rollback() error {
+	if !f.renamed {
+		if f.backupPath != "" {
+			os.Remove(f.backupPath)
+		}
+		return os.Remove(f.tmpPath)
+	}
+
+	if f.backupPath != "" {
+		return os.Rename(f.backupPath, f.path)
+	}
+
+	return os.Remove(f.path)
+}
+
+// writeFiles writes files as a group. All of them are staged to temporary
+// files before being moved to their destination, and the files already
+// written are rolled back if any of them fails. The missing parent
+// directories are not removed on failure.
+func writeFiles(files []*pb.FileContent) (err error) {
+	paths := make(map[string]bool)
+
+	for _, file := range files {
+		if file == nil {
+			return grpcStatus.Error(codes.InvalidArgument, "Missing file")
+		}
+
+		if err := checkWriteFilePath(file.Path); err != nil {
+			return err
+		}
+
+		if paths[file.Path] {
+			return grpcStatus.Errorf(codes.InvalidArgument, "File %q written several times", file.Path)
+		}
+		paths[file.Path] = true
+	}
+
+	var staged []*stagedFile
+
+	defer func() {
+		if err == nil {
+			for _, f := range staged {
+				if f.backupPath != "" {
+					os.Remove(f.backupPath)
+				}
+			}
+			return
+		}
+
+		for i := len(staged) - 1; i >= 0; i-- {
+			if rbErr := staged[i].rollback(); rbErr != nil {
+				agentLog.WithFields(logrus.Fields{
+					"error": rbErr,
+					"path":  staged[i].path,
+				}).Error("failed to roll back WriteFiles")
+			}
+		}
+	}()
+
+	for _, file := range files {
+		f, err := stageFile(file)
+		if err != nil {
+			return err
+		}
+		staged = append(staged, f)
+	}
+
+	for _, f := range staged {
+		if err := f.commit(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
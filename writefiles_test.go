@@ -0,0 +1,198 @@
+//
+// Copyright (c) 2020 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// setupWriteFiles points the containers rootfs directory to a temporary
+// directory, returned along with a function restoring it.
+func setupWriteFiles(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "write-files")
+	assert.NoError(t, err)
+
+	savedContainersRootfsPath := containersRootfsPath
+	containersRootfsPath = dir
+
+	return dir, func() {
+		containersRootfsPath = savedContainersRootfsPath
+		os.RemoveAll(dir)
+	}
+}
+
+// listFiles returns the names found in dir.
+func listFiles(t *testing.T, dir string) []string {
+	entries, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+func TestCheckWriteFilePath(t *testing.T) {
+	assert := assert.New(t)
+
+	savedContainersRootfsPath := containersRootfsPath
+	containersRootfsPath = "/run"
+	defer func() {
+		containersRootfsPath = savedContainersRootfsPath
+	}()
+
+	type testData struct {
+		path  string
+		valid bool
+	}
+
+	data := []testData{
+		{"/run/foo", true},
+		{"/run/foo/bar", true},
+		{"", false},
+		{"run/foo", false},
+		{"/run", false},
+		{"/run/", false},
+		{"/run/../etc/passwd", false},
+		{"/run/foo/../bar", false},
+		{"/runfoo", false},
+		{"/etc/passwd", false},
+	}
+
+	for i, d := range data {
+		err := checkWriteFilePath(d.path)
+		if d.valid {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		} else {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, cleanup := setupWriteFiles(t)
+	defer cleanup()
+
+	existing := filepath.Join(dir, "existing")
+	err := ioutil.WriteFile(existing, []byte("old"), 0644)
+	assert.NoError(err)
+
+	files := []*pb.FileContent{
+		{Path: existing, Mode: 0600, Uid: 1000, Gid: 1001, Content: []byte("new")},
+		{Path: filepath.Join(dir, "secret", "token"), Mode: 0400, DirMode: 0750, Uid: 2000, Gid: 2001, Content: []byte("token")},
+		{Path: filepath.Join(dir, "secret", "empty"), Mode: 0644, DirMode: 0750},
+	}
+
+	err = writeFiles(files)
+	assert.NoError(err)
+
+	for _, f := range files {
+		content, err := ioutil.ReadFile(f.Path)
+		assert.NoError(err)
+		assert.Equal(string(f.Content), string(content))
+
+		fi, err := os.Stat(f.Path)
+		assert.NoError(err)
+		assert.Equal(os.FileMode(f.Mode), fi.Mode())
+
+		st := fi.Sys().(*syscall.Stat_t)
+		assert.Equal(uint32(f.Uid), st.Uid, f.Path)
+		assert.Equal(uint32(f.Gid), st.Gid, f.Path)
+	}
+
+	fi, err := os.Stat(filepath.Join(dir, "secret"))
+	assert.NoError(err)
+	assert.Equal(os.ModeDir|0750, fi.Mode())
+
+	// No temporary file is left behind.
+	assert.Equal([]string{"existing", "secret"}, listFiles(t, dir))
+	assert.Equal([]string{"empty", "token"}, listFiles(t, filepath.Join(dir, "secret")))
+}
+
+func TestWriteFilesRollback(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, cleanup := setupWriteFiles(t)
+	defer cleanup()
+
+	existing := filepath.Join(dir, "existing")
+	err := ioutil.WriteFile(existing, []byte("old"), 0644)
+	assert.NoError(err)
+
+	// A directory cannot be replaced by a file.
+	err = os.Mkdir(filepath.Join(dir, "dir"), 0755)
+	assert.NoError(err)
+
+	// A file cannot be the parent of another one.
+	notDir := filepath.Join(dir, "file")
+	err = ioutil.WriteFile(notDir, nil, 0644)
+	assert.NoError(err)
+
+	uid := int32(os.Getuid())
+	gid := int32(os.Getgid())
+
+	type testData struct {
+		failing *pb.FileContent
+	}
+
+	data := []testData{
+		// failure while moving the files
+		{&pb.FileContent{Path: filepath.Join(dir, "dir"), Mode: 0644, Uid: uid, Gid: gid}},
+		// failure while staging the files
+		{&pb.FileContent{Path: filepath.Join(notDir, "foo"), Mode: 0644, Uid: uid, Gid: gid}},
+	}
+
+	for i, d := range data {
+		files := []*pb.FileContent{
+			{Path: existing, Mode: 0600, Uid: uid, Gid: gid, Content: []byte("new")},
+			{Path: filepath.Join(dir, "created"), Mode: 0600, Uid: uid, Gid: gid, Content: []byte("created")},
+			d.failing,
+		}
+
+		err = writeFiles(files)
+		assert.Error(err, "test %d", i)
+
+		content, err := ioutil.ReadFile(existing)
+		assert.NoError(err)
+		assert.Equal("old", string(content), "test %d", i)
+
+		fi, err := os.Stat(existing)
+		assert.NoError(err)
+		assert.Equal(os.FileMode(0644), fi.Mode(), "test %d", i)
+
+		assert.Equal([]string{"dir", "existing", "file"}, listFiles(t, dir), "test %d", i)
+	}
+
+	// Invalid requests do not write anything.
+	err = writeFiles([]*pb.FileContent{
+		{Path: filepath.Join(dir, "created"), Mode: 0600, Uid: uid, Gid: gid},
+		{Path: "/etc/passwd", Mode: 0600},
+	})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	err = writeFiles([]*pb.FileContent{
+		{Path: filepath.Join(dir, "created"), Mode: 0600, Uid: uid, Gid: gid},
+		{Path: filepath.Join(dir, "created"), Mode: 0600, Uid: uid, Gid: gid},
+	})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	assert.Equal([]string{"dir", "existing", "file"}, listFiles(t, dir))
+}